@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"github.com/maxuanquang/idm/internal/logic"
 	"github.com/maxuanquang/idm/internal/wiring"
 	"github.com/spf13/cobra"
 )
@@ -16,6 +19,7 @@ var (
 
 const (
 	flagConfigFilePath = "config-file-path"
+	flagAccountID      = "account-id"
 )
 
 func standaloneServer() *cobra.Command {
@@ -43,12 +47,74 @@ func standaloneServer() *cobra.Command {
 	return command
 }
 
+func rewrapFileKeys() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "rewrap-file-keys",
+		Short: "Wrap the data keys of all downloaded files with the current master key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configFilePath, err := cmd.Flags().GetString(flagConfigFilePath)
+			if err != nil {
+				return err
+			}
+
+			downloadTaskLogic, cleanup, err := wiring.InitializeDownloadTaskLogic(configs.ConfigFilePath(configFilePath))
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			return downloadTaskLogic.RewrapAllDownloadTaskFileKey(context.Background())
+		},
+	}
+
+	command.Flags().String(flagConfigFilePath, "", "If provided, will use the provided config file")
+
+	return command
+}
+
+func grantAdminRole() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "grant-admin-role",
+		Short: "Give an account the admin role, used to create the first admin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configFilePath, err := cmd.Flags().GetString(flagConfigFilePath)
+			if err != nil {
+				return err
+			}
+
+			accountID, err := cmd.Flags().GetUint64(flagAccountID)
+			if err != nil {
+				return err
+			}
+
+			adminLogic, cleanup, err := wiring.InitializeAdminLogic(configs.ConfigFilePath(configFilePath))
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			return adminLogic.UpdateAccountRole(context.Background(), logic.UpdateAccountRoleInput{
+				AccountID: accountID,
+				Role:      idm.Role_Admin,
+			})
+		},
+	}
+
+	command.Flags().String(flagConfigFilePath, "", "If provided, will use the provided config file")
+	command.Flags().Uint64(flagAccountID, 0, "ID of the account to make admin")
+	_ = command.MarkFlagRequired(flagAccountID)
+
+	return command
+}
+
 func main() {
 	rootCommand := &cobra.Command{
 		Version: fmt.Sprintf("%s-%s", version, commitHash),
 	}
 	rootCommand.AddCommand(
 		standaloneServer(),
+		rewrapFileKeys(),
+		grantAdminRole(),
 	)
 
 	if err := rootCommand.Execute(); err != nil {
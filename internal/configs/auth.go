@@ -6,16 +6,116 @@ type Hash struct {
 	Cost int `yaml:"cost"`
 }
 
+type SigningKey struct {
+	// EncryptionKey is the base64 encoded AES-256 key encrypting the private signing keys in the database.
+	EncryptionKey    string `yaml:"encryption_key"`
+	RotationInterval uint32 `yaml:"rotation_interval"`
+	ReloadInterval   uint32 `yaml:"reload_interval"`
+}
+
+func (s SigningKey) GetRotationInterval() time.Duration {
+	return time.Duration(s.RotationInterval) * time.Second
+}
+
+func (s SigningKey) GetReloadInterval() time.Duration {
+	return time.Duration(s.ReloadInterval) * time.Second
+}
+
 type Token struct {
-	Duration            uint32 `yaml:"duration"`
-	RS512KeyPairBitSize uint16 `yaml:"rs512_key_pair_bit_size"`
+	Duration             uint32     `yaml:"duration"`
+	RefreshTokenDuration uint32     `yaml:"refresh_token_duration"`
+	RS512KeyPairBitSize  uint16     `yaml:"rs512_key_pair_bit_size"`
+	SigningKey           SigningKey `yaml:"signing_key"`
 }
 
 func (t *Token) GetTokenDuration() time.Duration {
 	return time.Duration(t.Duration) * time.Second
 }
 
+func (t *Token) GetRefreshTokenDuration() time.Duration {
+	return time.Duration(t.RefreshTokenDuration) * time.Second
+}
+
+// PasswordPolicy is checked whenever a password is set, existing passwords are not checked again.
+type PasswordPolicy struct {
+	// MinLength and MaxLength count characters, passwords longer than 72 bytes are rejected regardless of
+	// MaxLength since bcrypt only hashes their first 72 bytes.
+	MinLength        int  `yaml:"min_length"`
+	MaxLength        int  `yaml:"max_length"`
+	RequireLowercase bool `yaml:"require_lowercase"`
+	RequireUppercase bool `yaml:"require_uppercase"`
+	RequireDigit     bool `yaml:"require_digit"`
+	RequireSymbol    bool `yaml:"require_symbol"`
+	// BreachedPasswordListFilePath is a file with one breached password per line, either in plain text or as
+	// the hex encoded SHA-1 hash of the password optionally followed by ":<count>". No passwords are rejected
+	// as breached if it is empty.
+	BreachedPasswordListFilePath string `yaml:"breached_password_list_file_path"`
+}
+
+type PasswordReset struct {
+	TokenDuration uint32 `yaml:"token_duration"`
+}
+
+func (p PasswordReset) GetTokenDuration() time.Duration {
+	return time.Duration(p.TokenDuration) * time.Second
+}
+
+type TOTP struct {
+	// Issuer is shown next to the account name in authenticator apps.
+	Issuer string `yaml:"issuer"`
+	// EncryptionKey is the base64 encoded AES-256 key encrypting the TOTP secrets in the database, it is read
+	// from EnvTOTPEncryptionKey if empty.
+	EncryptionKey          string `yaml:"encryption_key"`
+	LoginChallengeDuration uint32 `yaml:"login_challenge_duration"`
+	// MaxLoginChallengeAttempts is the number of wrong codes after which a login challenge is dropped.
+	MaxLoginChallengeAttempts uint32 `yaml:"max_login_challenge_attempts"`
+	RecoveryCodeCount         int    `yaml:"recovery_code_count"`
+}
+
+func (t TOTP) GetLoginChallengeDuration() time.Duration {
+	return time.Duration(t.LoginChallengeDuration) * time.Second
+}
+
+type LoginThrottleLimit struct {
+	// FreeAttemptCount is the number of failed logins before logins are delayed.
+	FreeAttemptCount uint64 `yaml:"free_attempt_count"`
+	// LockoutThreshold is the number of failed logins before logins are locked out for the lockout duration.
+	LockoutThreshold uint64 `yaml:"lockout_threshold"`
+}
+
+// LoginThrottle slows down password guessing, failed logins are counted per account name and per IP address.
+// The lockout threshold of Account applies to the failed logins of an account name from a single IP address.
+type LoginThrottle struct {
+	Enabled             bool               `yaml:"enabled"`
+	FailedAttemptWindow uint32             `yaml:"failed_attempt_window"`
+	BaseDelay           uint32             `yaml:"base_delay"`
+	MaxDelay            uint32             `yaml:"max_delay"`
+	LockoutDuration     uint32             `yaml:"lockout_duration"`
+	Account             LoginThrottleLimit `yaml:"account"`
+	IPAddress           LoginThrottleLimit `yaml:"ip_address"`
+}
+
+func (l LoginThrottle) GetFailedAttemptWindow() time.Duration {
+	return time.Duration(l.FailedAttemptWindow) * time.Second
+}
+
+func (l LoginThrottle) GetBaseDelay() time.Duration {
+	return time.Duration(l.BaseDelay) * time.Second
+}
+
+func (l LoginThrottle) GetMaxDelay() time.Duration {
+	return time.Duration(l.MaxDelay) * time.Second
+}
+
+func (l LoginThrottle) GetLockoutDuration() time.Duration {
+	return time.Duration(l.LockoutDuration) * time.Second
+}
+
 type Auth struct {
-	Hash  Hash  `yaml:"hash"`
-	Token Token `yaml:"token"`
+	Hash           Hash           `yaml:"hash"`
+	Token          Token          `yaml:"token"`
+	PasswordPolicy PasswordPolicy `yaml:"password_policy"`
+	PasswordReset  PasswordReset  `yaml:"password_reset"`
+	TOTP           TOTP           `yaml:"totp"`
+	LoginThrottle  LoginThrottle  `yaml:"login_throttle"`
 }
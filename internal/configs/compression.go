@@ -0,0 +1,12 @@
+package configs
+
+type CompressionRule struct {
+	ContentType string `yaml:"content_type"`
+	Codec       string `yaml:"codec"`
+}
+
+type Compression struct {
+	Enabled         bool              `yaml:"enabled"`
+	Rules           []CompressionRule `yaml:"rules"`
+	ServeCompressed bool              `yaml:"serve_compressed"`
+}
@@ -10,16 +10,24 @@ import (
 
 type ConfigFilePath string
 
+const (
+	EnvTOTPEncryptionKey    = "IDM_TOTP_ENCRYPTION_KEY"
+	EnvWebhookEncryptionKey = "IDM_WEBHOOK_ENCRYPTION_KEY"
+)
+
 type Config struct {
-	Auth     Auth     `yaml:"auth"`
-	Database Database `yaml:"database"`
-	Log      Log      `yaml:"log"`
-	Cache    Cache    `yaml:"cache"`
-	HTTP     HTTP     `yaml:"http"`
-	GRPC     GRPC     `yaml:"grpc"`
-	MQ       MQ       `yaml:"mq"`
-	Download Download `yaml:"download"`
-	Cron     Cron     `yaml:"cron"`
+	Auth      Auth      `yaml:"auth"`
+	Database  Database  `yaml:"database"`
+	Log       Log       `yaml:"log"`
+	Cache     Cache     `yaml:"cache"`
+	HTTP      HTTP      `yaml:"http"`
+	GRPC      GRPC      `yaml:"grpc"`
+	MQ        MQ        `yaml:"mq"`
+	Download  Download  `yaml:"download"`
+	Cron      Cron      `yaml:"cron"`
+	ShareLink ShareLink `yaml:"share_link"`
+	OIDC      OIDC      `yaml:"oidc"`
+	Webhook   Webhook   `yaml:"webhook"`
 }
 
 func NewConfig(configFilePath ConfigFilePath) (Config, error) {
@@ -41,5 +49,18 @@ func NewConfig(configFilePath ConfigFilePath) (Config, error) {
 		return Config{}, fmt.Errorf("error unmarshal configuration file: %w", err)
 	}
 
+	loadSecretListFromEnvironment(&config)
 	return config, nil
 }
+
+// loadSecretListFromEnvironment reads the secrets left empty in the configuration file from the environment, so
+// that they do not have to be written in it.
+func loadSecretListFromEnvironment(config *Config) {
+	if config.Auth.TOTP.EncryptionKey == "" {
+		config.Auth.TOTP.EncryptionKey = os.Getenv(EnvTOTPEncryptionKey)
+	}
+
+	if config.Webhook.EncryptionKey == "" {
+		config.Webhook.EncryptionKey = os.Getenv(EnvWebhookEncryptionKey)
+	}
+}
@@ -1,5 +1,7 @@
 package configs
 
+import "time"
+
 type ExecuteAllPendingDownloadTask struct {
 	Schedule         string `yaml:"schedule"`
 	ConcurrencyLimit int    `yaml:"concurrency_limit"`
@@ -9,7 +11,39 @@ type UpdateFailedDownloadTaskStatusToPending struct {
 	Schedule string `yaml:"schedule"`
 }
 
+type MoveDownloadTaskFileToColdTier struct {
+	Schedule         string `yaml:"schedule"`
+	ConcurrencyLimit int    `yaml:"concurrency_limit"`
+}
+
+type RotateTokenSigningKey struct {
+	Schedule string `yaml:"schedule"`
+}
+
+type DeliverAllPendingWebhookDelivery struct {
+	Schedule         string `yaml:"schedule"`
+	ConcurrencyLimit int    `yaml:"concurrency_limit"`
+	// BatchSize is the number of due deliveries attempted per run.
+	BatchSize int `yaml:"batch_size"`
+}
+
+type RelayAllUnsentOutboxMessage struct {
+	Schedule string `yaml:"schedule"`
+	// BatchSize is the number of messages produced per transaction.
+	BatchSize int `yaml:"batch_size"`
+	// SentMessageRetention is the number of hours sent messages are kept for before being deleted.
+	SentMessageRetention uint32 `yaml:"sent_message_retention"`
+}
+
+func (r RelayAllUnsentOutboxMessage) GetSentMessageRetention() time.Duration {
+	return time.Duration(r.SentMessageRetention) * time.Hour
+}
+
 type Cron struct {
 	ExecuteAllPendingDownloadTask           ExecuteAllPendingDownloadTask           `yaml:"execute_all_pending_download_task"`
 	UpdateFailedDownloadTaskStatusToPending UpdateFailedDownloadTaskStatusToPending `yaml:"update_failed_download_task_status_to_pending"`
+	MoveDownloadTaskFileToColdTier          MoveDownloadTaskFileToColdTier          `yaml:"move_download_task_file_to_cold_tier"`
+	RotateTokenSigningKey                   RotateTokenSigningKey                   `yaml:"rotate_token_signing_key"`
+	DeliverAllPendingWebhookDelivery        DeliverAllPendingWebhookDelivery        `yaml:"deliver_all_pending_webhook_delivery"`
+	RelayAllUnsentOutboxMessage             RelayAllUnsentOutboxMessage             `yaml:"relay_all_unsent_outbox_message"`
 }
@@ -5,6 +5,8 @@ type DownloadMode string
 const (
 	DownloadModeLocal DownloadMode = "local"
 	DownloadModeS3    DownloadMode = "s3"
+	// DownloadModeTiered lands files on local disk and later moves them to s3.
+	DownloadModeTiered DownloadMode = "tiered"
 )
 
 type Download struct {
@@ -14,4 +16,6 @@ type Download struct {
 	Address           string       `yaml:"address"`
 	Username          string       `yaml:"username"`
 	Password          string       `yaml:"password"`
+	Encryption        Encryption   `yaml:"encryption"`
+	Compression       Compression  `yaml:"compression"`
 }
@@ -0,0 +1,23 @@
+package configs
+
+import "github.com/dustin/go-humanize"
+
+type MasterKeySource string
+
+const (
+	MasterKeySourceConfig   MasterKeySource = "config"
+	MasterKeySourceLocalKMS MasterKeySource = "local_kms"
+)
+
+type Encryption struct {
+	Enabled            bool              `yaml:"enabled"`
+	ChunkSize          string            `yaml:"chunk_size"`
+	MasterKeySource    MasterKeySource   `yaml:"master_key_source"`
+	CurrentMasterKeyID string            `yaml:"current_master_key_id"`
+	MasterKeys         map[string]string `yaml:"master_keys"`
+	LocalKMSDirectory  string            `yaml:"local_kms_directory"`
+}
+
+func (e Encryption) GetChunkSizeInBytes() (uint64, error) {
+	return humanize.ParseBytes(e.ChunkSize)
+}
@@ -5,6 +5,9 @@ import "github.com/dustin/go-humanize"
 type GRPC struct {
 	Address             string              `yaml:"address"`
 	GetDownloadTaskFile GetDownloadTaskFile `yaml:"get_download_task_file"`
+	// TrustedProxyCIDRList lists the proxies whose X-Forwarded-For is trusted to get the IP address of
+	// clients, including grpc-gateway.
+	TrustedProxyCIDRList []string `yaml:"trusted_proxy_cidr_list"`
 }
 
 type GetDownloadTaskFile struct {
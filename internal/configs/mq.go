@@ -3,4 +3,8 @@ package configs
 type MQ struct {
 	Addresses []string `yaml:"addresses"`
 	ClientID  string   `yaml:"client_id"`
+	// ConsumerGroupID is shared by the replicas of the server, each message is handled by one of them.
+	ConsumerGroupID string `yaml:"consumer_group_id"`
+	// ConsumerMaxAttemptCount is the number of times a message is handled before it is skipped.
+	ConsumerMaxAttemptCount int `yaml:"consumer_max_attempt_count"`
 }
@@ -0,0 +1,26 @@
+package configs
+
+import "time"
+
+type OIDCProvider struct {
+	// Name identifies the provider in login routes and in linked external identities, it must not change.
+	Name         string   `yaml:"name"`
+	IssuerURL    string   `yaml:"issuer_url"`
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	Scopes       []string `yaml:"scopes"`
+}
+
+type OIDC struct {
+	// BaseURL is the public URL of the http server, callback URLs registered at providers are
+	// <base_url>/api/v1/oidc/providers/<name>/callback.
+	BaseURL string `yaml:"base_url"`
+	// PostLoginRedirectURL is where browsers are redirected after logging in.
+	PostLoginRedirectURL string         `yaml:"post_login_redirect_url"`
+	LoginStateDuration   uint32         `yaml:"login_state_duration"`
+	Providers            []OIDCProvider `yaml:"providers"`
+}
+
+func (o OIDC) GetLoginStateDuration() time.Duration {
+	return time.Duration(o.LoginStateDuration) * time.Second
+}
@@ -0,0 +1,13 @@
+package configs
+
+import "time"
+
+type ShareLink struct {
+	Secret      string `yaml:"secret"`
+	BaseURL     string `yaml:"base_url"`
+	MaxDuration uint32 `yaml:"max_duration"`
+}
+
+func (s ShareLink) GetMaxDuration() time.Duration {
+	return time.Duration(s.MaxDuration) * time.Second
+}
@@ -0,0 +1,30 @@
+package configs
+
+import "time"
+
+type Webhook struct {
+	// EncryptionKey is the base64 encoded AES-256 key encrypting the webhook secrets in the database, it is read
+	// from EnvWebhookEncryptionKey if empty.
+	EncryptionKey  string `yaml:"encryption_key"`
+	RequestTimeout uint32 `yaml:"request_timeout"`
+	// MaxAttemptCount is the number of failed attempts after which a delivery is not retried anymore.
+	MaxAttemptCount uint32 `yaml:"max_attempt_count"`
+	// BaseRetryDelay is doubled after each failed attempt of a delivery, up to MaxRetryDelay.
+	BaseRetryDelay uint32 `yaml:"base_retry_delay"`
+	MaxRetryDelay  uint32 `yaml:"max_retry_delay"`
+	// AllowPrivateNetwork lets webhooks call loopback and private addresses, webhooks can otherwise only call
+	// public addresses so that accounts can not reach the internal network of the server through them.
+	AllowPrivateNetwork bool `yaml:"allow_private_network"`
+}
+
+func (w Webhook) GetRequestTimeout() time.Duration {
+	return time.Duration(w.RequestTimeout) * time.Second
+}
+
+func (w Webhook) GetBaseRetryDelay() time.Duration {
+	return time.Duration(w.BaseRetryDelay) * time.Second
+}
+
+func (w Webhook) GetMaxRetryDelay() time.Duration {
+	return time.Duration(w.MaxRetryDelay) * time.Second
+}
@@ -13,4 +13,7 @@ var WireSet = wire.NewSet(
 	wire.FieldsOf(new(Config), "MQ"),
 	wire.FieldsOf(new(Config), "Download"),
 	wire.FieldsOf(new(Config), "Cron"),
+	wire.FieldsOf(new(Config), "ShareLink"),
+	wire.FieldsOf(new(Config), "OIDC"),
+	wire.FieldsOf(new(Config), "Webhook"),
 )
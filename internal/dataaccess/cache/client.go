@@ -22,6 +22,12 @@ type Client interface {
 	Get(ctx context.Context, key string) (any, error)
 	AddToSet(ctx context.Context, key string, value ...any) error
 	IsValueInSet(ctx context.Context, key string, value any) (bool, error)
+	Delete(ctx context.Context, key string) error
+	// GetAndDelete returns the value of key and deletes it atomically, only one of concurrent callers gets it.
+	GetAndDelete(ctx context.Context, key string) (any, error)
+	// Increment adds one to the counter of key and returns its new value. The counter expires ttl after its
+	// last increment.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
 }
 
 func NewClient(
@@ -97,6 +103,52 @@ func (c *redisClient) IsValueInSet(ctx context.Context, key string, value interf
 	return exists, nil
 }
 
+// Delete implements Client.
+func (c *redisClient) Delete(ctx context.Context, key string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	if err := c.client.Del(ctx, key).Err(); err != nil {
+		logger.Error("failed to delete key from cache", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetAndDelete implements Client.
+func (c *redisClient) GetAndDelete(ctx context.Context, key string) (any, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	value, err := c.client.GetDel(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrCacheMissed
+		}
+		logger.Error("failed to get and delete key from cache", zap.Error(err))
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Increment implements Client.
+func (c *redisClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Duration("ttl", ttl))
+
+	var incrCmd *redis.IntCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incrCmd = pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed to increment counter in cache", zap.Error(err))
+		return 0, err
+	}
+
+	return incrCmd.Val(), nil
+}
+
 // Get implements Client.
 func (c *redisClient) Get(ctx context.Context, key string) (any, error) {
 	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
@@ -139,6 +191,12 @@ func NewInMemoryClient(
 	}, nil
 }
 
+// inMemoryCounter is the value of keys set by Increment, unlike other values counters expire.
+type inMemoryCounter struct {
+	value      int64
+	expireTime time.Time
+}
+
 type inMemoryClient struct {
 	cache      map[string]any
 	cacheMutex *sync.Mutex
@@ -162,6 +220,16 @@ func (i *inMemoryClient) AddToSet(ctx context.Context, key string, value ...any)
 	return nil
 }
 
+// Delete implements Client.
+func (i *inMemoryClient) Delete(ctx context.Context, key string) error {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	delete(i.cache, key)
+
+	return nil
+}
+
 // Get implements Client.
 func (i *inMemoryClient) Get(ctx context.Context, key string) (any, error) {
 	if val, ok := i.cache[key]; ok {
@@ -171,6 +239,37 @@ func (i *inMemoryClient) Get(ctx context.Context, key string) (any, error) {
 	return nil, ErrCacheMissed
 }
 
+// GetAndDelete implements Client.
+func (i *inMemoryClient) GetAndDelete(ctx context.Context, key string) (any, error) {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	val, ok := i.cache[key]
+	if !ok {
+		return nil, ErrCacheMissed
+	}
+
+	delete(i.cache, key)
+	return val, nil
+}
+
+// Increment implements Client.
+func (i *inMemoryClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	now := time.Now()
+	counter, ok := i.cache[key].(*inMemoryCounter)
+	if !ok || !now.Before(counter.expireTime) {
+		counter = &inMemoryCounter{}
+		i.cache[key] = counter
+	}
+
+	counter.value++
+	counter.expireTime = now.Add(ttl)
+	return counter.value, nil
+}
+
 // IsValueInSet implements Client.
 func (i *inMemoryClient) IsValueInSet(ctx context.Context, key string, value any) (bool, error) {
 	if set, ok := i.cache[key].(map[any]struct{}); ok {
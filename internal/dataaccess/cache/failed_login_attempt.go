@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var (
+	failedLoginAttemptCountKeyPrefix string = "failed_login_attempt_count"
+	failedLoginBlockedUntilKeyPrefix string = "failed_login_blocked_until"
+)
+
+// FailedLoginAttempt counts the failed logins of a subject, such as an account name or an IP address, and
+// holds until when its logins are rejected.
+type FailedLoginAttempt interface {
+	// Increment returns the number of failed logins of subject, failed logins more than window apart from each
+	// other are not counted together.
+	Increment(ctx context.Context, subject string, window time.Duration) (uint64, error)
+	Reset(ctx context.Context, subject string) error
+	SetBlockedUntil(ctx context.Context, subject string, blockedUntil time.Time) error
+	// GetBlockedUntil returns the zero time if logins of subject are not blocked.
+	GetBlockedUntil(ctx context.Context, subject string) (time.Time, error)
+}
+
+func NewFailedLoginAttempt(client Client) (FailedLoginAttempt, error) {
+	return &failedLoginAttempt{
+		client: client,
+	}, nil
+}
+
+type failedLoginAttempt struct {
+	client Client
+}
+
+// Increment implements FailedLoginAttempt.
+func (f *failedLoginAttempt) Increment(ctx context.Context, subject string, window time.Duration) (uint64, error) {
+	count, err := f.client.Increment(ctx, f.getCountCacheKey(subject), window)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(count), nil
+}
+
+// Reset implements FailedLoginAttempt.
+func (f *failedLoginAttempt) Reset(ctx context.Context, subject string) error {
+	if err := f.client.Delete(ctx, f.getCountCacheKey(subject)); err != nil {
+		return err
+	}
+
+	return f.client.Delete(ctx, f.getBlockedUntilCacheKey(subject))
+}
+
+// SetBlockedUntil implements FailedLoginAttempt.
+func (f *failedLoginAttempt) SetBlockedUntil(ctx context.Context, subject string, blockedUntil time.Time) error {
+	return f.client.Set(
+		ctx,
+		f.getBlockedUntilCacheKey(subject),
+		strconv.FormatInt(blockedUntil.UnixNano(), 10),
+		time.Until(blockedUntil),
+	)
+}
+
+// GetBlockedUntil implements FailedLoginAttempt.
+func (f *failedLoginAttempt) GetBlockedUntil(ctx context.Context, subject string) (time.Time, error) {
+	value, err := f.client.Get(ctx, f.getBlockedUntilCacheKey(subject))
+	if err != nil {
+		if errors.Is(err, ErrCacheMissed) {
+			return time.Time{}, nil
+		}
+
+		return time.Time{}, err
+	}
+
+	stringValue, ok := value.(string)
+	if !ok {
+		return time.Time{}, errors.New("cached value is not a string")
+	}
+
+	blockedUntilUnixNano, err := strconv.ParseInt(stringValue, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, blockedUntilUnixNano), nil
+}
+
+func (f *failedLoginAttempt) getCountCacheKey(subject string) string {
+	return fmt.Sprintf("%s:%s", failedLoginAttemptCountKeyPrefix, subject)
+}
+
+func (f *failedLoginAttempt) getBlockedUntilCacheKey(subject string) string {
+	return fmt.Sprintf("%s:%s", failedLoginBlockedUntilKeyPrefix, subject)
+}
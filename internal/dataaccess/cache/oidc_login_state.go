@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	oidcLoginStateKeyPrefix string = "oidc_login_state"
+)
+
+type OIDCLoginStateValue struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+	// AccountID is the account to link the external identity to, 0 when logging in.
+	AccountID uint64 `json:"account_id"`
+}
+
+// OIDCLoginState holds what is needed to finish an OIDC login between the redirect to the provider and its callback.
+type OIDCLoginState interface {
+	Set(ctx context.Context, state string, value OIDCLoginStateValue, ttl time.Duration) error
+	// Pop returns the value of a state and deletes it so that a state can only be used once.
+	Pop(ctx context.Context, state string) (OIDCLoginStateValue, error)
+}
+
+func NewOIDCLoginState(client Client) (OIDCLoginState, error) {
+	return &oidcLoginState{
+		client: client,
+	}, nil
+}
+
+type oidcLoginState struct {
+	client Client
+}
+
+// Set implements OIDCLoginState.
+func (o *oidcLoginState) Set(ctx context.Context, state string, value OIDCLoginStateValue, ttl time.Duration) error {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return o.client.Set(ctx, o.getCacheKey(state), string(bytes), ttl)
+}
+
+// Pop implements OIDCLoginState.
+func (o *oidcLoginState) Pop(ctx context.Context, state string) (OIDCLoginStateValue, error) {
+	value, err := o.client.GetAndDelete(ctx, o.getCacheKey(state))
+	if err != nil {
+		return OIDCLoginStateValue{}, err
+	}
+
+	stringValue, ok := value.(string)
+	if !ok {
+		return OIDCLoginStateValue{}, errors.New("cached value is not a string")
+	}
+
+	var loginStateValue OIDCLoginStateValue
+	if err = json.Unmarshal([]byte(stringValue), &loginStateValue); err != nil {
+		return OIDCLoginStateValue{}, err
+	}
+
+	return loginStateValue, nil
+}
+
+func (o *oidcLoginState) getCacheKey(state string) string {
+	return fmt.Sprintf("%s:%s", oidcLoginStateKeyPrefix, state)
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	sessionDenylistKeyPrefix string = "session_denylist"
+)
+
+// SessionDenylist holds the IDs of revoked sessions until their tokens expire.
+type SessionDenylist interface {
+	Add(ctx context.Context, sessionID string, ttl time.Duration) error
+	Has(ctx context.Context, sessionID string) (bool, error)
+}
+
+func NewSessionDenylist(client Client) (SessionDenylist, error) {
+	return &sessionDenylist{
+		client: client,
+	}, nil
+}
+
+type sessionDenylist struct {
+	client Client
+}
+
+// Add implements SessionDenylist.
+func (s *sessionDenylist) Add(ctx context.Context, sessionID string, ttl time.Duration) error {
+	return s.client.Set(ctx, s.getCacheKey(sessionID), "1", ttl)
+}
+
+// Has implements SessionDenylist.
+func (s *sessionDenylist) Has(ctx context.Context, sessionID string) (bool, error) {
+	_, err := s.client.Get(ctx, s.getCacheKey(sessionID))
+	if err != nil {
+		if errors.Is(err, ErrCacheMissed) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *sessionDenylist) getCacheKey(sessionID string) string {
+	return fmt.Sprintf("%s:%s", sessionDenylistKeyPrefix, sessionID)
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	totpLoginChallengeKeyPrefix             string = "totp_login_challenge"
+	totpLoginChallengeAttemptCountKeyPrefix string = "totp_login_challenge_attempt_count"
+)
+
+type TOTPLoginChallengeValue struct {
+	AccountID uint64 `json:"account_id"`
+	UserAgent string `json:"user_agent"`
+	IPAddress string `json:"ip_address"`
+	// ExpireTime is checked by the caller as not every cache drops expired keys.
+	ExpireTime time.Time `json:"expire_time"`
+}
+
+// TOTPLoginChallenge holds the logins which checked the password of an account with TOTP activated and are
+// waiting for a TOTP code.
+type TOTPLoginChallenge interface {
+	Set(ctx context.Context, challenge string, value TOTPLoginChallengeValue, ttl time.Duration) error
+	Get(ctx context.Context, challenge string) (TOTPLoginChallengeValue, error)
+	// IncrementAttemptCount returns the number of codes checked for the challenge, the count is incremented
+	// atomically so that concurrent requests each get their own count.
+	IncrementAttemptCount(ctx context.Context, challenge string, ttl time.Duration) (uint32, error)
+	// Delete also deletes the attempt count of the challenge.
+	Delete(ctx context.Context, challenge string) error
+}
+
+func NewTOTPLoginChallenge(client Client) (TOTPLoginChallenge, error) {
+	return &totpLoginChallenge{
+		client: client,
+	}, nil
+}
+
+type totpLoginChallenge struct {
+	client Client
+}
+
+// Set implements TOTPLoginChallenge.
+func (t *totpLoginChallenge) Set(ctx context.Context, challenge string, value TOTPLoginChallengeValue, ttl time.Duration) error {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return t.client.Set(ctx, t.getCacheKey(challenge), string(bytes), ttl)
+}
+
+// Get implements TOTPLoginChallenge.
+func (t *totpLoginChallenge) Get(ctx context.Context, challenge string) (TOTPLoginChallengeValue, error) {
+	value, err := t.client.Get(ctx, t.getCacheKey(challenge))
+	if err != nil {
+		return TOTPLoginChallengeValue{}, err
+	}
+
+	stringValue, ok := value.(string)
+	if !ok {
+		return TOTPLoginChallengeValue{}, errors.New("cached value is not a string")
+	}
+
+	var challengeValue TOTPLoginChallengeValue
+	if err = json.Unmarshal([]byte(stringValue), &challengeValue); err != nil {
+		return TOTPLoginChallengeValue{}, err
+	}
+
+	return challengeValue, nil
+}
+
+// IncrementAttemptCount implements TOTPLoginChallenge.
+func (t *totpLoginChallenge) IncrementAttemptCount(ctx context.Context, challenge string, ttl time.Duration) (uint32, error) {
+	count, err := t.client.Increment(ctx, t.getAttemptCountCacheKey(challenge), ttl)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(count), nil
+}
+
+// Delete implements TOTPLoginChallenge.
+func (t *totpLoginChallenge) Delete(ctx context.Context, challenge string) error {
+	if err := t.client.Delete(ctx, t.getCacheKey(challenge)); err != nil {
+		return err
+	}
+
+	return t.client.Delete(ctx, t.getAttemptCountCacheKey(challenge))
+}
+
+func (t *totpLoginChallenge) getCacheKey(challenge string) string {
+	return fmt.Sprintf("%s:%s", totpLoginChallengeKeyPrefix, challenge)
+}
+
+func (t *totpLoginChallenge) getAttemptCountCacheKey(challenge string) string {
+	return fmt.Sprintf("%s:%s", totpLoginChallengeAttemptCountKeyPrefix, challenge)
+}
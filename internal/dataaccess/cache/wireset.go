@@ -6,4 +6,8 @@ var WireSet = wire.NewSet(
 	NewClient,
 	NewTakenAccountName,
 	NewTokenPublicKey,
+	NewSessionDenylist,
+	NewOIDCLoginState,
+	NewTOTPLoginChallenge,
+	NewFailedLoginAttempt,
 )
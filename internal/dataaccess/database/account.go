@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
 	"github.com/maxuanquang/idm/internal/utils"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -17,12 +18,20 @@ var (
 type Account struct {
 	AccountID   uint64 `gorm:"column:account_id;primaryKey"`
 	AccountName string `gorm:"column:account_name"`
+	Role        uint16 `gorm:"column:role"`
+	IsDisabled  bool   `gorm:"column:is_disabled"`
 }
 
 type AccountDataAccessor interface {
+	// CreateAccount creates an account with the user role if account has no role.
 	CreateAccount(ctx context.Context, account Account) (Account, error)
 	GetAccountByID(ctx context.Context, id uint64) (Account, error)
 	GetAccountByName(ctx context.Context, name string) (Account, error)
+	GetAccountList(ctx context.Context, offset, limit uint64) ([]Account, error)
+	GetAccountCount(ctx context.Context) (uint64, error)
+	GetDisabledAccountCount(ctx context.Context) (uint64, error)
+	UpdateAccountRole(ctx context.Context, id uint64, role uint16) error
+	UpdateAccountIsDisabled(ctx context.Context, id uint64, isDisabled bool) error
 	WithDatabaseTransaction(database Database) AccountDataAccessor
 }
 
@@ -42,11 +51,15 @@ type accountDataAccessor struct {
 func (a *accountDataAccessor) CreateAccount(ctx context.Context, account Account) (Account, error) {
 	createdAccount := Account{
 		AccountName: account.AccountName,
+		Role:        account.Role,
+	}
+	if createdAccount.Role == 0 {
+		createdAccount.Role = uint16(idm.Role_User)
 	}
 	result := a.database.Create(&createdAccount)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
-			return Account{}, nil
+			return Account{}, ErrAccountAlreadyExists
 		}
 
 		logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("account_name", account.AccountName))
@@ -91,6 +104,74 @@ func (a *accountDataAccessor) GetAccountByName(ctx context.Context, name string)
 	return foundAccount, nil
 }
 
+// GetAccountList implements AccountDataAccessor.
+func (a *accountDataAccessor) GetAccountList(ctx context.Context, offset, limit uint64) ([]Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("offset", offset)).With(zap.Uint64("limit", limit))
+
+	var accounts []Account
+	result := a.database.Order("account_id").Offset(int(offset)).Limit(int(limit)).Find(&accounts)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting account list")
+		return nil, result.Error
+	}
+
+	return accounts, nil
+}
+
+// GetAccountCount implements AccountDataAccessor.
+func (a *accountDataAccessor) GetAccountCount(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	var count int64
+	result := a.database.Model(&Account{}).Count(&count)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting account count")
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// GetDisabledAccountCount implements AccountDataAccessor.
+func (a *accountDataAccessor) GetDisabledAccountCount(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	var count int64
+	result := a.database.Model(&Account{}).Where("is_disabled = ?", true).Count(&count)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting disabled account count")
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// UpdateAccountRole implements AccountDataAccessor.
+func (a *accountDataAccessor) UpdateAccountRole(ctx context.Context, id uint64, role uint16) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", id)).With(zap.Uint16("role", role))
+
+	result := a.database.Model(&Account{}).Where("account_id = ?", id).Update("role", role)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error updating account role")
+		return result.Error
+	}
+
+	return nil
+}
+
+// UpdateAccountIsDisabled implements AccountDataAccessor.
+func (a *accountDataAccessor) UpdateAccountIsDisabled(ctx context.Context, id uint64, isDisabled bool) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", id)).With(zap.Bool("is_disabled", isDisabled))
+
+	result := a.database.Model(&Account{}).Where("account_id = ?", id).Update("is_disabled", isDisabled)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error updating account disabled flag")
+		return result.Error
+	}
+
+	return nil
+}
+
 // WithDatabaseTransaction implements AccountDataAccessor.
 func (a *accountDataAccessor) WithDatabaseTransaction(database Database) AccountDataAccessor {
 	return &accountDataAccessor{
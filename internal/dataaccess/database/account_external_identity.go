@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAccountExternalIdentityNotFound = errors.New("account external identity not found")
+)
+
+// AccountExternalIdentity links an account to its identity at an external identity provider.
+type AccountExternalIdentity struct {
+	AccountExternalIdentityID uint64    `gorm:"column:account_external_identity_id;primaryKey"`
+	OfAccountID               uint64    `gorm:"column:of_account_id"`
+	Provider                  string    `gorm:"column:provider"`
+	Subject                   string    `gorm:"column:subject"`
+	Email                     string    `gorm:"column:email"`
+	CreateTime                time.Time `gorm:"column:create_time"`
+}
+
+type AccountExternalIdentityDataAccessor interface {
+	CreateAccountExternalIdentity(ctx context.Context, accountExternalIdentity AccountExternalIdentity) error
+	GetAccountExternalIdentity(ctx context.Context, provider string, subject string) (AccountExternalIdentity, error)
+	WithDatabaseTransaction(database Database) AccountExternalIdentityDataAccessor
+}
+
+func NewAccountExternalIdentityDataAccessor(database Database, logger *zap.Logger) AccountExternalIdentityDataAccessor {
+	return &accountExternalIdentityDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type accountExternalIdentityDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateAccountExternalIdentity implements AccountExternalIdentityDataAccessor.
+func (a *accountExternalIdentityDataAccessor) CreateAccountExternalIdentity(
+	ctx context.Context,
+	accountExternalIdentity AccountExternalIdentity,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Uint64("accountID", accountExternalIdentity.OfAccountID)).
+		With(zap.String("provider", accountExternalIdentity.Provider))
+
+	result := a.database.Create(&accountExternalIdentity)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating account external identity")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetAccountExternalIdentity implements AccountExternalIdentityDataAccessor.
+func (a *accountExternalIdentityDataAccessor) GetAccountExternalIdentity(
+	ctx context.Context,
+	provider string,
+	subject string,
+) (AccountExternalIdentity, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("provider", provider))
+
+	var accountExternalIdentity AccountExternalIdentity
+	result := a.database.
+		Where("provider = ?", provider).
+		Where("subject = ?", subject).
+		First(&accountExternalIdentity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return AccountExternalIdentity{}, ErrAccountExternalIdentityNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting account external identity")
+		return AccountExternalIdentity{}, result.Error
+	}
+
+	return accountExternalIdentity, nil
+}
+
+// WithDatabaseTransaction implements AccountExternalIdentityDataAccessor.
+func (a *accountExternalIdentityDataAccessor) WithDatabaseTransaction(database Database) AccountExternalIdentityDataAccessor {
+	return &accountExternalIdentityDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
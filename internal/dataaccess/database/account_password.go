@@ -2,8 +2,16 @@ package database
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrAccountPasswordNotFound is returned for accounts only logging in with an external identity provider.
+	ErrAccountPasswordNotFound = errors.New("account password not found")
 )
 
 type AccountPassword struct {
@@ -14,6 +22,8 @@ type AccountPassword struct {
 type AccountPasswordDataAccessor interface {
 	CreatePassword(ctx context.Context, ofAccountID uint64, hashedPassword string) (AccountPassword, error)
 	GetPassword(ctx context.Context, ofAccountID uint64) (AccountPassword, error)
+	// UpdatePassword creates the password if the account does not have one.
+	UpdatePassword(ctx context.Context, ofAccountID uint64, hashedPassword string) error
 	WithDatabaseTransaction(database Database) AccountPasswordDataAccessor
 }
 
@@ -37,6 +47,10 @@ func (a *accountPasswordDataAccessor) GetPassword(ctx context.Context, ofAccount
 	var foundPassword AccountPassword
 	result := a.database.Where("of_account_id = ?", ofAccountID).First(&foundPassword)
 	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return AccountPassword{}, ErrAccountPasswordNotFound
+		}
+
 		return AccountPassword{}, result.Error
 	}
 
@@ -57,6 +71,20 @@ func (a *accountPasswordDataAccessor) CreatePassword(ctx context.Context, ofAcco
 	return createdPassword, nil
 }
 
+// UpdatePassword implements AccountPasswordDataAccessor.
+func (a *accountPasswordDataAccessor) UpdatePassword(ctx context.Context, ofAccountID uint64, hashedPassword string) error {
+	password := AccountPassword{
+		OfAccountID: ofAccountID,
+		Hashed:      hashedPassword,
+	}
+	result := a.database.Clauses(clause.OnConflict{UpdateAll: true}).Create(&password)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
 // WithDatabaseTransaction implements AccountPasswordDataAccessor.
 func (a *accountPasswordDataAccessor) WithDatabaseTransaction(database Database) AccountPasswordDataAccessor {
 	return &accountPasswordDataAccessor{
@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrAccountRecoveryCodeNotFound = errors.New("account recovery code not found")
+)
+
+// AccountRecoveryCode can be used once in place of a TOTP code.
+type AccountRecoveryCode struct {
+	AccountRecoveryCodeID uint64 `gorm:"column:account_recovery_code_id;primaryKey"`
+	OfAccountID           uint64 `gorm:"column:of_account_id"`
+	HashedCode            string `gorm:"column:hashed_code"`
+	IsUsed                bool   `gorm:"column:is_used"`
+}
+
+type AccountRecoveryCodeDataAccessor interface {
+	CreateAccountRecoveryCodeList(ctx context.Context, accountRecoveryCodeList []AccountRecoveryCode) error
+	GetUnusedAccountRecoveryCodeForUpdate(ctx context.Context, accountID uint64, hashedCode string) (AccountRecoveryCode, error)
+	MarkAccountRecoveryCodeUsed(ctx context.Context, accountRecoveryCodeID uint64) error
+	DeleteAccountRecoveryCodeListOfAccount(ctx context.Context, accountID uint64) error
+	WithDatabaseTransaction(database Database) AccountRecoveryCodeDataAccessor
+}
+
+func NewAccountRecoveryCodeDataAccessor(database Database, logger *zap.Logger) AccountRecoveryCodeDataAccessor {
+	return &accountRecoveryCodeDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type accountRecoveryCodeDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateAccountRecoveryCodeList implements AccountRecoveryCodeDataAccessor.
+func (a *accountRecoveryCodeDataAccessor) CreateAccountRecoveryCodeList(ctx context.Context, accountRecoveryCodeList []AccountRecoveryCode) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	if len(accountRecoveryCodeList) == 0 {
+		return nil
+	}
+
+	result := a.database.Create(&accountRecoveryCodeList)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating account recovery codes")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetUnusedAccountRecoveryCodeForUpdate implements AccountRecoveryCodeDataAccessor.
+func (a *accountRecoveryCodeDataAccessor) GetUnusedAccountRecoveryCodeForUpdate(
+	ctx context.Context,
+	accountID uint64,
+	hashedCode string,
+) (AccountRecoveryCode, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("accountID", accountID))
+
+	var accountRecoveryCode AccountRecoveryCode
+	result := a.database.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("of_account_id = ?", accountID).
+		Where("hashed_code = ?", hashedCode).
+		Where("is_used = ?", false).
+		First(&accountRecoveryCode)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return AccountRecoveryCode{}, ErrAccountRecoveryCodeNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting account recovery code")
+		return AccountRecoveryCode{}, result.Error
+	}
+
+	return accountRecoveryCode, nil
+}
+
+// MarkAccountRecoveryCodeUsed implements AccountRecoveryCodeDataAccessor.
+func (a *accountRecoveryCodeDataAccessor) MarkAccountRecoveryCodeUsed(ctx context.Context, accountRecoveryCodeID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("accountRecoveryCodeID", accountRecoveryCodeID))
+
+	result := a.database.Model(&AccountRecoveryCode{}).
+		Where("account_recovery_code_id = ?", accountRecoveryCodeID).
+		Update("is_used", true)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error marking account recovery code used")
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeleteAccountRecoveryCodeListOfAccount implements AccountRecoveryCodeDataAccessor.
+func (a *accountRecoveryCodeDataAccessor) DeleteAccountRecoveryCodeListOfAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("accountID", accountID))
+
+	result := a.database.Where("of_account_id = ?", accountID).Delete(&AccountRecoveryCode{})
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error deleting account recovery codes")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements AccountRecoveryCodeDataAccessor.
+func (a *accountRecoveryCodeDataAccessor) WithDatabaseTransaction(database Database) AccountRecoveryCodeDataAccessor {
+	return &accountRecoveryCodeDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -0,0 +1,138 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrAccountTOTPNotFound = errors.New("account totp not found")
+)
+
+// AccountTOTP is the TOTP secret of an account, codes are only required at login once it is activated.
+type AccountTOTP struct {
+	OfAccountID uint64 `gorm:"column:of_account_id;primaryKey"`
+	// EncryptedSecret is the nonce followed by the sealed secret.
+	EncryptedSecret []byte `gorm:"column:encrypted_secret"`
+	IsActivated     bool   `gorm:"column:is_activated"`
+	// LastUsedTimeStep is the time step of the last accepted code, codes of earlier time steps are rejected
+	// so that a code can not be replayed.
+	LastUsedTimeStep uint64    `gorm:"column:last_used_time_step"`
+	CreateTime       time.Time `gorm:"column:create_time"`
+}
+
+type AccountTOTPDataAccessor interface {
+	// CreateOrReplaceAccountTOTP replaces the TOTP secret the account already has.
+	CreateOrReplaceAccountTOTP(ctx context.Context, accountTOTP AccountTOTP) error
+	GetAccountTOTP(ctx context.Context, accountID uint64) (AccountTOTP, error)
+	GetAccountTOTPForUpdate(ctx context.Context, accountID uint64) (AccountTOTP, error)
+	ActivateAccountTOTP(ctx context.Context, accountID uint64) error
+	UpdateAccountTOTPLastUsedTimeStep(ctx context.Context, accountID uint64, lastUsedTimeStep uint64) error
+	DeleteAccountTOTP(ctx context.Context, accountID uint64) error
+	WithDatabaseTransaction(database Database) AccountTOTPDataAccessor
+}
+
+func NewAccountTOTPDataAccessor(database Database, logger *zap.Logger) AccountTOTPDataAccessor {
+	return &accountTOTPDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type accountTOTPDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateOrReplaceAccountTOTP implements AccountTOTPDataAccessor.
+func (a *accountTOTPDataAccessor) CreateOrReplaceAccountTOTP(ctx context.Context, accountTOTP AccountTOTP) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("accountID", accountTOTP.OfAccountID))
+
+	result := a.database.Clauses(clause.OnConflict{UpdateAll: true}).Create(&accountTOTP)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating account totp")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetAccountTOTP implements AccountTOTPDataAccessor.
+func (a *accountTOTPDataAccessor) GetAccountTOTP(ctx context.Context, accountID uint64) (AccountTOTP, error) {
+	return a.getAccountTOTP(ctx, a.database, accountID)
+}
+
+// GetAccountTOTPForUpdate implements AccountTOTPDataAccessor.
+func (a *accountTOTPDataAccessor) GetAccountTOTPForUpdate(ctx context.Context, accountID uint64) (AccountTOTP, error) {
+	return a.getAccountTOTP(ctx, a.database.Clauses(clause.Locking{Strength: "UPDATE"}), accountID)
+}
+
+func (a *accountTOTPDataAccessor) getAccountTOTP(ctx context.Context, database Database, accountID uint64) (AccountTOTP, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("accountID", accountID))
+
+	var accountTOTP AccountTOTP
+	result := database.Where("of_account_id = ?", accountID).First(&accountTOTP)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return AccountTOTP{}, ErrAccountTOTPNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting account totp")
+		return AccountTOTP{}, result.Error
+	}
+
+	return accountTOTP, nil
+}
+
+// ActivateAccountTOTP implements AccountTOTPDataAccessor.
+func (a *accountTOTPDataAccessor) ActivateAccountTOTP(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("accountID", accountID))
+
+	result := a.database.Model(&AccountTOTP{}).Where("of_account_id = ?", accountID).Update("is_activated", true)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error activating account totp")
+		return result.Error
+	}
+
+	return nil
+}
+
+// UpdateAccountTOTPLastUsedTimeStep implements AccountTOTPDataAccessor.
+func (a *accountTOTPDataAccessor) UpdateAccountTOTPLastUsedTimeStep(ctx context.Context, accountID uint64, lastUsedTimeStep uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("accountID", accountID))
+
+	result := a.database.Model(&AccountTOTP{}).Where("of_account_id = ?", accountID).Update("last_used_time_step", lastUsedTimeStep)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error updating account totp last used time step")
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeleteAccountTOTP implements AccountTOTPDataAccessor.
+func (a *accountTOTPDataAccessor) DeleteAccountTOTP(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("accountID", accountID))
+
+	result := a.database.Where("of_account_id = ?", accountID).Delete(&AccountTOTP{})
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error deleting account totp")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements AccountTOTPDataAccessor.
+func (a *accountTOTPDataAccessor) WithDatabaseTransaction(database Database) AccountTOTPDataAccessor {
+	return &accountTOTPDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -0,0 +1,162 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New("api key not found")
+)
+
+type APIKey struct {
+	APIKeyID    uint64 `gorm:"column:api_key_id;primaryKey"`
+	OfAccountID uint64 `gorm:"column:of_account_id"`
+	Name        string `gorm:"column:name"`
+	// KeyPrefix is the beginning of the key, shown to tell keys apart as the key itself is not stored.
+	KeyPrefix string `gorm:"column:key_prefix"`
+	HashedKey string `gorm:"column:hashed_key"`
+	// Scopes is a space separated list of scopes.
+	Scopes       string     `gorm:"column:scopes"`
+	CreateTime   time.Time  `gorm:"column:create_time"`
+	LastUsedTime *time.Time `gorm:"column:last_used_time"`
+	ExpireTime   *time.Time `gorm:"column:expire_time"`
+	IsRevoked    bool       `gorm:"column:is_revoked"`
+}
+
+type APIKeyDataAccessor interface {
+	CreateAPIKey(ctx context.Context, apiKey APIKey) (uint64, error)
+	GetAPIKey(ctx context.Context, apiKeyID uint64) (APIKey, error)
+	GetAPIKeyByHashedKey(ctx context.Context, hashedKey string) (APIKey, error)
+	GetAPIKeyListOfAccount(ctx context.Context, accountID uint64) ([]APIKey, error)
+	// UpdateAPIKeyLastUsedTime only updates keys last used more than minUpdateInterval before lastUsedTime.
+	UpdateAPIKeyLastUsedTime(ctx context.Context, apiKeyID uint64, lastUsedTime time.Time, minUpdateInterval time.Duration) error
+	RevokeAPIKey(ctx context.Context, apiKeyID uint64) error
+	WithDatabaseTransaction(database Database) APIKeyDataAccessor
+}
+
+func NewAPIKeyDataAccessor(database Database, logger *zap.Logger) APIKeyDataAccessor {
+	return &apiKeyDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type apiKeyDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateAPIKey implements APIKeyDataAccessor.
+func (a *apiKeyDataAccessor) CreateAPIKey(ctx context.Context, apiKey APIKey) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("accountID", apiKey.OfAccountID))
+
+	result := a.database.Create(&apiKey)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating api key")
+		return 0, result.Error
+	}
+
+	return apiKey.APIKeyID, nil
+}
+
+// GetAPIKey implements APIKeyDataAccessor.
+func (a *apiKeyDataAccessor) GetAPIKey(ctx context.Context, apiKeyID uint64) (APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("apiKeyID", apiKeyID))
+
+	var apiKey APIKey
+	result := a.database.First(&apiKey, apiKeyID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return APIKey{}, ErrAPIKeyNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting api key")
+		return APIKey{}, result.Error
+	}
+
+	return apiKey, nil
+}
+
+// GetAPIKeyByHashedKey implements APIKeyDataAccessor.
+func (a *apiKeyDataAccessor) GetAPIKeyByHashedKey(ctx context.Context, hashedKey string) (APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	var apiKey APIKey
+	result := a.database.Where("hashed_key = ?", hashedKey).First(&apiKey)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return APIKey{}, ErrAPIKeyNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting api key")
+		return APIKey{}, result.Error
+	}
+
+	return apiKey, nil
+}
+
+// GetAPIKeyListOfAccount implements APIKeyDataAccessor.
+func (a *apiKeyDataAccessor) GetAPIKeyListOfAccount(ctx context.Context, accountID uint64) ([]APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("accountID", accountID))
+
+	var apiKeys []APIKey
+	result := a.database.
+		Where("of_account_id = ?", accountID).
+		Where("is_revoked = ?", false).
+		Order("api_key_id DESC").
+		Find(&apiKeys)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting api key list")
+		return nil, result.Error
+	}
+
+	return apiKeys, nil
+}
+
+// UpdateAPIKeyLastUsedTime implements APIKeyDataAccessor.
+func (a *apiKeyDataAccessor) UpdateAPIKeyLastUsedTime(
+	ctx context.Context,
+	apiKeyID uint64,
+	lastUsedTime time.Time,
+	minUpdateInterval time.Duration,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("apiKeyID", apiKeyID))
+
+	result := a.database.Model(&APIKey{}).
+		Where("api_key_id = ?", apiKeyID).
+		Where("last_used_time IS NULL OR last_used_time < ?", lastUsedTime.Add(-minUpdateInterval)).
+		Update("last_used_time", lastUsedTime)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error updating api key last used time")
+		return result.Error
+	}
+
+	return nil
+}
+
+// RevokeAPIKey implements APIKeyDataAccessor.
+func (a *apiKeyDataAccessor) RevokeAPIKey(ctx context.Context, apiKeyID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("apiKeyID", apiKeyID))
+
+	result := a.database.Model(&APIKey{}).Where("api_key_id = ?", apiKeyID).Update("is_revoked", true)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error revoking api key")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements APIKeyDataAccessor.
+func (a *apiKeyDataAccessor) WithDatabaseTransaction(database Database) APIKeyDataAccessor {
+	return &apiKeyDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
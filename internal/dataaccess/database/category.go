@@ -0,0 +1,152 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCategoryNotFound      = errors.New("category not found")
+	ErrCategoryAlreadyExists = errors.New("category already exists")
+)
+
+// Category gives its defaults to the download tasks of a workspace it is set on, a download task has at most
+// one category.
+type Category struct {
+	CategoryID    uint64 `gorm:"column:category_id;primaryKey"`
+	OfWorkspaceID uint64 `gorm:"column:of_workspace_id"`
+	Name          string `gorm:"column:name"`
+	// StoragePrefix is prepended to the name files are stored under.
+	StoragePrefix string `gorm:"column:storage_prefix"`
+	Compression   uint16 `gorm:"column:compression"`
+	Priority      int32  `gorm:"column:priority"`
+	// ContentTypeList and FileExtensionList are JSON arrays of strings, downloaded files matching one of them
+	// get the category if their download task has none.
+	ContentTypeList   string    `gorm:"column:content_type_list"`
+	FileExtensionList string    `gorm:"column:file_extension_list"`
+	CreateTime        time.Time `gorm:"column:create_time;autoCreateTime"`
+}
+
+type CategoryDataAccessor interface {
+	CreateCategory(ctx context.Context, category Category) (Category, error)
+	GetCategory(ctx context.Context, categoryID uint64) (Category, error)
+	GetCategoryListOfWorkspace(ctx context.Context, workspaceID uint64) ([]Category, error)
+	UpdateCategory(ctx context.Context, category Category) error
+	// DeleteCategory also unsets the category from download tasks.
+	DeleteCategory(ctx context.Context, categoryID uint64) error
+	WithDatabaseTransaction(database Database) CategoryDataAccessor
+}
+
+func NewCategoryDataAccessor(database Database, logger *zap.Logger) CategoryDataAccessor {
+	return &categoryDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type categoryDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateCategory implements CategoryDataAccessor.
+func (c *categoryDataAccessor) CreateCategory(ctx context.Context, category Category) (Category, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("workspaceID", category.OfWorkspaceID)).With(zap.String("name", category.Name))
+
+	createdCategory := category
+	createdCategory.CategoryID = 0
+
+	result := c.database.Create(&createdCategory)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return Category{}, ErrCategoryAlreadyExists
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error creating category")
+		return Category{}, result.Error
+	}
+
+	return createdCategory, nil
+}
+
+// GetCategory implements CategoryDataAccessor.
+func (c *categoryDataAccessor) GetCategory(ctx context.Context, categoryID uint64) (Category, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("categoryID", categoryID))
+
+	var category Category
+	result := c.database.Where("category_id = ?", categoryID).First(&category)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Category{}, ErrCategoryNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting category")
+		return Category{}, result.Error
+	}
+
+	return category, nil
+}
+
+// GetCategoryListOfWorkspace implements CategoryDataAccessor.
+func (c *categoryDataAccessor) GetCategoryListOfWorkspace(ctx context.Context, workspaceID uint64) ([]Category, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("workspaceID", workspaceID))
+
+	var categories []Category
+	result := c.database.Where("of_workspace_id = ?", workspaceID).Order("category_id").Find(&categories)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting category list of workspace")
+		return nil, result.Error
+	}
+
+	return categories, nil
+}
+
+// UpdateCategory implements CategoryDataAccessor.
+func (c *categoryDataAccessor) UpdateCategory(ctx context.Context, category Category) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("categoryID", category.CategoryID))
+
+	result := c.database.Model(&Category{}).Where("category_id = ?", category.CategoryID).Updates(map[string]any{
+		"name":                category.Name,
+		"storage_prefix":      category.StoragePrefix,
+		"compression":         category.Compression,
+		"priority":            category.Priority,
+		"content_type_list":   category.ContentTypeList,
+		"file_extension_list": category.FileExtensionList,
+	})
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return ErrCategoryAlreadyExists
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error updating category")
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeleteCategory implements CategoryDataAccessor.
+func (c *categoryDataAccessor) DeleteCategory(ctx context.Context, categoryID uint64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("categoryID", categoryID))
+
+	result := c.database.Delete(&Category{}, categoryID)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error deleting category")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements CategoryDataAccessor.
+func (c *categoryDataAccessor) WithDatabaseTransaction(database Database) CategoryDataAccessor {
+	return &categoryDataAccessor{
+		database: database,
+		logger:   c.logger,
+	}
+}
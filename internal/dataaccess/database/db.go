@@ -100,14 +100,18 @@ func InitializeDB(dbConfig configs.Database) (Database, func(), error) {
 }
 
 func InitializeGORMDB(dbConfig configs.Database) (*gorm.DB, func(), error) {
-	// Create data source name (DSN) string
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true", dbConfig.Username, dbConfig.Password, dbConfig.Host, dbConfig.Port, dbConfig.Database)
+	// Create data source name (DSN) string, clientFoundRows makes the rows affected by updates count the rows
+	// matched even if their values do not change, so that conditional updates can tell when nothing matched.
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true&clientFoundRows=true", dbConfig.Username, dbConfig.Password, dbConfig.Host, dbConfig.Port, dbConfig.Database)
 
 	// Open GORM database connection
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
 		NamingStrategy: schema.NamingStrategy{
 			SingularTable: true,
 		},
+		// Translate the errors of the driver to the errors of gorm, such as gorm.ErrDuplicatedKey for the
+		// violations of unique keys.
+		TranslateError: true,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -3,6 +3,10 @@ package database
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
 	"github.com/maxuanquang/idm/internal/utils"
@@ -13,26 +17,121 @@ import (
 
 var (
 	ErrDownloadTaskNotFound = errors.New("download task not found")
+	// ErrDownloadTaskStatusChanged is returned by conditional updates of download tasks whose status is not the
+	// expected one anymore.
+	ErrDownloadTaskStatusChanged = errors.New("download task status changed")
 )
 
 type DownloadTask struct {
 	DownloadTaskID uint64 `gorm:"column:download_task_id;primaryKey"`
+	// OfAccountID is the account which created the download task.
 	OfAccountID    uint64 `gorm:"column:of_account_id"`
+	OfWorkspaceID  uint64 `gorm:"column:of_workspace_id"`
 	DownloadType   uint16 `gorm:"column:download_type"`
 	DownloadURL    string `gorm:"column:download_url"`
 	DownloadStatus uint16 `gorm:"column:download_status"`
-	Metadata       string `gorm:"column:metadata"`
+	// Metadata is a JSON object written by the server, Labels is a JSON object of strings set by clients.
+	Metadata   string    `gorm:"column:metadata"`
+	Labels     string    `gorm:"column:labels"`
+	CreateTime time.Time `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime time.Time `gorm:"column:update_time;autoUpdateTime"`
+	// StartTime and FinishTime are the times the download task last started and finished downloading.
+	StartTime  *time.Time `gorm:"column:start_time"`
+	FinishTime *time.Time `gorm:"column:finish_time"`
+	// FileSize is generated by the database from the metadata.
+	FileSize     *uint64 `gorm:"column:file_size;->"`
+	OfCategoryID *uint64 `gorm:"column:of_category_id"`
+	// Priority orders the execution of pending download tasks, highest first.
+	Priority int32 `gorm:"column:priority"`
+}
+
+type DownloadTaskListSortField uint8
+
+const (
+	DownloadTaskListSortFieldCreateTime DownloadTaskListSortField = iota
+	DownloadTaskListSortFieldFileSize
+	DownloadTaskListSortFieldDownloadStatus
+)
+
+// DownloadTaskListFilter narrows down a download task list, its zero fields do not filter. Time ranges
+// include their start and exclude their end.
+type DownloadTaskListFilter struct {
+	DownloadStatusList []uint16
+	DownloadTypeList   []uint16
+	URLSubstring       string
+	URLHost            string
+	CreateTimeFrom     time.Time
+	CreateTimeTo       time.Time
+	UpdateTimeFrom     time.Time
+	UpdateTimeTo       time.Time
+	// SearchQuery is matched against the words of the download URL and the file name, the last word of the
+	// query also matches words it is a prefix of.
+	SearchQuery string
+	// TagIDList keeps the download tasks with any of the tags, CategoryIDList the ones in any of the
+	// categories.
+	TagIDList      []uint64
+	CategoryIDList []uint64
+}
+
+type DownloadTaskListSort struct {
+	Field      DownloadTaskListSortField
+	Descending bool
+}
+
+// DownloadTaskListCursor points at a download task of a list, the list continues right after it.
+type DownloadTaskListCursor struct {
+	DownloadTaskID uint64
+	CreateTime     time.Time
+	FileSize       *uint64
+	DownloadStatus uint16
+}
+
+func NewDownloadTaskListCursor(downloadTask DownloadTask) DownloadTaskListCursor {
+	return DownloadTaskListCursor{
+		DownloadTaskID: downloadTask.DownloadTaskID,
+		CreateTime:     downloadTask.CreateTime,
+		FileSize:       downloadTask.FileSize,
+		DownloadStatus: downloadTask.DownloadStatus,
+	}
 }
 
 type DownloadTaskDataAccessor interface {
 	CreateDownloadTask(ctx context.Context, downloadTask DownloadTask) (DownloadTask, error)
 	GetDownloadTask(ctx context.Context, downloadTaskID uint64) (DownloadTask, error)
 	GetDownloadTaskForUpdate(ctx context.Context, downloadTaskID uint64) (DownloadTask, error)
+	// GetPendingDownloadTaskIDList returns the IDs of the pending download tasks, highest priority first.
 	GetPendingDownloadTaskIDList(ctx context.Context) ([]uint64, error)
+	GetDownloadTaskIDListWithStatus(ctx context.Context, downloadStatus uint16) ([]uint64, error)
+	GetDownloadTaskIDListWithMetadataValue(ctx context.Context, downloadStatus uint16, metadataKey string, metadataValue string) ([]uint64, error)
 	GetDownloadTaskListOfAccount(ctx context.Context, accountID, offset, limit uint64) ([]DownloadTask, error)
 	GetDownloadTaskCountOfAccount(ctx context.Context, accountID uint64) (uint64, error)
-	UpdateDownloadTask(ctx context.Context, downloadTaskID uint64, downloadStatus uint16, metadata string) error
-	UpdateFailedDownloadTaskStatusToPending(ctx context.Context) error
+	GetDownloadTaskListOfWorkspace(
+		ctx context.Context,
+		workspaceID uint64,
+		filter DownloadTaskListFilter,
+		sort DownloadTaskListSort,
+		after *DownloadTaskListCursor,
+		offset, limit uint64,
+	) ([]DownloadTask, error)
+	GetDownloadTaskCountOfWorkspace(ctx context.Context, workspaceID uint64, filter DownloadTaskListFilter) (uint64, error)
+	GetDownloadTaskList(ctx context.Context, offset, limit uint64) ([]DownloadTask, error)
+	GetDownloadTaskCount(ctx context.Context) (uint64, error)
+	// GetDownloadTaskCountPerStatus maps each download status to its number of download tasks, statuses
+	// without download tasks are left out.
+	GetDownloadTaskCountPerStatus(ctx context.Context) (map[uint16]uint64, error)
+	// UpdateDownloadTask sets the status of a download task unless downloadStatus is 0, and its metadata unless it
+	// is empty. The download task is only updated if its status is still fromDownloadStatus, otherwise
+	// ErrDownloadTaskStatusChanged is returned.
+	UpdateDownloadTask(ctx context.Context, downloadTaskID uint64, fromDownloadStatus, downloadStatus uint16, metadata string) error
+	// UpdateDownloadTaskStatusInBulk moves every download task whose status is in fromDownloadStatusList to
+	// toDownloadStatus, it returns the IDs and former statuses of the updated download tasks.
+	UpdateDownloadTaskStatusInBulk(ctx context.Context, fromDownloadStatusList []uint16, toDownloadStatus uint16) ([]DownloadTask, error)
+	UpdateDownloadTaskLabels(ctx context.Context, downloadTaskID uint64, labels string) error
+	// UpdateDownloadTaskCategory sets the category of a download task, or unsets it if categoryID is nil,
+	// along with the priority the category gives it.
+	UpdateDownloadTaskCategory(ctx context.Context, downloadTaskID uint64, categoryID *uint64, priority int32) error
+	// UpdateDownloadTaskPriorityOfCategory sets the priority of every download task of the category.
+	UpdateDownloadTaskPriorityOfCategory(ctx context.Context, categoryID uint64, priority int32) error
 	DeleteDownloadTask(ctx context.Context, downloadTaskID uint64) error
 	WithDatabaseTransaction(database Database) DownloadTaskDataAccessor
 }
@@ -87,7 +186,11 @@ func (d *downloadTaskDataAccessor) GetPendingDownloadTaskIDList(ctx context.Cont
 	logger := utils.LoggerWithContext(ctx, d.logger)
 
 	var downloadTaskIDs []uint64
-	result := d.database.Model(&DownloadTask{}).Where("download_status = ?", uint16(idm.DownloadStatus_Pending)).Pluck("download_task_id", &downloadTaskIDs)
+	result := d.database.Model(&DownloadTask{}).
+		Where("download_status = ?", uint16(idm.DownloadStatus_Pending)).
+		Order("priority DESC").
+		Order("download_task_id").
+		Pluck("download_task_id", &downloadTaskIDs)
 	if result.Error != nil {
 		logger.With(zap.Error(result.Error)).Error("error getting pending download task id list")
 		return nil, result.Error
@@ -96,6 +199,45 @@ func (d *downloadTaskDataAccessor) GetPendingDownloadTaskIDList(ctx context.Cont
 	return downloadTaskIDs, nil
 }
 
+// GetDownloadTaskIDListWithStatus implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) GetDownloadTaskIDListWithStatus(ctx context.Context, downloadStatus uint16) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint16("downloadStatus", downloadStatus))
+
+	var downloadTaskIDs []uint64
+	result := d.database.Model(&DownloadTask{}).Where("download_status = ?", downloadStatus).Pluck("download_task_id", &downloadTaskIDs)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting download task id list with status")
+		return nil, result.Error
+	}
+
+	return downloadTaskIDs, nil
+}
+
+// GetDownloadTaskIDListWithMetadataValue implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) GetDownloadTaskIDListWithMetadataValue(
+	ctx context.Context,
+	downloadStatus uint16,
+	metadataKey string,
+	metadataValue string,
+) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint16("downloadStatus", downloadStatus)).
+		With(zap.String("metadataKey", metadataKey)).
+		With(zap.String("metadataValue", metadataValue))
+
+	var downloadTaskIDs []uint64
+	result := d.database.Model(&DownloadTask{}).
+		Where("download_status = ?", downloadStatus).
+		Where("JSON_UNQUOTE(JSON_EXTRACT(metadata, ?)) = ?", fmt.Sprintf(`$."%s"`, metadataKey), metadataValue).
+		Pluck("download_task_id", &downloadTaskIDs)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting download task id list with metadata value")
+		return nil, result.Error
+	}
+
+	return downloadTaskIDs, nil
+}
+
 // GetDownloadTaskForUpdate implements DownloadTaskDataAccessor.
 func (d *downloadTaskDataAccessor) GetDownloadTaskForUpdate(ctx context.Context, downloadTaskID uint64) (DownloadTask, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("downloadTaskID", downloadTaskID))
@@ -119,7 +261,7 @@ func (d *downloadTaskDataAccessor) GetDownloadTaskListOfAccount(ctx context.Cont
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("accountID", accountID)).With(zap.Uint64("offset", offset)).With(zap.Uint64("limit", limit))
 
 	var downloadTasks []DownloadTask
-	result := d.database.Where("of_account_id = ?", accountID).Offset(int(offset)).Limit(int(limit)).Find(&downloadTasks)
+	result := d.database.Where("of_account_id = ?", accountID).Order("download_task_id").Offset(int(offset)).Limit(int(limit)).Find(&downloadTasks)
 	if result.Error != nil {
 		logger.With(zap.Error(result.Error)).Error("error getting download task list")
 		return nil, result.Error
@@ -132,53 +274,245 @@ func (d *downloadTaskDataAccessor) GetDownloadTaskListOfAccount(ctx context.Cont
 func (d *downloadTaskDataAccessor) GetDownloadTaskCountOfAccount(ctx context.Context, accountID uint64) (uint64, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("accountID", accountID))
 
+	var count int64
+	result := d.database.Model(&DownloadTask{}).Where("of_account_id = ?", accountID).Count(&count)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting download task count")
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// GetDownloadTaskListOfWorkspace implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) GetDownloadTaskListOfWorkspace(
+	ctx context.Context,
+	workspaceID uint64,
+	filter DownloadTaskListFilter,
+	sort DownloadTaskListSort,
+	after *DownloadTaskListCursor,
+	offset, limit uint64,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("workspaceID", workspaceID)).With(zap.Uint64("offset", offset)).With(zap.Uint64("limit", limit))
+
+	sortColumn, err := getDownloadTaskListSortColumn(sort.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	query := applyDownloadTaskListFilter(d.database.Where("of_workspace_id = ?", workspaceID), filter)
+	if after != nil {
+		query = applyDownloadTaskListCursor(query, sortColumn, sort, *after)
+	}
+
+	var downloadTasks []DownloadTask
+	result := query.
+		Order(clause.OrderByColumn{Column: clause.Column{Name: sortColumn}, Desc: sort.Descending}).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "download_task_id"}, Desc: sort.Descending}).
+		Offset(int(offset)).
+		Limit(int(limit)).
+		Find(&downloadTasks)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting download task list of workspace")
+		return nil, result.Error
+	}
+
+	return downloadTasks, nil
+}
+
+// GetDownloadTaskCountOfWorkspace implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) GetDownloadTaskCountOfWorkspace(ctx context.Context, workspaceID uint64, filter DownloadTaskListFilter) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("workspaceID", workspaceID))
+
+	var count int64
+	result := applyDownloadTaskListFilter(d.database.Model(&DownloadTask{}).Where("of_workspace_id = ?", workspaceID), filter).Count(&count)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting download task count of workspace")
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// GetDownloadTaskList implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) GetDownloadTaskList(ctx context.Context, offset, limit uint64) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("offset", offset)).With(zap.Uint64("limit", limit))
+
 	var downloadTasks []DownloadTask
-	result := d.database.Where("of_account_id = ?", accountID).Find(&downloadTasks)
+	result := d.database.Order("download_task_id").Offset(int(offset)).Limit(int(limit)).Find(&downloadTasks)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting download task list")
+		return nil, result.Error
+	}
+
+	return downloadTasks, nil
+}
+
+// GetDownloadTaskCount implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) GetDownloadTaskCount(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	var count int64
+	result := d.database.Model(&DownloadTask{}).Count(&count)
 	if result.Error != nil {
 		logger.With(zap.Error(result.Error)).Error("error getting download task count")
 		return 0, result.Error
 	}
 
-	return uint64(result.RowsAffected), nil
+	return uint64(count), nil
 }
 
-// UpdateDownloadTask implements DownloadTaskDataAccessor.
-func (d *downloadTaskDataAccessor) UpdateDownloadTask(ctx context.Context, downloadTaskID uint64, downloadStatus uint16, metadata string) error {
-	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("downloadTaskID", downloadTaskID)).With(zap.Uint16("downloadStatus", downloadStatus)).With(zap.String("metadata", metadata))
+// GetDownloadTaskCountPerStatus implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) GetDownloadTaskCountPerStatus(ctx context.Context) (map[uint16]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger)
 
-	downloadTask, err := d.GetDownloadTaskForUpdate(ctx, downloadTaskID)
-	if err != nil {
-		return err
+	var rows []struct {
+		DownloadStatus uint16
+		Count          uint64
+	}
+	result := d.database.Model(&DownloadTask{}).
+		Select("download_status, COUNT(*) AS count").
+		Group("download_status").
+		Scan(&rows)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting download task count per status")
+		return nil, result.Error
 	}
 
-	if downloadStatus != 0 {
-		downloadTask.DownloadStatus = downloadStatus
+	countPerStatus := make(map[uint16]uint64, len(rows))
+	for _, row := range rows {
+		countPerStatus[row.DownloadStatus] = row.Count
+	}
+
+	return countPerStatus, nil
+}
+
+// UpdateDownloadTask implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) UpdateDownloadTask(
+	ctx context.Context,
+	downloadTaskID uint64,
+	fromDownloadStatus, downloadStatus uint16,
+	metadata string,
+) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("downloadTaskID", downloadTaskID)).
+		With(zap.Uint16("fromDownloadStatus", fromDownloadStatus)).
+		With(zap.Uint16("downloadStatus", downloadStatus)).
+		With(zap.String("metadata", metadata))
+
+	columns := map[string]any{}
+	if downloadStatus != 0 && downloadStatus != fromDownloadStatus {
+		columns = getDownloadTaskStatusColumns(downloadStatus, time.Now())
 	}
 	if metadata != "" {
-		downloadTask.Metadata = metadata
+		columns["metadata"] = metadata
+	}
+	if len(columns) == 0 {
+		return nil
 	}
 
-	result := d.database.Save(&downloadTask)
+	result := d.database.Model(&DownloadTask{}).
+		Where("download_task_id = ?", downloadTaskID).
+		Where("download_status = ?", fromDownloadStatus).
+		Updates(columns)
 	if result.Error != nil {
 		logger.With(zap.Error(result.Error)).Error("failed to update download task")
 		return result.Error
 	}
 
+	if result.RowsAffected == 0 {
+		return ErrDownloadTaskStatusChanged
+	}
+
 	return nil
 }
 
-func (d *downloadTaskDataAccessor) UpdateFailedDownloadTaskStatusToPending(ctx context.Context) error {
-	logger := utils.LoggerWithContext(ctx, d.logger)
+// UpdateDownloadTaskStatusInBulk implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) UpdateDownloadTaskStatusInBulk(
+	ctx context.Context,
+	fromDownloadStatusList []uint16,
+	toDownloadStatus uint16,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Any("fromDownloadStatusList", fromDownloadStatusList)).
+		With(zap.Uint16("toDownloadStatus", toDownloadStatus))
+
+	if len(fromDownloadStatusList) == 0 {
+		return nil, nil
+	}
+
+	var downloadTaskList []DownloadTask
+	result := d.database.Model(&DownloadTask{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("download_task_id", "download_status").
+		Where("download_status IN ?", fromDownloadStatusList).
+		Find(&downloadTaskList)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("failed to get download task list to update")
+		return nil, result.Error
+	}
+
+	if len(downloadTaskList) == 0 {
+		logger.Info("no download task to update found")
+		return nil, nil
+	}
+
+	downloadTaskIDs := make([]uint64, 0, len(downloadTaskList))
+	for _, downloadTask := range downloadTaskList {
+		downloadTaskIDs = append(downloadTaskIDs, downloadTask.DownloadTaskID)
+	}
+
+	result = d.database.Model(&DownloadTask{}).
+		Where("download_task_id IN ?", downloadTaskIDs).
+		Where("download_status IN ?", fromDownloadStatusList).
+		Updates(getDownloadTaskStatusColumns(toDownloadStatus, time.Now()))
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("failed to update download task status")
+		return nil, result.Error
+	}
 
-	result := d.database.Model(&DownloadTask{}).Where("download_status = ?", uint16(idm.DownloadStatus_Failed)).Update("download_status", uint16(idm.DownloadStatus_Pending))
+	return downloadTaskList, nil
+}
+
+// UpdateDownloadTaskLabels implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) UpdateDownloadTaskLabels(ctx context.Context, downloadTaskID uint64, labels string) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("downloadTaskID", downloadTaskID))
+
+	result := d.database.Model(&DownloadTask{}).Where("download_task_id = ?", downloadTaskID).Update("labels", labels)
 	if result.Error != nil {
-		logger.With(zap.Error(result.Error)).Error("failed to update failed download task status to pending")
+		logger.With(zap.Error(result.Error)).Error("failed to update download task labels")
 		return result.Error
 	}
 
-	if result.RowsAffected == 0 {
-		logger.Info("no failed task found")
+	return nil
+}
+
+// UpdateDownloadTaskCategory implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) UpdateDownloadTaskCategory(ctx context.Context, downloadTaskID uint64, categoryID *uint64, priority int32) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("downloadTaskID", downloadTaskID))
+
+	result := d.database.Model(&DownloadTask{}).Where("download_task_id = ?", downloadTaskID).Updates(map[string]any{
+		"of_category_id": categoryID,
+		"priority":       priority,
+	})
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("failed to update download task category")
+		return result.Error
 	}
+
+	return nil
+}
+
+// UpdateDownloadTaskPriorityOfCategory implements DownloadTaskDataAccessor.
+func (d *downloadTaskDataAccessor) UpdateDownloadTaskPriorityOfCategory(ctx context.Context, categoryID uint64, priority int32) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("categoryID", categoryID))
+
+	result := d.database.Model(&DownloadTask{}).Where("of_category_id = ?", categoryID).Update("priority", priority)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("failed to update download task priority of category")
+		return result.Error
+	}
+
 	return nil
 }
 
@@ -202,3 +536,146 @@ func (d *downloadTaskDataAccessor) WithDatabaseTransaction(database Database) Do
 		logger:   d.logger,
 	}
 }
+
+// getDownloadTaskStatusColumns returns the columns to update to move download tasks to a status along with their
+// start and finish times, a download task going back to pending has not started yet.
+func getDownloadTaskStatusColumns(downloadStatus uint16, now time.Time) map[string]any {
+	columns := map[string]any{
+		"download_status": downloadStatus,
+	}
+
+	switch idm.DownloadStatus(downloadStatus) {
+	case idm.DownloadStatus_Pending:
+		columns["start_time"] = nil
+		columns["finish_time"] = nil
+	case idm.DownloadStatus_Downloading:
+		columns["start_time"] = now
+		columns["finish_time"] = nil
+	case idm.DownloadStatus_Failed, idm.DownloadStatus_Success, idm.DownloadStatus_Cancelled:
+		columns["finish_time"] = now
+	}
+
+	return columns
+}
+
+func applyDownloadTaskListFilter(database *gorm.DB, filter DownloadTaskListFilter) *gorm.DB {
+	if len(filter.DownloadStatusList) > 0 {
+		database = database.Where("download_status IN ?", filter.DownloadStatusList)
+	}
+
+	if len(filter.DownloadTypeList) > 0 {
+		database = database.Where("download_type IN ?", filter.DownloadTypeList)
+	}
+
+	if filter.URLSubstring != "" {
+		database = database.Where("download_url LIKE ?", "%"+escapeLikePattern(filter.URLSubstring)+"%")
+	}
+
+	if filter.URLHost != "" {
+		database = database.Where("download_url_host = ?", strings.ToLower(filter.URLHost))
+	}
+
+	if !filter.CreateTimeFrom.IsZero() {
+		database = database.Where("create_time >= ?", filter.CreateTimeFrom)
+	}
+
+	if !filter.CreateTimeTo.IsZero() {
+		database = database.Where("create_time < ?", filter.CreateTimeTo)
+	}
+
+	if !filter.UpdateTimeFrom.IsZero() {
+		database = database.Where("update_time >= ?", filter.UpdateTimeFrom)
+	}
+
+	if !filter.UpdateTimeTo.IsZero() {
+		database = database.Where("update_time < ?", filter.UpdateTimeTo)
+	}
+
+	if searchQuery := getFullTextSearchQuery(filter.SearchQuery); searchQuery != "" {
+		database = database.Where("MATCH (download_url, file_name) AGAINST (? IN BOOLEAN MODE)", searchQuery)
+	}
+
+	if len(filter.TagIDList) > 0 {
+		database = database.Where(
+			"download_task_id IN (SELECT of_download_task_id FROM download_task_tag WHERE of_tag_id IN ?)",
+			filter.TagIDList,
+		)
+	}
+
+	if len(filter.CategoryIDList) > 0 {
+		database = database.Where("of_category_id IN ?", filter.CategoryIDList)
+	}
+
+	return database
+}
+
+// applyDownloadTaskListCursor keeps the download tasks coming after the cursor in the order of the list, the
+// download task ID breaks ties. NULL file sizes come first in ascending order and last in descending order.
+func applyDownloadTaskListCursor(database *gorm.DB, sortColumn string, sort DownloadTaskListSort, cursor DownloadTaskListCursor) *gorm.DB {
+	var sortValue any
+	switch sort.Field {
+	case DownloadTaskListSortFieldCreateTime:
+		sortValue = cursor.CreateTime
+	case DownloadTaskListSortFieldFileSize:
+		if cursor.FileSize != nil {
+			sortValue = *cursor.FileSize
+		}
+	case DownloadTaskListSortFieldDownloadStatus:
+		sortValue = cursor.DownloadStatus
+	}
+
+	if sortValue == nil {
+		if sort.Descending {
+			return database.Where(fmt.Sprintf("(%s IS NULL AND download_task_id < ?)", sortColumn), cursor.DownloadTaskID)
+		}
+
+		return database.Where(fmt.Sprintf("(%s IS NOT NULL OR download_task_id > ?)", sortColumn), cursor.DownloadTaskID)
+	}
+
+	comparison := ">"
+	if sort.Descending {
+		comparison = "<"
+	}
+
+	condition := fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND download_task_id %[2]s ?)", sortColumn, comparison)
+	if sort.Descending {
+		condition += fmt.Sprintf(" OR %s IS NULL", sortColumn)
+	}
+
+	return database.Where("("+condition+")", sortValue, sortValue, cursor.DownloadTaskID)
+}
+
+func getDownloadTaskListSortColumn(sortField DownloadTaskListSortField) (string, error) {
+	switch sortField {
+	case DownloadTaskListSortFieldCreateTime:
+		return "create_time", nil
+	case DownloadTaskListSortFieldFileSize:
+		return "file_size", nil
+	case DownloadTaskListSortFieldDownloadStatus:
+		return "download_status", nil
+	default:
+		return "", fmt.Errorf("unsupported download task list sort field %d", sortField)
+	}
+}
+
+// getFullTextSearchQuery turns free text into a boolean mode full-text query requiring every word, the
+// operators of the boolean mode are dropped so that user input can not break the query.
+func getFullTextSearchQuery(searchQuery string) string {
+	wordList := strings.FieldsFunc(searchQuery, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(wordList) == 0 {
+		return ""
+	}
+
+	for i := range wordList {
+		wordList[i] = "+" + wordList[i]
+	}
+
+	wordList[len(wordList)-1] += "*"
+	return strings.Join(wordList, " ")
+}
+
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
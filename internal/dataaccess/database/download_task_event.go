@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+)
+
+// DownloadTaskEvent records a change of the status of a download task.
+type DownloadTaskEvent struct {
+	DownloadTaskEventID uint64    `gorm:"column:download_task_event_id;primaryKey"`
+	OfDownloadTaskID    uint64    `gorm:"column:of_download_task_id"`
+	FromDownloadStatus  uint16    `gorm:"column:from_download_status"`
+	ToDownloadStatus    uint16    `gorm:"column:to_download_status"`
+	EventTime           time.Time `gorm:"column:event_time"`
+	// Node is the host the status was changed on.
+	Node   string `gorm:"column:node"`
+	Reason string `gorm:"column:reason"`
+}
+
+type DownloadTaskEventDataAccessor interface {
+	CreateDownloadTaskEventList(ctx context.Context, downloadTaskEventList []DownloadTaskEvent) error
+	// GetDownloadTaskEventListOfDownloadTask returns the events of a download task, oldest first.
+	GetDownloadTaskEventListOfDownloadTask(ctx context.Context, downloadTaskID uint64) ([]DownloadTaskEvent, error)
+	WithDatabaseTransaction(database Database) DownloadTaskEventDataAccessor
+}
+
+func NewDownloadTaskEventDataAccessor(database Database, logger *zap.Logger) DownloadTaskEventDataAccessor {
+	return &downloadTaskEventDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type downloadTaskEventDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateDownloadTaskEventList implements DownloadTaskEventDataAccessor.
+func (d *downloadTaskEventDataAccessor) CreateDownloadTaskEventList(ctx context.Context, downloadTaskEventList []DownloadTaskEvent) error {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	if len(downloadTaskEventList) == 0 {
+		return nil
+	}
+
+	result := d.database.Create(&downloadTaskEventList)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating download task events")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetDownloadTaskEventListOfDownloadTask implements DownloadTaskEventDataAccessor.
+func (d *downloadTaskEventDataAccessor) GetDownloadTaskEventListOfDownloadTask(ctx context.Context, downloadTaskID uint64) ([]DownloadTaskEvent, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("downloadTaskID", downloadTaskID))
+
+	var downloadTaskEventList []DownloadTaskEvent
+	result := d.database.Where("of_download_task_id = ?", downloadTaskID).Order("download_task_event_id").Find(&downloadTaskEventList)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting download task event list")
+		return nil, result.Error
+	}
+
+	return downloadTaskEventList, nil
+}
+
+// WithDatabaseTransaction implements DownloadTaskEventDataAccessor.
+func (d *downloadTaskEventDataAccessor) WithDatabaseTransaction(database Database) DownloadTaskEventDataAccessor {
+	return &downloadTaskEventDataAccessor{
+		database: database,
+		logger:   d.logger,
+	}
+}
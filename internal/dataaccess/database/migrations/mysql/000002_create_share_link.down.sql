@@ -0,0 +1,2 @@
+-- Drop share_link table
+DROP TABLE IF EXISTS `share_link`;
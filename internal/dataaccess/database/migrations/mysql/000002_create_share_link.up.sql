@@ -0,0 +1,13 @@
+-- Create share_link table
+CREATE TABLE IF NOT EXISTS `share_link` (
+    `share_link_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_download_task_id` BIGINT UNSIGNED NOT NULL,
+    `of_account_id` BIGINT UNSIGNED NOT NULL,
+    `hashed_password` VARCHAR(128) NOT NULL DEFAULT '',
+    `max_download_count` BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    `download_count` BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    `expire_time` DATETIME NOT NULL,
+    `is_revoked` BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY (`of_download_task_id`) REFERENCES `download_task` (`download_task_id`) ON DELETE CASCADE,
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`account_id`)
+);
@@ -0,0 +1,2 @@
+-- Drop session table
+DROP TABLE IF EXISTS `session`;
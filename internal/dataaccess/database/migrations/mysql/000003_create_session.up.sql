@@ -0,0 +1,13 @@
+-- Create session table
+CREATE TABLE IF NOT EXISTS `session` (
+    `session_id` CHAR(32) PRIMARY KEY,
+    `of_account_id` BIGINT UNSIGNED NOT NULL,
+    `user_agent` VARCHAR(512) NOT NULL DEFAULT '',
+    `ip_address` VARCHAR(64) NOT NULL DEFAULT '',
+    `create_time` DATETIME NOT NULL,
+    `last_seen_time` DATETIME NOT NULL,
+    `expire_time` DATETIME NOT NULL,
+    `is_revoked` BOOLEAN NOT NULL DEFAULT FALSE,
+    INDEX `idx_session_of_account_id_expire_time` (`of_account_id`, `expire_time`),
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`account_id`)
+);
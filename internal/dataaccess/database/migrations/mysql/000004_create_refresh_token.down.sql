@@ -0,0 +1,2 @@
+-- Drop refresh_token table
+DROP TABLE IF EXISTS `refresh_token`;
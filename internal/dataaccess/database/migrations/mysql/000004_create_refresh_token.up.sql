@@ -0,0 +1,9 @@
+-- Create refresh_token table
+CREATE TABLE IF NOT EXISTS `refresh_token` (
+    `refresh_token_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_session_id` CHAR(32) NOT NULL,
+    `hashed_token` CHAR(64) UNIQUE NOT NULL,
+    `expire_time` DATETIME NOT NULL,
+    `is_used` BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY (`of_session_id`) REFERENCES `session` (`session_id`)
+);
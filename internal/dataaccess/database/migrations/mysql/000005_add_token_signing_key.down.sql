@@ -0,0 +1,5 @@
+-- Drop signing key columns of token_public_key table
+ALTER TABLE `token_public_key`
+    DROP COLUMN `encrypted_private_key`,
+    DROP COLUMN `create_time`,
+    DROP COLUMN `retire_time`;
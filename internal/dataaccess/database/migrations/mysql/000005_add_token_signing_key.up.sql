@@ -0,0 +1,8 @@
+-- Store the encrypted private key next to each public key so that replicas share signing keys
+ALTER TABLE `token_public_key`
+    ADD COLUMN `encrypted_private_key` VARBINARY(8192) NOT NULL DEFAULT '',
+    ADD COLUMN `create_time` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN `retire_time` DATETIME NULL;
+
+-- Keys created before this migration have no private key, they are only kept to verify existing tokens
+UPDATE `token_public_key` SET `retire_time` = CURRENT_TIMESTAMP;
@@ -0,0 +1,2 @@
+-- Drop account_external_identity table
+DROP TABLE IF EXISTS `account_external_identity`;
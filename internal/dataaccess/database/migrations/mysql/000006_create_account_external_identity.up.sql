@@ -0,0 +1,11 @@
+-- Create account_external_identity table
+CREATE TABLE IF NOT EXISTS `account_external_identity` (
+    `account_external_identity_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_account_id` BIGINT UNSIGNED NOT NULL,
+    `provider` VARCHAR(64) NOT NULL,
+    `subject` VARCHAR(255) NOT NULL,
+    `email` VARCHAR(320) NOT NULL DEFAULT '',
+    `create_time` DATETIME NOT NULL,
+    UNIQUE KEY `uk_account_external_identity_provider_subject` (`provider`, `subject`),
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`account_id`)
+);
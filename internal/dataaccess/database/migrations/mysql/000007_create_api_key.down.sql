@@ -0,0 +1,2 @@
+-- Drop api_key table
+DROP TABLE IF EXISTS `api_key`;
@@ -0,0 +1,15 @@
+-- Create api_key table
+CREATE TABLE IF NOT EXISTS `api_key` (
+    `api_key_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_account_id` BIGINT UNSIGNED NOT NULL,
+    `name` VARCHAR(64) NOT NULL,
+    `key_prefix` VARCHAR(16) NOT NULL,
+    `hashed_key` CHAR(64) NOT NULL UNIQUE,
+    `scopes` VARCHAR(255) NOT NULL,
+    `create_time` DATETIME NOT NULL,
+    `last_used_time` DATETIME NULL,
+    `expire_time` DATETIME NULL,
+    `is_revoked` BOOLEAN NOT NULL DEFAULT FALSE,
+    INDEX `idx_api_key_of_account_id` (`of_account_id`),
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`account_id`)
+);
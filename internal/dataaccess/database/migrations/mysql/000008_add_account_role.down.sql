@@ -0,0 +1,4 @@
+-- Drop role and disabled flag from account table
+ALTER TABLE `account`
+    DROP COLUMN `is_disabled`,
+    DROP COLUMN `role`;
@@ -0,0 +1,4 @@
+-- Add role and disabled flag to account table
+ALTER TABLE `account`
+    ADD COLUMN `role` SMALLINT NOT NULL DEFAULT 1,
+    ADD COLUMN `is_disabled` BOOLEAN NOT NULL DEFAULT FALSE;
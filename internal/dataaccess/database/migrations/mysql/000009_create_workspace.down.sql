@@ -0,0 +1,9 @@
+-- Drop workspace of download_task table
+ALTER TABLE `download_task`
+    DROP FOREIGN KEY `fk_download_task_of_workspace_id`,
+    DROP INDEX `idx_download_task_of_workspace_id`,
+    DROP COLUMN `of_workspace_id`;
+
+-- Drop workspace_member and workspace tables
+DROP TABLE IF EXISTS `workspace_member`;
+DROP TABLE IF EXISTS `workspace`;
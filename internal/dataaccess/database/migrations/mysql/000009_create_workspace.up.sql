@@ -0,0 +1,40 @@
+-- Create workspace table, personal workspaces belong to a single account
+CREATE TABLE IF NOT EXISTS `workspace` (
+    `workspace_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `name` VARCHAR(64) NOT NULL,
+    `personal_of_account_id` BIGINT UNSIGNED NULL UNIQUE,
+    `create_time` DATETIME NOT NULL,
+    FOREIGN KEY (`personal_of_account_id`) REFERENCES `account` (`account_id`)
+);
+
+-- Create workspace_member table
+CREATE TABLE IF NOT EXISTS `workspace_member` (
+    `of_workspace_id` BIGINT UNSIGNED NOT NULL,
+    `of_account_id` BIGINT UNSIGNED NOT NULL,
+    `role` SMALLINT NOT NULL,
+    `create_time` DATETIME NOT NULL,
+    PRIMARY KEY (`of_workspace_id`, `of_account_id`),
+    INDEX `idx_workspace_member_of_account_id` (`of_account_id`),
+    FOREIGN KEY (`of_workspace_id`) REFERENCES `workspace` (`workspace_id`),
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`account_id`)
+);
+
+-- Give every existing account a personal workspace it manages
+INSERT INTO `workspace` (`name`, `personal_of_account_id`, `create_time`)
+    SELECT `account_name`, `account_id`, CURRENT_TIMESTAMP FROM `account`;
+
+INSERT INTO `workspace_member` (`of_workspace_id`, `of_account_id`, `role`, `create_time`)
+    SELECT `workspace_id`, `personal_of_account_id`, 3, CURRENT_TIMESTAMP FROM `workspace`;
+
+-- Move existing download tasks into the personal workspace of their account, of_account_id is kept as
+-- the account that created the task
+ALTER TABLE `download_task` ADD COLUMN `of_workspace_id` BIGINT UNSIGNED NULL;
+
+UPDATE `download_task`
+    INNER JOIN `workspace` ON `workspace`.`personal_of_account_id` = `download_task`.`of_account_id`
+    SET `download_task`.`of_workspace_id` = `workspace`.`workspace_id`;
+
+ALTER TABLE `download_task`
+    MODIFY COLUMN `of_workspace_id` BIGINT UNSIGNED NOT NULL,
+    ADD INDEX `idx_download_task_of_workspace_id` (`of_workspace_id`),
+    ADD CONSTRAINT `fk_download_task_of_workspace_id` FOREIGN KEY (`of_workspace_id`) REFERENCES `workspace` (`workspace_id`);
@@ -0,0 +1,10 @@
+-- Create password_reset_token table
+CREATE TABLE IF NOT EXISTS `password_reset_token` (
+    `password_reset_token_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_account_id` BIGINT UNSIGNED NOT NULL,
+    `hashed_token` CHAR(64) UNIQUE NOT NULL,
+    `create_time` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `expire_time` DATETIME NOT NULL,
+    `is_used` BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`account_id`)
+);
@@ -0,0 +1,5 @@
+-- Drop account_recovery_code table
+DROP TABLE IF EXISTS `account_recovery_code`;
+
+-- Drop account_totp table
+DROP TABLE IF EXISTS `account_totp`;
@@ -0,0 +1,19 @@
+-- Create account_totp table
+CREATE TABLE IF NOT EXISTS `account_totp` (
+    `of_account_id` BIGINT UNSIGNED PRIMARY KEY,
+    `encrypted_secret` VARBINARY(128) NOT NULL,
+    `is_activated` BOOLEAN NOT NULL DEFAULT FALSE,
+    `last_used_time_step` BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    `create_time` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`account_id`)
+);
+
+-- Create account_recovery_code table
+CREATE TABLE IF NOT EXISTS `account_recovery_code` (
+    `account_recovery_code_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_account_id` BIGINT UNSIGNED NOT NULL,
+    `hashed_code` CHAR(64) NOT NULL,
+    `is_used` BOOLEAN NOT NULL DEFAULT FALSE,
+    UNIQUE KEY `uk_account_recovery_code` (`of_account_id`, `hashed_code`),
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`account_id`)
+);
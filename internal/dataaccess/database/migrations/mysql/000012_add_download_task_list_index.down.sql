@@ -0,0 +1,14 @@
+-- Drop the download task list indexes and columns
+DROP INDEX `ftx_download_task_download_url_file_name` ON `download_task`;
+DROP INDEX `idx_download_task_of_workspace_id_download_url_host` ON `download_task`;
+DROP INDEX `idx_download_task_of_workspace_id_file_size` ON `download_task`;
+DROP INDEX `idx_download_task_of_workspace_id_download_status` ON `download_task`;
+DROP INDEX `idx_download_task_of_workspace_id_update_time` ON `download_task`;
+DROP INDEX `idx_download_task_of_workspace_id_create_time` ON `download_task`;
+
+ALTER TABLE `download_task`
+    DROP COLUMN `file_size`,
+    DROP COLUMN `file_name`,
+    DROP COLUMN `download_url_host`,
+    DROP COLUMN `update_time`,
+    DROP COLUMN `create_time`;
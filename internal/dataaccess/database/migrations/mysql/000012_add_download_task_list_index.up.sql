@@ -0,0 +1,22 @@
+-- Add the columns download task lists are filtered and sorted by. The URL host, file name and file size are
+-- derived from the download URL and the metadata so that every writer keeps them up to date.
+ALTER TABLE `download_task`
+    ADD COLUMN `create_time` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN `update_time` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    ADD COLUMN `download_url_host` VARCHAR(255) AS (
+        LOWER(LEFT(SUBSTRING_INDEX(SUBSTRING_INDEX(SUBSTRING_INDEX(SUBSTRING_INDEX(SUBSTRING_INDEX(SUBSTRING_INDEX(
+            `download_url`, '://', -1), '/', 1), '?', 1), '#', 1), '@', -1), ':', 1), 255))
+    ) STORED,
+    ADD COLUMN `file_name` VARCHAR(255) AS (
+        IF(JSON_VALID(`metadata`), LEFT(JSON_UNQUOTE(JSON_EXTRACT(`metadata`, '$."file-name"')), 255), NULL)
+    ) STORED,
+    ADD COLUMN `file_size` BIGINT UNSIGNED AS (
+        IF(JSON_VALID(`metadata`), CAST(JSON_EXTRACT(`metadata`, '$."file-size"') AS UNSIGNED), NULL)
+    ) STORED;
+
+CREATE INDEX `idx_download_task_of_workspace_id_create_time` ON `download_task` (`of_workspace_id`, `create_time`);
+CREATE INDEX `idx_download_task_of_workspace_id_update_time` ON `download_task` (`of_workspace_id`, `update_time`);
+CREATE INDEX `idx_download_task_of_workspace_id_download_status` ON `download_task` (`of_workspace_id`, `download_status`);
+CREATE INDEX `idx_download_task_of_workspace_id_file_size` ON `download_task` (`of_workspace_id`, `file_size`);
+CREATE INDEX `idx_download_task_of_workspace_id_download_url_host` ON `download_task` (`of_workspace_id`, `download_url_host`);
+CREATE FULLTEXT INDEX `ftx_download_task_download_url_file_name` ON `download_task` (`download_url`, `file_name`);
@@ -0,0 +1,6 @@
+-- Drop download_task_event table and the download task start and finish times
+DROP TABLE IF EXISTS `download_task_event`;
+
+ALTER TABLE `download_task`
+    DROP COLUMN `finish_time`,
+    DROP COLUMN `start_time`;
@@ -0,0 +1,17 @@
+-- Add the times a download task last started and finished downloading
+ALTER TABLE `download_task`
+    ADD COLUMN `start_time` DATETIME NULL,
+    ADD COLUMN `finish_time` DATETIME NULL;
+
+-- Create download_task_event table, each row is a change of the status of a download task
+CREATE TABLE IF NOT EXISTS `download_task_event` (
+    `download_task_event_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_download_task_id` BIGINT UNSIGNED NOT NULL,
+    `from_download_status` SMALLINT NOT NULL,
+    `to_download_status` SMALLINT NOT NULL,
+    `event_time` DATETIME NOT NULL,
+    `node` VARCHAR(255) NOT NULL,
+    `reason` VARCHAR(1024) NOT NULL DEFAULT '',
+    INDEX `idx_download_task_event_of_download_task_id` (`of_download_task_id`, `download_task_event_id`),
+    FOREIGN KEY (`of_download_task_id`) REFERENCES `download_task` (`download_task_id`) ON DELETE CASCADE
+);
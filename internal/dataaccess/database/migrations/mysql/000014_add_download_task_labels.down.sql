@@ -0,0 +1,7 @@
+-- Search download tasks by the name their file is stored under again and drop the labels
+ALTER TABLE `download_task`
+    MODIFY COLUMN `file_name` VARCHAR(255) AS (
+        IF(JSON_VALID(`metadata`), LEFT(JSON_UNQUOTE(JSON_EXTRACT(`metadata`, '$."file-name"')), 255), NULL)
+    ) STORED;
+
+ALTER TABLE `download_task` DROP COLUMN `labels`;
@@ -0,0 +1,12 @@
+-- Add the labels clients set on download tasks, the metadata is only written by the server from now on
+ALTER TABLE `download_task` ADD COLUMN `labels` TEXT NOT NULL DEFAULT ('{}');
+
+-- Search download tasks by the name their file is saved with instead of the name it is stored under, tasks
+-- not downloaded yet are searched by the last segment of their URL
+ALTER TABLE `download_task`
+    MODIFY COLUMN `file_name` VARCHAR(255) AS (
+        LEFT(COALESCE(
+            IF(JSON_VALID(`metadata`), JSON_UNQUOTE(JSON_EXTRACT(`metadata`, '$."display-file-name"')), NULL),
+            SUBSTRING_INDEX(SUBSTRING_INDEX(SUBSTRING_INDEX(`download_url`, '?', 1), '#', 1), '/', -1)
+        ), 255)
+    ) STORED;
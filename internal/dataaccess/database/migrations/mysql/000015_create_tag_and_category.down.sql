@@ -0,0 +1,12 @@
+-- Drop the category and priority of download tasks
+ALTER TABLE `download_task`
+    DROP FOREIGN KEY `fk_download_task_of_category_id`,
+    DROP INDEX `idx_download_task_download_status_priority`,
+    DROP INDEX `idx_download_task_of_workspace_id_of_category_id`,
+    DROP COLUMN `priority`,
+    DROP COLUMN `of_category_id`;
+
+-- Drop category, download_task_tag and tag tables
+DROP TABLE IF EXISTS `category`;
+DROP TABLE IF EXISTS `download_task_tag`;
+DROP TABLE IF EXISTS `tag`;
@@ -0,0 +1,42 @@
+-- Create tag table, tags are named by the members of a workspace and set on any number of its download tasks
+CREATE TABLE IF NOT EXISTS `tag` (
+    `tag_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_workspace_id` BIGINT UNSIGNED NOT NULL,
+    `name` VARCHAR(64) NOT NULL,
+    `create_time` DATETIME NOT NULL,
+    UNIQUE INDEX `idx_tag_of_workspace_id_name` (`of_workspace_id`, `name`),
+    FOREIGN KEY (`of_workspace_id`) REFERENCES `workspace` (`workspace_id`)
+);
+
+-- Create download_task_tag table, each row sets a tag on a download task
+CREATE TABLE IF NOT EXISTS `download_task_tag` (
+    `of_download_task_id` BIGINT UNSIGNED NOT NULL,
+    `of_tag_id` BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (`of_download_task_id`, `of_tag_id`),
+    INDEX `idx_download_task_tag_of_tag_id` (`of_tag_id`),
+    FOREIGN KEY (`of_download_task_id`) REFERENCES `download_task` (`download_task_id`) ON DELETE CASCADE,
+    FOREIGN KEY (`of_tag_id`) REFERENCES `tag` (`tag_id`) ON DELETE CASCADE
+);
+
+-- Create category table, a download task has at most one category which gives it its defaults
+CREATE TABLE IF NOT EXISTS `category` (
+    `category_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_workspace_id` BIGINT UNSIGNED NOT NULL,
+    `name` VARCHAR(64) NOT NULL,
+    `storage_prefix` VARCHAR(128) NOT NULL DEFAULT '',
+    `compression` SMALLINT NOT NULL DEFAULT 0,
+    `priority` INT NOT NULL DEFAULT 0,
+    `content_type_list` TEXT NOT NULL,
+    `file_extension_list` TEXT NOT NULL,
+    `create_time` DATETIME NOT NULL,
+    UNIQUE INDEX `idx_category_of_workspace_id_name` (`of_workspace_id`, `name`),
+    FOREIGN KEY (`of_workspace_id`) REFERENCES `workspace` (`workspace_id`)
+);
+
+-- Add the category of download tasks and the priority pending ones are executed in
+ALTER TABLE `download_task`
+    ADD COLUMN `of_category_id` BIGINT UNSIGNED NULL,
+    ADD COLUMN `priority` INT NOT NULL DEFAULT 0,
+    ADD INDEX `idx_download_task_of_workspace_id_of_category_id` (`of_workspace_id`, `of_category_id`),
+    ADD INDEX `idx_download_task_download_status_priority` (`download_status`, `priority`),
+    ADD CONSTRAINT `fk_download_task_of_category_id` FOREIGN KEY (`of_category_id`) REFERENCES `category` (`category_id`) ON DELETE SET NULL;
@@ -0,0 +1,3 @@
+-- Drop webhook_delivery and webhook tables
+DROP TABLE IF EXISTS `webhook_delivery`;
+DROP TABLE IF EXISTS `webhook`;
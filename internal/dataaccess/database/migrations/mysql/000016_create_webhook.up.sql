@@ -0,0 +1,31 @@
+-- Create webhook table, webhooks are called on the lifecycle events of the download tasks of their account
+CREATE TABLE IF NOT EXISTS `webhook` (
+    `webhook_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_account_id` BIGINT UNSIGNED NOT NULL,
+    `url` VARCHAR(2048) NOT NULL,
+    `encrypted_secret` VARBINARY(256) NOT NULL,
+    `event_type_list` VARCHAR(256) NOT NULL,
+    `create_time` DATETIME NOT NULL,
+    INDEX `idx_webhook_of_account_id` (`of_account_id`),
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`account_id`)
+);
+
+-- Create webhook_delivery table, each row is a call of a webhook on an event, retried until it succeeds or
+-- runs out of attempts
+CREATE TABLE IF NOT EXISTS `webhook_delivery` (
+    `webhook_delivery_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_webhook_id` BIGINT UNSIGNED NOT NULL,
+    `of_download_task_id` BIGINT UNSIGNED NOT NULL,
+    `event_type` SMALLINT NOT NULL,
+    `payload` MEDIUMTEXT NOT NULL,
+    `delivery_status` SMALLINT NOT NULL,
+    `attempt_count` INT UNSIGNED NOT NULL DEFAULT 0,
+    `response_status_code` INT UNSIGNED NOT NULL DEFAULT 0,
+    `error` VARCHAR(1024) NOT NULL DEFAULT '',
+    `create_time` DATETIME NOT NULL,
+    `last_attempt_time` DATETIME NULL,
+    `next_attempt_time` DATETIME NULL,
+    INDEX `idx_webhook_delivery_of_webhook_id` (`of_webhook_id`, `webhook_delivery_id`),
+    INDEX `idx_webhook_delivery_delivery_status_next_attempt_time` (`delivery_status`, `next_attempt_time`),
+    FOREIGN KEY (`of_webhook_id`) REFERENCES `webhook` (`webhook_id`) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+-- Drop outbox_message table
+DROP TABLE IF EXISTS `outbox_message`;
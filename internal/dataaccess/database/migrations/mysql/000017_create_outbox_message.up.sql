@@ -0,0 +1,10 @@
+-- Create outbox_message table, messages are saved in the transaction of the change they are about and produced
+-- to their queue by the relay afterwards
+CREATE TABLE IF NOT EXISTS `outbox_message` (
+    `outbox_message_id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `queue_name` VARCHAR(256) NOT NULL,
+    `payload` MEDIUMBLOB NOT NULL,
+    `create_time` DATETIME NOT NULL,
+    `sent_time` DATETIME NULL,
+    INDEX `idx_outbox_message_sent_time` (`sent_time`, `outbox_message_id`)
+);
@@ -0,0 +1,3 @@
+-- Drop the key of outbox messages
+ALTER TABLE `outbox_message`
+    DROP COLUMN `message_key`;
@@ -0,0 +1,3 @@
+-- Add the key outbox messages are produced with, messages with the same key are consumed in order
+ALTER TABLE `outbox_message`
+    ADD COLUMN `message_key` VARCHAR(256) NOT NULL DEFAULT '' AFTER `queue_name`;
@@ -0,0 +1,124 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxMessage is a message saved in the transaction of the change it is about, it is produced to its queue
+// once the transaction is committed.
+type OutboxMessage struct {
+	OutboxMessageID uint64 `gorm:"column:outbox_message_id;primaryKey"`
+	QueueName       string `gorm:"column:queue_name"`
+	// MessageKey is the key the message is produced with, empty for messages without key.
+	MessageKey string    `gorm:"column:message_key"`
+	Payload    []byte    `gorm:"column:payload"`
+	CreateTime time.Time `gorm:"column:create_time;autoCreateTime"`
+	// SentTime is nil until the message is produced.
+	SentTime *time.Time `gorm:"column:sent_time"`
+}
+
+type OutboxMessageDataAccessor interface {
+	CreateOutboxMessageList(ctx context.Context, outboxMessageList []OutboxMessage) error
+	// GetUnsentOutboxMessageListForUpdate returns the oldest unsent messages and locks them until the end of the
+	// transaction, other transactions calling it wait for the lock instead of returning later messages.
+	GetUnsentOutboxMessageListForUpdate(ctx context.Context, limit int) ([]OutboxMessage, error)
+	UpdateOutboxMessageListSentTime(ctx context.Context, outboxMessageIDList []uint64, sentTime time.Time) error
+	DeleteOutboxMessageListSentBefore(ctx context.Context, sentTime time.Time) error
+	WithDatabaseTransaction(database Database) OutboxMessageDataAccessor
+}
+
+func NewOutboxMessageDataAccessor(database Database, logger *zap.Logger) OutboxMessageDataAccessor {
+	return &outboxMessageDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type outboxMessageDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateOutboxMessageList implements OutboxMessageDataAccessor.
+func (o *outboxMessageDataAccessor) CreateOutboxMessageList(ctx context.Context, outboxMessageList []OutboxMessage) error {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	if len(outboxMessageList) == 0 {
+		return nil
+	}
+
+	result := o.database.Create(&outboxMessageList)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating outbox message list")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetUnsentOutboxMessageListForUpdate implements OutboxMessageDataAccessor.
+func (o *outboxMessageDataAccessor) GetUnsentOutboxMessageListForUpdate(ctx context.Context, limit int) ([]OutboxMessage, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Int("limit", limit))
+
+	var outboxMessages []OutboxMessage
+	result := o.database.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("sent_time IS NULL").
+		Order("outbox_message_id").
+		Limit(limit).
+		Find(&outboxMessages)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting unsent outbox message list for update")
+		return nil, result.Error
+	}
+
+	return outboxMessages, nil
+}
+
+// UpdateOutboxMessageListSentTime implements OutboxMessageDataAccessor.
+func (o *outboxMessageDataAccessor) UpdateOutboxMessageListSentTime(
+	ctx context.Context,
+	outboxMessageIDList []uint64,
+	sentTime time.Time,
+) error {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	if len(outboxMessageIDList) == 0 {
+		return nil
+	}
+
+	result := o.database.Model(&OutboxMessage{}).
+		Where("outbox_message_id IN ?", outboxMessageIDList).
+		Update("sent_time", sentTime)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error updating outbox message list sent time")
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeleteOutboxMessageListSentBefore implements OutboxMessageDataAccessor.
+func (o *outboxMessageDataAccessor) DeleteOutboxMessageListSentBefore(ctx context.Context, sentTime time.Time) error {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	result := o.database.Where("sent_time < ?", sentTime).Delete(&OutboxMessage{})
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error deleting outbox message list sent before")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements OutboxMessageDataAccessor.
+func (o *outboxMessageDataAccessor) WithDatabaseTransaction(database Database) OutboxMessageDataAccessor {
+	return &outboxMessageDataAccessor{
+		database: database,
+		logger:   o.logger,
+	}
+}
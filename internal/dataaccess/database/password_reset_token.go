@@ -0,0 +1,116 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrPasswordResetTokenNotFound = errors.New("password reset token not found")
+)
+
+type PasswordResetToken struct {
+	PasswordResetTokenID uint64    `gorm:"column:password_reset_token_id;primaryKey"`
+	OfAccountID          uint64    `gorm:"column:of_account_id"`
+	HashedToken          string    `gorm:"column:hashed_token"`
+	CreateTime           time.Time `gorm:"column:create_time"`
+	ExpireTime           time.Time `gorm:"column:expire_time"`
+	IsUsed               bool      `gorm:"column:is_used"`
+}
+
+type PasswordResetTokenDataAccessor interface {
+	CreatePasswordResetToken(ctx context.Context, passwordResetToken PasswordResetToken) error
+	GetPasswordResetTokenByHashedTokenForUpdate(ctx context.Context, hashedToken string) (PasswordResetToken, error)
+	MarkPasswordResetTokenUsed(ctx context.Context, passwordResetTokenID uint64) error
+	// MarkPasswordResetTokenListOfAccountUsed makes every unused password reset token of an account unusable.
+	MarkPasswordResetTokenListOfAccountUsed(ctx context.Context, accountID uint64) error
+	WithDatabaseTransaction(database Database) PasswordResetTokenDataAccessor
+}
+
+func NewPasswordResetTokenDataAccessor(database Database, logger *zap.Logger) PasswordResetTokenDataAccessor {
+	return &passwordResetTokenDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type passwordResetTokenDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreatePasswordResetToken implements PasswordResetTokenDataAccessor.
+func (p *passwordResetTokenDataAccessor) CreatePasswordResetToken(ctx context.Context, passwordResetToken PasswordResetToken) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("accountID", passwordResetToken.OfAccountID))
+
+	result := p.database.Create(&passwordResetToken)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating password reset token")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetPasswordResetTokenByHashedTokenForUpdate implements PasswordResetTokenDataAccessor.
+func (p *passwordResetTokenDataAccessor) GetPasswordResetTokenByHashedTokenForUpdate(ctx context.Context, hashedToken string) (PasswordResetToken, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var passwordResetToken PasswordResetToken
+	result := p.database.Clauses(clause.Locking{Strength: "UPDATE"}).Where("hashed_token = ?", hashedToken).First(&passwordResetToken)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return PasswordResetToken{}, ErrPasswordResetTokenNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting password reset token")
+		return PasswordResetToken{}, result.Error
+	}
+
+	return passwordResetToken, nil
+}
+
+// MarkPasswordResetTokenUsed implements PasswordResetTokenDataAccessor.
+func (p *passwordResetTokenDataAccessor) MarkPasswordResetTokenUsed(ctx context.Context, passwordResetTokenID uint64) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("passwordResetTokenID", passwordResetTokenID))
+
+	result := p.database.Model(&PasswordResetToken{}).
+		Where("password_reset_token_id = ?", passwordResetTokenID).
+		Update("is_used", true)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error marking password reset token used")
+		return result.Error
+	}
+
+	return nil
+}
+
+// MarkPasswordResetTokenListOfAccountUsed implements PasswordResetTokenDataAccessor.
+func (p *passwordResetTokenDataAccessor) MarkPasswordResetTokenListOfAccountUsed(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("accountID", accountID))
+
+	result := p.database.Model(&PasswordResetToken{}).
+		Where("of_account_id = ?", accountID).
+		Where("is_used = ?", false).
+		Update("is_used", true)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error marking password reset tokens of account used")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements PasswordResetTokenDataAccessor.
+func (p *passwordResetTokenDataAccessor) WithDatabaseTransaction(database Database) PasswordResetTokenDataAccessor {
+	return &passwordResetTokenDataAccessor{
+		database: database,
+		logger:   p.logger,
+	}
+}
@@ -0,0 +1,95 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+)
+
+type RefreshToken struct {
+	RefreshTokenID uint64    `gorm:"column:refresh_token_id;primaryKey"`
+	OfSessionID    string    `gorm:"column:of_session_id"`
+	HashedToken    string    `gorm:"column:hashed_token"`
+	ExpireTime     time.Time `gorm:"column:expire_time"`
+	IsUsed         bool      `gorm:"column:is_used"`
+}
+
+type RefreshTokenDataAccessor interface {
+	CreateRefreshToken(ctx context.Context, refreshToken RefreshToken) error
+	GetRefreshTokenByHashedTokenForUpdate(ctx context.Context, hashedToken string) (RefreshToken, error)
+	MarkRefreshTokenUsed(ctx context.Context, refreshTokenID uint64) error
+	WithDatabaseTransaction(database Database) RefreshTokenDataAccessor
+}
+
+func NewRefreshTokenDataAccessor(database Database, logger *zap.Logger) RefreshTokenDataAccessor {
+	return &refreshTokenDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type refreshTokenDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateRefreshToken implements RefreshTokenDataAccessor.
+func (r *refreshTokenDataAccessor) CreateRefreshToken(ctx context.Context, refreshToken RefreshToken) error {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.String("sessionID", refreshToken.OfSessionID))
+
+	result := r.database.Create(&refreshToken)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating refresh token")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetRefreshTokenByHashedTokenForUpdate implements RefreshTokenDataAccessor.
+func (r *refreshTokenDataAccessor) GetRefreshTokenByHashedTokenForUpdate(ctx context.Context, hashedToken string) (RefreshToken, error) {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	var refreshToken RefreshToken
+	result := r.database.Clauses(clause.Locking{Strength: "UPDATE"}).Where("hashed_token = ?", hashedToken).First(&refreshToken)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return RefreshToken{}, ErrRefreshTokenNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting refresh token")
+		return RefreshToken{}, result.Error
+	}
+
+	return refreshToken, nil
+}
+
+// MarkRefreshTokenUsed implements RefreshTokenDataAccessor.
+func (r *refreshTokenDataAccessor) MarkRefreshTokenUsed(ctx context.Context, refreshTokenID uint64) error {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.Uint64("refreshTokenID", refreshTokenID))
+
+	result := r.database.Model(&RefreshToken{}).Where("refresh_token_id = ?", refreshTokenID).Update("is_used", true)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error marking refresh token used")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements RefreshTokenDataAccessor.
+func (r *refreshTokenDataAccessor) WithDatabaseTransaction(database Database) RefreshTokenDataAccessor {
+	return &refreshTokenDataAccessor{
+		database: database,
+		logger:   r.logger,
+	}
+}
@@ -0,0 +1,190 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+type Session struct {
+	SessionID    string    `gorm:"column:session_id;primaryKey"`
+	OfAccountID  uint64    `gorm:"column:of_account_id"`
+	UserAgent    string    `gorm:"column:user_agent"`
+	IPAddress    string    `gorm:"column:ip_address"`
+	CreateTime   time.Time `gorm:"column:create_time"`
+	LastSeenTime time.Time `gorm:"column:last_seen_time"`
+	ExpireTime   time.Time `gorm:"column:expire_time"`
+	IsRevoked    bool      `gorm:"column:is_revoked"`
+}
+
+type SessionDataAccessor interface {
+	CreateSession(ctx context.Context, session Session) error
+	GetSession(ctx context.Context, sessionID string) (Session, error)
+	GetActiveSessionListOfAccount(ctx context.Context, accountID uint64) ([]Session, error)
+	GetRevokedUnexpiredSessionList(ctx context.Context) ([]Session, error)
+	GetActiveSessionCount(ctx context.Context) (uint64, error)
+	// UpdateSessionLastSeenTime only updates sessions last seen more than minUpdateInterval before lastSeenTime.
+	UpdateSessionLastSeenTime(ctx context.Context, sessionID string, lastSeenTime time.Time, minUpdateInterval time.Duration) error
+	UpdateSessionExpireTime(ctx context.Context, sessionID string, expireTime time.Time) error
+	RevokeSession(ctx context.Context, sessionID string) error
+	WithDatabaseTransaction(database Database) SessionDataAccessor
+}
+
+func NewSessionDataAccessor(database Database, logger *zap.Logger) SessionDataAccessor {
+	return &sessionDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type sessionDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateSession implements SessionDataAccessor.
+func (s *sessionDataAccessor) CreateSession(ctx context.Context, session Session) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("accountID", session.OfAccountID))
+
+	result := s.database.Create(&session)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating session")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetSession implements SessionDataAccessor.
+func (s *sessionDataAccessor) GetSession(ctx context.Context, sessionID string) (Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("sessionID", sessionID))
+
+	var session Session
+	result := s.database.Where("session_id = ?", sessionID).First(&session)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Session{}, ErrSessionNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting session")
+		return Session{}, result.Error
+	}
+
+	return session, nil
+}
+
+// GetActiveSessionListOfAccount implements SessionDataAccessor.
+func (s *sessionDataAccessor) GetActiveSessionListOfAccount(ctx context.Context, accountID uint64) ([]Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("accountID", accountID))
+
+	var sessions []Session
+	result := s.database.
+		Where("of_account_id = ?", accountID).
+		Where("is_revoked = ?", false).
+		Where("expire_time > ?", time.Now()).
+		Order("last_seen_time DESC").
+		Find(&sessions)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting active session list")
+		return nil, result.Error
+	}
+
+	return sessions, nil
+}
+
+// GetRevokedUnexpiredSessionList implements SessionDataAccessor.
+func (s *sessionDataAccessor) GetRevokedUnexpiredSessionList(ctx context.Context) ([]Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	var sessions []Session
+	result := s.database.
+		Where("is_revoked = ?", true).
+		Where("expire_time > ?", time.Now()).
+		Find(&sessions)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting revoked session list")
+		return nil, result.Error
+	}
+
+	return sessions, nil
+}
+
+// GetActiveSessionCount implements SessionDataAccessor.
+func (s *sessionDataAccessor) GetActiveSessionCount(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	var count int64
+	result := s.database.Model(&Session{}).
+		Where("is_revoked = ?", false).
+		Where("expire_time > ?", time.Now()).
+		Count(&count)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting active session count")
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// UpdateSessionLastSeenTime implements SessionDataAccessor.
+func (s *sessionDataAccessor) UpdateSessionLastSeenTime(
+	ctx context.Context,
+	sessionID string,
+	lastSeenTime time.Time,
+	minUpdateInterval time.Duration,
+) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("sessionID", sessionID))
+
+	result := s.database.Model(&Session{}).
+		Where("session_id = ?", sessionID).
+		Where("last_seen_time < ?", lastSeenTime.Add(-minUpdateInterval)).
+		Update("last_seen_time", lastSeenTime)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error updating session last seen time")
+		return result.Error
+	}
+
+	return nil
+}
+
+// UpdateSessionExpireTime implements SessionDataAccessor.
+func (s *sessionDataAccessor) UpdateSessionExpireTime(ctx context.Context, sessionID string, expireTime time.Time) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("sessionID", sessionID))
+
+	result := s.database.Model(&Session{}).Where("session_id = ?", sessionID).Update("expire_time", expireTime)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error updating session expire time")
+		return result.Error
+	}
+
+	return nil
+}
+
+// RevokeSession implements SessionDataAccessor.
+func (s *sessionDataAccessor) RevokeSession(ctx context.Context, sessionID string) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("sessionID", sessionID))
+
+	result := s.database.Model(&Session{}).Where("session_id = ?", sessionID).Update("is_revoked", true)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error revoking session")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements SessionDataAccessor.
+func (s *sessionDataAccessor) WithDatabaseTransaction(database Database) SessionDataAccessor {
+	return &sessionDataAccessor{
+		database: database,
+		logger:   s.logger,
+	}
+}
@@ -0,0 +1,138 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrShareLinkNotFound = errors.New("share link not found")
+)
+
+type ShareLink struct {
+	ShareLinkID      uint64    `gorm:"column:share_link_id;primaryKey"`
+	OfDownloadTaskID uint64    `gorm:"column:of_download_task_id"`
+	OfAccountID      uint64    `gorm:"column:of_account_id"`
+	HashedPassword   string    `gorm:"column:hashed_password"`
+	MaxDownloadCount uint64    `gorm:"column:max_download_count"`
+	DownloadCount    uint64    `gorm:"column:download_count"`
+	ExpireTime       time.Time `gorm:"column:expire_time"`
+	IsRevoked        bool      `gorm:"column:is_revoked"`
+}
+
+type ShareLinkDataAccessor interface {
+	CreateShareLink(ctx context.Context, shareLink ShareLink) (ShareLink, error)
+	GetShareLink(ctx context.Context, shareLinkID uint64) (ShareLink, error)
+	GetShareLinkListOfAccount(ctx context.Context, accountID uint64, downloadTaskID uint64) ([]ShareLink, error)
+	// IncreaseShareLinkDownloadCount returns false if the share link has reached its maximum download count.
+	IncreaseShareLinkDownloadCount(ctx context.Context, shareLinkID uint64) (bool, error)
+	RevokeShareLink(ctx context.Context, shareLinkID uint64) error
+	WithDatabaseTransaction(database Database) ShareLinkDataAccessor
+}
+
+func NewShareLinkDataAccessor(database Database, logger *zap.Logger) ShareLinkDataAccessor {
+	return &shareLinkDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type shareLinkDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateShareLink implements ShareLinkDataAccessor.
+func (s *shareLinkDataAccessor) CreateShareLink(ctx context.Context, shareLink ShareLink) (ShareLink, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("downloadTaskID", shareLink.OfDownloadTaskID))
+
+	var createdShareLink = shareLink
+	createdShareLink.ShareLinkID = 0
+
+	result := s.database.Create(&createdShareLink)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating share link")
+		return ShareLink{}, result.Error
+	}
+
+	return createdShareLink, nil
+}
+
+// GetShareLink implements ShareLinkDataAccessor.
+func (s *shareLinkDataAccessor) GetShareLink(ctx context.Context, shareLinkID uint64) (ShareLink, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("shareLinkID", shareLinkID))
+
+	var shareLink ShareLink
+	result := s.database.Where("share_link_id = ?", shareLinkID).First(&shareLink)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return ShareLink{}, ErrShareLinkNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting share link")
+		return ShareLink{}, result.Error
+	}
+
+	return shareLink, nil
+}
+
+// GetShareLinkListOfAccount implements ShareLinkDataAccessor.
+func (s *shareLinkDataAccessor) GetShareLinkListOfAccount(ctx context.Context, accountID uint64, downloadTaskID uint64) ([]ShareLink, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("accountID", accountID)).With(zap.Uint64("downloadTaskID", downloadTaskID))
+
+	query := s.database.Where("of_account_id = ?", accountID)
+	if downloadTaskID != 0 {
+		query = query.Where("of_download_task_id = ?", downloadTaskID)
+	}
+
+	var shareLinks []ShareLink
+	result := query.Order("share_link_id DESC").Find(&shareLinks)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting share link list")
+		return nil, result.Error
+	}
+
+	return shareLinks, nil
+}
+
+// IncreaseShareLinkDownloadCount implements ShareLinkDataAccessor.
+func (s *shareLinkDataAccessor) IncreaseShareLinkDownloadCount(ctx context.Context, shareLinkID uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("shareLinkID", shareLinkID))
+
+	result := s.database.Model(&ShareLink{}).
+		Where("share_link_id = ?", shareLinkID).
+		Where("max_download_count = 0 OR download_count < max_download_count").
+		Update("download_count", gorm.Expr("download_count + 1"))
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error increasing share link download count")
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// RevokeShareLink implements ShareLinkDataAccessor.
+func (s *shareLinkDataAccessor) RevokeShareLink(ctx context.Context, shareLinkID uint64) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("shareLinkID", shareLinkID))
+
+	result := s.database.Model(&ShareLink{}).Where("share_link_id = ?", shareLinkID).Update("is_revoked", true)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error revoking share link")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements ShareLinkDataAccessor.
+func (s *shareLinkDataAccessor) WithDatabaseTransaction(database Database) ShareLinkDataAccessor {
+	return &shareLinkDataAccessor{
+		database: database,
+		logger:   s.logger,
+	}
+}
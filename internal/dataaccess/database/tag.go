@@ -0,0 +1,243 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrTagAlreadyExists = errors.New("tag already exists")
+)
+
+// Tag is named by the members of a workspace and set on any number of its download tasks.
+type Tag struct {
+	TagID         uint64    `gorm:"column:tag_id;primaryKey"`
+	OfWorkspaceID uint64    `gorm:"column:of_workspace_id"`
+	Name          string    `gorm:"column:name"`
+	CreateTime    time.Time `gorm:"column:create_time;autoCreateTime"`
+}
+
+// DownloadTaskTag sets a tag on a download task.
+type DownloadTaskTag struct {
+	OfDownloadTaskID uint64 `gorm:"column:of_download_task_id;primaryKey"`
+	OfTagID          uint64 `gorm:"column:of_tag_id;primaryKey"`
+}
+
+type TagDataAccessor interface {
+	CreateTag(ctx context.Context, tag Tag) (Tag, error)
+	GetTag(ctx context.Context, tagID uint64) (Tag, error)
+	GetTagListOfWorkspace(ctx context.Context, workspaceID uint64) ([]Tag, error)
+	GetTagList(ctx context.Context, tagIDList []uint64) ([]Tag, error)
+	UpdateTagName(ctx context.Context, tagID uint64, name string) error
+	// DeleteTag also unsets the tag from download tasks.
+	DeleteTag(ctx context.Context, tagID uint64) error
+	// AddDownloadTaskTagList sets tags on a download task, the ones already set are left as is.
+	AddDownloadTaskTagList(ctx context.Context, downloadTaskID uint64, tagIDList []uint64) error
+	RemoveDownloadTaskTagList(ctx context.Context, downloadTaskID uint64, tagIDList []uint64) error
+	// GetTagIDListOfDownloadTaskList maps each download task to the IDs of its tags, sorted, download tasks
+	// without tags are left out.
+	GetTagIDListOfDownloadTaskList(ctx context.Context, downloadTaskIDList []uint64) (map[uint64][]uint64, error)
+	WithDatabaseTransaction(database Database) TagDataAccessor
+}
+
+func NewTagDataAccessor(database Database, logger *zap.Logger) TagDataAccessor {
+	return &tagDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type tagDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateTag implements TagDataAccessor.
+func (t *tagDataAccessor) CreateTag(ctx context.Context, tag Tag) (Tag, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("workspaceID", tag.OfWorkspaceID)).With(zap.String("name", tag.Name))
+
+	createdTag := tag
+	createdTag.TagID = 0
+
+	result := t.database.Create(&createdTag)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return Tag{}, ErrTagAlreadyExists
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error creating tag")
+		return Tag{}, result.Error
+	}
+
+	return createdTag, nil
+}
+
+// GetTag implements TagDataAccessor.
+func (t *tagDataAccessor) GetTag(ctx context.Context, tagID uint64) (Tag, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("tagID", tagID))
+
+	var tag Tag
+	result := t.database.Where("tag_id = ?", tagID).First(&tag)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Tag{}, ErrTagNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting tag")
+		return Tag{}, result.Error
+	}
+
+	return tag, nil
+}
+
+// GetTagListOfWorkspace implements TagDataAccessor.
+func (t *tagDataAccessor) GetTagListOfWorkspace(ctx context.Context, workspaceID uint64) ([]Tag, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("workspaceID", workspaceID))
+
+	var tags []Tag
+	result := t.database.Where("of_workspace_id = ?", workspaceID).Order("name").Find(&tags)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting tag list of workspace")
+		return nil, result.Error
+	}
+
+	return tags, nil
+}
+
+// GetTagList implements TagDataAccessor.
+func (t *tagDataAccessor) GetTagList(ctx context.Context, tagIDList []uint64) ([]Tag, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	if len(tagIDList) == 0 {
+		return nil, nil
+	}
+
+	var tags []Tag
+	result := t.database.Where("tag_id IN ?", tagIDList).Order("tag_id").Find(&tags)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting tag list")
+		return nil, result.Error
+	}
+
+	return tags, nil
+}
+
+// UpdateTagName implements TagDataAccessor.
+func (t *tagDataAccessor) UpdateTagName(ctx context.Context, tagID uint64, name string) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("tagID", tagID)).With(zap.String("name", name))
+
+	result := t.database.Model(&Tag{}).Where("tag_id = ?", tagID).Update("name", name)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return ErrTagAlreadyExists
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error updating tag name")
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeleteTag implements TagDataAccessor.
+func (t *tagDataAccessor) DeleteTag(ctx context.Context, tagID uint64) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("tagID", tagID))
+
+	result := t.database.Delete(&Tag{}, tagID)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error deleting tag")
+		return result.Error
+	}
+
+	return nil
+}
+
+// AddDownloadTaskTagList implements TagDataAccessor.
+func (t *tagDataAccessor) AddDownloadTaskTagList(ctx context.Context, downloadTaskID uint64, tagIDList []uint64) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("downloadTaskID", downloadTaskID))
+
+	if len(tagIDList) == 0 {
+		return nil
+	}
+
+	downloadTaskTags := make([]DownloadTaskTag, 0, len(tagIDList))
+	for _, tagID := range tagIDList {
+		downloadTaskTags = append(downloadTaskTags, DownloadTaskTag{
+			OfDownloadTaskID: downloadTaskID,
+			OfTagID:          tagID,
+		})
+	}
+
+	result := t.database.Clauses(clause.OnConflict{DoNothing: true}).Create(&downloadTaskTags)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error adding download task tags")
+		return result.Error
+	}
+
+	return nil
+}
+
+// RemoveDownloadTaskTagList implements TagDataAccessor.
+func (t *tagDataAccessor) RemoveDownloadTaskTagList(ctx context.Context, downloadTaskID uint64, tagIDList []uint64) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("downloadTaskID", downloadTaskID))
+
+	if len(tagIDList) == 0 {
+		return nil
+	}
+
+	result := t.database.
+		Where("of_download_task_id = ?", downloadTaskID).
+		Where("of_tag_id IN ?", tagIDList).
+		Delete(&DownloadTaskTag{})
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error removing download task tags")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetTagIDListOfDownloadTaskList implements TagDataAccessor.
+func (t *tagDataAccessor) GetTagIDListOfDownloadTaskList(ctx context.Context, downloadTaskIDList []uint64) (map[uint64][]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	tagIDListPerDownloadTask := make(map[uint64][]uint64)
+	if len(downloadTaskIDList) == 0 {
+		return tagIDListPerDownloadTask, nil
+	}
+
+	var downloadTaskTags []DownloadTaskTag
+	result := t.database.
+		Where("of_download_task_id IN ?", downloadTaskIDList).
+		Order("of_download_task_id").
+		Order("of_tag_id").
+		Find(&downloadTaskTags)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting tags of download task list")
+		return nil, result.Error
+	}
+
+	for _, downloadTaskTag := range downloadTaskTags {
+		tagIDListPerDownloadTask[downloadTaskTag.OfDownloadTaskID] = append(
+			tagIDListPerDownloadTask[downloadTaskTag.OfDownloadTaskID],
+			downloadTaskTag.OfTagID,
+		)
+	}
+
+	return tagIDListPerDownloadTask, nil
+}
+
+// WithDatabaseTransaction implements TagDataAccessor.
+func (t *tagDataAccessor) WithDatabaseTransaction(database Database) TagDataAccessor {
+	return &tagDataAccessor{
+		database: database,
+		logger:   t.logger,
+	}
+}
@@ -2,19 +2,38 @@ package database
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/maxuanquang/idm/internal/utils"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTokenPublicKeyNotFound = errors.New("token public key not found")
 )
 
 type TokenPublicKey struct {
 	TokenPublicKeyID    uint64 `gorm:"column:token_public_key_id;primaryKey"`
 	TokenPublicKeyValue []byte `gorm:"column:token_public_key_value"`
+	// EncryptedPrivateKey is empty for keys created before signing keys were stored.
+	EncryptedPrivateKey []byte    `gorm:"column:encrypted_private_key"`
+	CreateTime          time.Time `gorm:"column:create_time"`
+	// RetireTime is set once the key is no longer used to sign tokens.
+	RetireTime *time.Time `gorm:"column:retire_time"`
 }
 
 type TokenPublicKeyDataAccessor interface {
 	CreatePublicKey(ctx context.Context, tokenPublicKey TokenPublicKey) (uint64, error)
 	GetPublicKey(ctx context.Context, tokenPublicKeyID uint64) (TokenPublicKey, error)
+	GetCurrentSigningKey(ctx context.Context) (TokenPublicKey, error)
+	GetCurrentSigningKeyForUpdate(ctx context.Context) (TokenPublicKey, error)
+	GetPublicKeyListRetiredAfter(ctx context.Context, retireTime time.Time) ([]TokenPublicKey, error)
+	RetireSigningKeysExcept(ctx context.Context, tokenPublicKeyID uint64, retireTime time.Time) error
+	DeletePublicKeysRetiredBefore(ctx context.Context, retireTime time.Time) (int64, error)
+	WithDatabaseTransaction(database Database) TokenPublicKeyDataAccessor
 }
 
 func NewTokenPublicKeyDataAccessor(
@@ -38,6 +57,8 @@ func (t *tokenPublicKeyDataAccessor) CreatePublicKey(ctx context.Context, tokenP
 
 	var createdTokenPublicKey = TokenPublicKey{
 		TokenPublicKeyValue: tokenPublicKey.TokenPublicKeyValue,
+		EncryptedPrivateKey: tokenPublicKey.EncryptedPrivateKey,
+		CreateTime:          tokenPublicKey.CreateTime,
 	}
 	result := t.database.Create(&createdTokenPublicKey)
 
@@ -56,9 +77,99 @@ func (t *tokenPublicKeyDataAccessor) GetPublicKey(ctx context.Context, tokenPubl
 	var foundTokenPublicKey TokenPublicKey
 	result := t.database.First(&foundTokenPublicKey, tokenPublicKeyID)
 	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return TokenPublicKey{}, ErrTokenPublicKeyNotFound
+		}
+
 		logger.Error("failed to get public key", zap.Error(result.Error))
 		return TokenPublicKey{}, result.Error
 	}
 
 	return foundTokenPublicKey, nil
 }
+
+// GetCurrentSigningKey implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) GetCurrentSigningKey(ctx context.Context) (TokenPublicKey, error) {
+	return t.getCurrentSigningKey(ctx, t.database)
+}
+
+// GetCurrentSigningKeyForUpdate implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) GetCurrentSigningKeyForUpdate(ctx context.Context) (TokenPublicKey, error) {
+	return t.getCurrentSigningKey(ctx, t.database.Clauses(clause.Locking{Strength: "UPDATE"}))
+}
+
+// GetPublicKeyListRetiredAfter implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) GetPublicKeyListRetiredAfter(ctx context.Context, retireTime time.Time) ([]TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	var tokenPublicKeys []TokenPublicKey
+	result := t.database.
+		Select("token_public_key_id", "token_public_key_value", "create_time", "retire_time").
+		Where("retire_time IS NULL OR retire_time > ?", retireTime).
+		Order("token_public_key_id DESC").
+		Find(&tokenPublicKeys)
+	if result.Error != nil {
+		logger.Error("failed to get public key list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return tokenPublicKeys, nil
+}
+
+// RetireSigningKeysExcept implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) RetireSigningKeysExcept(ctx context.Context, tokenPublicKeyID uint64, retireTime time.Time) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("tokenPublicKeyID", tokenPublicKeyID))
+
+	result := t.database.Model(&TokenPublicKey{}).
+		Where("token_public_key_id <> ?", tokenPublicKeyID).
+		Where("retire_time IS NULL").
+		Update("retire_time", retireTime)
+	if result.Error != nil {
+		logger.Error("failed to retire signing keys", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeletePublicKeysRetiredBefore implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) DeletePublicKeysRetiredBefore(ctx context.Context, retireTime time.Time) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	result := t.database.Where("retire_time < ?", retireTime).Delete(&TokenPublicKey{})
+	if result.Error != nil {
+		logger.Error("failed to delete retired public keys", zap.Error(result.Error))
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// WithDatabaseTransaction implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) WithDatabaseTransaction(database Database) TokenPublicKeyDataAccessor {
+	return &tokenPublicKeyDataAccessor{
+		database: database,
+		logger:   t.logger,
+	}
+}
+
+func (t *tokenPublicKeyDataAccessor) getCurrentSigningKey(ctx context.Context, query Database) (TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	var foundTokenPublicKey TokenPublicKey
+	result := query.
+		Where("retire_time IS NULL").
+		Where("encrypted_private_key <> ''").
+		Order("token_public_key_id DESC").
+		First(&foundTokenPublicKey)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return TokenPublicKey{}, ErrTokenPublicKeyNotFound
+		}
+
+		logger.Error("failed to get current signing key", zap.Error(result.Error))
+		return TokenPublicKey{}, result.Error
+	}
+
+	return foundTokenPublicKey, nil
+}
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+)
+
+// Webhook is called on the lifecycle events of the download tasks created by its account.
+type Webhook struct {
+	WebhookID   uint64 `gorm:"column:webhook_id;primaryKey"`
+	OfAccountID uint64 `gorm:"column:of_account_id"`
+	URL         string `gorm:"column:url"`
+	// EncryptedSecret is the nonce followed by the sealed secret signing the requests of the webhook.
+	EncryptedSecret []byte `gorm:"column:encrypted_secret"`
+	// EventTypeList is a JSON array of the event types the webhook is called on, all of them if it is empty.
+	EventTypeList string    `gorm:"column:event_type_list"`
+	CreateTime    time.Time `gorm:"column:create_time;autoCreateTime"`
+}
+
+type WebhookDataAccessor interface {
+	CreateWebhook(ctx context.Context, webhook Webhook) (Webhook, error)
+	GetWebhook(ctx context.Context, webhookID uint64) (Webhook, error)
+	GetWebhookListOfAccount(ctx context.Context, accountID uint64) ([]Webhook, error)
+	// DeleteWebhook also deletes the deliveries of the webhook.
+	DeleteWebhook(ctx context.Context, webhookID uint64) error
+	WithDatabaseTransaction(database Database) WebhookDataAccessor
+}
+
+func NewWebhookDataAccessor(database Database, logger *zap.Logger) WebhookDataAccessor {
+	return &webhookDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type webhookDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateWebhook implements WebhookDataAccessor.
+func (w *webhookDataAccessor) CreateWebhook(ctx context.Context, webhook Webhook) (Webhook, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("accountID", webhook.OfAccountID))
+
+	createdWebhook := webhook
+	createdWebhook.WebhookID = 0
+
+	result := w.database.Create(&createdWebhook)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating webhook")
+		return Webhook{}, result.Error
+	}
+
+	return createdWebhook, nil
+}
+
+// GetWebhook implements WebhookDataAccessor.
+func (w *webhookDataAccessor) GetWebhook(ctx context.Context, webhookID uint64) (Webhook, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("webhookID", webhookID))
+
+	var webhook Webhook
+	result := w.database.Where("webhook_id = ?", webhookID).First(&webhook)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Webhook{}, ErrWebhookNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting webhook")
+		return Webhook{}, result.Error
+	}
+
+	return webhook, nil
+}
+
+// GetWebhookListOfAccount implements WebhookDataAccessor.
+func (w *webhookDataAccessor) GetWebhookListOfAccount(ctx context.Context, accountID uint64) ([]Webhook, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("accountID", accountID))
+
+	var webhooks []Webhook
+	result := w.database.Where("of_account_id = ?", accountID).Order("webhook_id").Find(&webhooks)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting webhook list of account")
+		return nil, result.Error
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook implements WebhookDataAccessor.
+func (w *webhookDataAccessor) DeleteWebhook(ctx context.Context, webhookID uint64) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("webhookID", webhookID))
+
+	result := w.database.Delete(&Webhook{}, webhookID)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error deleting webhook")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements WebhookDataAccessor.
+func (w *webhookDataAccessor) WithDatabaseTransaction(database Database) WebhookDataAccessor {
+	return &webhookDataAccessor{
+		database: database,
+		logger:   w.logger,
+	}
+}
@@ -0,0 +1,192 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+// WebhookDelivery is a call of a webhook on an event, it stays in the queue of pending deliveries until the
+// receiver accepts it or it runs out of attempts.
+type WebhookDelivery struct {
+	WebhookDeliveryID uint64 `gorm:"column:webhook_delivery_id;primaryKey"`
+	OfWebhookID       uint64 `gorm:"column:of_webhook_id"`
+	OfDownloadTaskID  uint64 `gorm:"column:of_download_task_id"`
+	EventType         uint16 `gorm:"column:event_type"`
+	// Payload is the request body, it is built when the event happens so that retries send the same body.
+	Payload            string     `gorm:"column:payload"`
+	DeliveryStatus     uint16     `gorm:"column:delivery_status"`
+	AttemptCount       uint32     `gorm:"column:attempt_count"`
+	ResponseStatusCode uint32     `gorm:"column:response_status_code"`
+	Error              string     `gorm:"column:error"`
+	CreateTime         time.Time  `gorm:"column:create_time;autoCreateTime"`
+	LastAttemptTime    *time.Time `gorm:"column:last_attempt_time"`
+	// NextAttemptTime is nil once the delivery is not pending anymore.
+	NextAttemptTime *time.Time `gorm:"column:next_attempt_time"`
+}
+
+type WebhookDeliveryDataAccessor interface {
+	CreateWebhookDeliveryList(ctx context.Context, webhookDeliveryList []WebhookDelivery) error
+	GetWebhookDeliveryForUpdate(ctx context.Context, webhookDeliveryID uint64) (WebhookDelivery, error)
+	// GetWebhookDeliveryListOfWebhook returns the newest deliveries first.
+	GetWebhookDeliveryListOfWebhook(ctx context.Context, webhookID uint64, offset, limit uint64) ([]WebhookDelivery, error)
+	GetWebhookDeliveryCountOfWebhook(ctx context.Context, webhookID uint64) (uint64, error)
+	// GetDueWebhookDeliveryIDList returns the oldest deliveries in the status whose next attempt is due at now.
+	GetDueWebhookDeliveryIDList(ctx context.Context, deliveryStatus uint16, now time.Time, limit int) ([]uint64, error)
+	// UpdateWebhookDeliveryAttempt saves the status, attempt count, response, error and attempt times of the
+	// delivery.
+	UpdateWebhookDeliveryAttempt(ctx context.Context, webhookDelivery WebhookDelivery) error
+	WithDatabaseTransaction(database Database) WebhookDeliveryDataAccessor
+}
+
+func NewWebhookDeliveryDataAccessor(database Database, logger *zap.Logger) WebhookDeliveryDataAccessor {
+	return &webhookDeliveryDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type webhookDeliveryDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateWebhookDeliveryList implements WebhookDeliveryDataAccessor.
+func (w *webhookDeliveryDataAccessor) CreateWebhookDeliveryList(ctx context.Context, webhookDeliveryList []WebhookDelivery) error {
+	logger := utils.LoggerWithContext(ctx, w.logger)
+
+	if len(webhookDeliveryList) == 0 {
+		return nil
+	}
+
+	result := w.database.Create(&webhookDeliveryList)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating webhook delivery list")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveryForUpdate implements WebhookDeliveryDataAccessor.
+func (w *webhookDeliveryDataAccessor) GetWebhookDeliveryForUpdate(ctx context.Context, webhookDeliveryID uint64) (WebhookDelivery, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("webhookDeliveryID", webhookDeliveryID))
+
+	var webhookDelivery WebhookDelivery
+	result := w.database.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("webhook_delivery_id = ?", webhookDeliveryID).
+		First(&webhookDelivery)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return WebhookDelivery{}, ErrWebhookDeliveryNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting webhook delivery for update")
+		return WebhookDelivery{}, result.Error
+	}
+
+	return webhookDelivery, nil
+}
+
+// GetWebhookDeliveryListOfWebhook implements WebhookDeliveryDataAccessor.
+func (w *webhookDeliveryDataAccessor) GetWebhookDeliveryListOfWebhook(
+	ctx context.Context,
+	webhookID uint64,
+	offset, limit uint64,
+) ([]WebhookDelivery, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).
+		With(zap.Uint64("webhookID", webhookID)).
+		With(zap.Uint64("offset", offset)).
+		With(zap.Uint64("limit", limit))
+
+	var webhookDeliveries []WebhookDelivery
+	result := w.database.
+		Where("of_webhook_id = ?", webhookID).
+		Order("webhook_delivery_id DESC").
+		Offset(int(offset)).
+		Limit(int(limit)).
+		Find(&webhookDeliveries)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting webhook delivery list of webhook")
+		return nil, result.Error
+	}
+
+	return webhookDeliveries, nil
+}
+
+// GetWebhookDeliveryCountOfWebhook implements WebhookDeliveryDataAccessor.
+func (w *webhookDeliveryDataAccessor) GetWebhookDeliveryCountOfWebhook(ctx context.Context, webhookID uint64) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("webhookID", webhookID))
+
+	var count int64
+	result := w.database.Model(&WebhookDelivery{}).Where("of_webhook_id = ?", webhookID).Count(&count)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error counting webhook deliveries of webhook")
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// GetDueWebhookDeliveryIDList implements WebhookDeliveryDataAccessor.
+func (w *webhookDeliveryDataAccessor) GetDueWebhookDeliveryIDList(
+	ctx context.Context,
+	deliveryStatus uint16,
+	now time.Time,
+	limit int,
+) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger)
+
+	var webhookDeliveryIDList []uint64
+	result := w.database.Model(&WebhookDelivery{}).
+		Where("delivery_status = ?", deliveryStatus).
+		Where("next_attempt_time <= ?", now).
+		Order("next_attempt_time").
+		Limit(limit).
+		Pluck("webhook_delivery_id", &webhookDeliveryIDList)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting due webhook delivery id list")
+		return nil, result.Error
+	}
+
+	return webhookDeliveryIDList, nil
+}
+
+// UpdateWebhookDeliveryAttempt implements WebhookDeliveryDataAccessor.
+func (w *webhookDeliveryDataAccessor) UpdateWebhookDeliveryAttempt(ctx context.Context, webhookDelivery WebhookDelivery) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("webhookDeliveryID", webhookDelivery.WebhookDeliveryID))
+
+	result := w.database.Model(&WebhookDelivery{}).
+		Where("webhook_delivery_id = ?", webhookDelivery.WebhookDeliveryID).
+		Updates(map[string]any{
+			"delivery_status":      webhookDelivery.DeliveryStatus,
+			"attempt_count":        webhookDelivery.AttemptCount,
+			"response_status_code": webhookDelivery.ResponseStatusCode,
+			"error":                webhookDelivery.Error,
+			"last_attempt_time":    webhookDelivery.LastAttemptTime,
+			"next_attempt_time":    webhookDelivery.NextAttemptTime,
+		})
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error updating webhook delivery attempt")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements WebhookDeliveryDataAccessor.
+func (w *webhookDeliveryDataAccessor) WithDatabaseTransaction(database Database) WebhookDeliveryDataAccessor {
+	return &webhookDeliveryDataAccessor{
+		database: database,
+		logger:   w.logger,
+	}
+}
@@ -6,7 +6,23 @@ var WireSet = wire.NewSet(
 	NewAccountDataAccessor,
 	NewAccountPasswordDataAccessor,
 	NewDownloadTaskDataAccessor,
+	NewDownloadTaskEventDataAccessor,
+	NewTagDataAccessor,
+	NewCategoryDataAccessor,
+	NewWebhookDataAccessor,
+	NewWebhookDeliveryDataAccessor,
+	NewOutboxMessageDataAccessor,
 	NewTokenPublicKeyDataAccessor,
+	NewShareLinkDataAccessor,
+	NewSessionDataAccessor,
+	NewRefreshTokenDataAccessor,
+	NewAccountExternalIdentityDataAccessor,
+	NewAPIKeyDataAccessor,
+	NewWorkspaceDataAccessor,
+	NewWorkspaceMemberDataAccessor,
+	NewPasswordResetTokenDataAccessor,
+	NewAccountTOTPDataAccessor,
+	NewAccountRecoveryCodeDataAccessor,
 	NewMigrator,
 	InitializeDB,
 )
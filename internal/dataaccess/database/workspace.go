@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrWorkspaceNotFound = errors.New("workspace not found")
+)
+
+// Workspace groups download tasks shared by its members.
+type Workspace struct {
+	WorkspaceID uint64 `gorm:"column:workspace_id;primaryKey"`
+	Name        string `gorm:"column:name"`
+	// PersonalOfAccountID is set for the personal workspace of an account, which no one else can join.
+	PersonalOfAccountID *uint64   `gorm:"column:personal_of_account_id"`
+	CreateTime          time.Time `gorm:"column:create_time"`
+}
+
+type WorkspaceDataAccessor interface {
+	CreateWorkspace(ctx context.Context, workspace Workspace) (Workspace, error)
+	GetWorkspace(ctx context.Context, workspaceID uint64) (Workspace, error)
+	GetWorkspaceForUpdate(ctx context.Context, workspaceID uint64) (Workspace, error)
+	GetPersonalWorkspaceOfAccount(ctx context.Context, accountID uint64) (Workspace, error)
+	GetWorkspaceList(ctx context.Context, workspaceIDList []uint64) ([]Workspace, error)
+	WithDatabaseTransaction(database Database) WorkspaceDataAccessor
+}
+
+func NewWorkspaceDataAccessor(database Database, logger *zap.Logger) WorkspaceDataAccessor {
+	return &workspaceDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type workspaceDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateWorkspace implements WorkspaceDataAccessor.
+func (w *workspaceDataAccessor) CreateWorkspace(ctx context.Context, workspace Workspace) (Workspace, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.String("name", workspace.Name))
+
+	createdWorkspace := workspace
+	createdWorkspace.WorkspaceID = 0
+
+	result := w.database.Create(&createdWorkspace)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating workspace")
+		return Workspace{}, result.Error
+	}
+
+	return createdWorkspace, nil
+}
+
+// GetWorkspace implements WorkspaceDataAccessor.
+func (w *workspaceDataAccessor) GetWorkspace(ctx context.Context, workspaceID uint64) (Workspace, error) {
+	return w.getWorkspace(ctx, w.database, workspaceID)
+}
+
+// GetWorkspaceForUpdate implements WorkspaceDataAccessor.
+func (w *workspaceDataAccessor) GetWorkspaceForUpdate(ctx context.Context, workspaceID uint64) (Workspace, error) {
+	return w.getWorkspace(ctx, w.database.Clauses(clause.Locking{Strength: "UPDATE"}), workspaceID)
+}
+
+func (w *workspaceDataAccessor) getWorkspace(ctx context.Context, database Database, workspaceID uint64) (Workspace, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("workspaceID", workspaceID))
+
+	var workspace Workspace
+	result := database.Where("workspace_id = ?", workspaceID).First(&workspace)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Workspace{}, ErrWorkspaceNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting workspace")
+		return Workspace{}, result.Error
+	}
+
+	return workspace, nil
+}
+
+// GetPersonalWorkspaceOfAccount implements WorkspaceDataAccessor.
+func (w *workspaceDataAccessor) GetPersonalWorkspaceOfAccount(ctx context.Context, accountID uint64) (Workspace, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("accountID", accountID))
+
+	var workspace Workspace
+	result := w.database.Where("personal_of_account_id = ?", accountID).First(&workspace)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Workspace{}, ErrWorkspaceNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting personal workspace")
+		return Workspace{}, result.Error
+	}
+
+	return workspace, nil
+}
+
+// GetWorkspaceList implements WorkspaceDataAccessor.
+func (w *workspaceDataAccessor) GetWorkspaceList(ctx context.Context, workspaceIDList []uint64) ([]Workspace, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger)
+
+	if len(workspaceIDList) == 0 {
+		return nil, nil
+	}
+
+	var workspaces []Workspace
+	result := w.database.Where("workspace_id IN ?", workspaceIDList).Order("workspace_id").Find(&workspaces)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting workspace list")
+		return nil, result.Error
+	}
+
+	return workspaces, nil
+}
+
+// WithDatabaseTransaction implements WorkspaceDataAccessor.
+func (w *workspaceDataAccessor) WithDatabaseTransaction(database Database) WorkspaceDataAccessor {
+	return &workspaceDataAccessor{
+		database: database,
+		logger:   w.logger,
+	}
+}
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrWorkspaceMemberNotFound = errors.New("workspace member not found")
+)
+
+type WorkspaceMember struct {
+	OfWorkspaceID uint64    `gorm:"column:of_workspace_id;primaryKey"`
+	OfAccountID   uint64    `gorm:"column:of_account_id;primaryKey"`
+	Role          uint16    `gorm:"column:role"`
+	CreateTime    time.Time `gorm:"column:create_time"`
+}
+
+type WorkspaceMemberDataAccessor interface {
+	CreateWorkspaceMember(ctx context.Context, workspaceMember WorkspaceMember) error
+	GetWorkspaceMember(ctx context.Context, workspaceID, accountID uint64) (WorkspaceMember, error)
+	GetWorkspaceMemberListOfWorkspace(ctx context.Context, workspaceID uint64) ([]WorkspaceMember, error)
+	GetWorkspaceMemberListOfAccount(ctx context.Context, accountID uint64) ([]WorkspaceMember, error)
+	GetWorkspaceMemberCountWithRole(ctx context.Context, workspaceID uint64, role uint16) (uint64, error)
+	UpdateWorkspaceMemberRole(ctx context.Context, workspaceID, accountID uint64, role uint16) error
+	DeleteWorkspaceMember(ctx context.Context, workspaceID, accountID uint64) error
+	WithDatabaseTransaction(database Database) WorkspaceMemberDataAccessor
+}
+
+func NewWorkspaceMemberDataAccessor(database Database, logger *zap.Logger) WorkspaceMemberDataAccessor {
+	return &workspaceMemberDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type workspaceMemberDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateWorkspaceMember implements WorkspaceMemberDataAccessor.
+func (w *workspaceMemberDataAccessor) CreateWorkspaceMember(ctx context.Context, workspaceMember WorkspaceMember) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).
+		With(zap.Uint64("workspaceID", workspaceMember.OfWorkspaceID)).
+		With(zap.Uint64("accountID", workspaceMember.OfAccountID))
+
+	result := w.database.Create(&workspaceMember)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error creating workspace member")
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetWorkspaceMember implements WorkspaceMemberDataAccessor.
+func (w *workspaceMemberDataAccessor) GetWorkspaceMember(ctx context.Context, workspaceID, accountID uint64) (WorkspaceMember, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("workspaceID", workspaceID)).With(zap.Uint64("accountID", accountID))
+
+	var workspaceMember WorkspaceMember
+	result := w.database.
+		Where("of_workspace_id = ?", workspaceID).
+		Where("of_account_id = ?", accountID).
+		First(&workspaceMember)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return WorkspaceMember{}, ErrWorkspaceMemberNotFound
+		}
+
+		logger.With(zap.Error(result.Error)).Error("error getting workspace member")
+		return WorkspaceMember{}, result.Error
+	}
+
+	return workspaceMember, nil
+}
+
+// GetWorkspaceMemberListOfWorkspace implements WorkspaceMemberDataAccessor.
+func (w *workspaceMemberDataAccessor) GetWorkspaceMemberListOfWorkspace(ctx context.Context, workspaceID uint64) ([]WorkspaceMember, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("workspaceID", workspaceID))
+
+	var workspaceMembers []WorkspaceMember
+	result := w.database.Where("of_workspace_id = ?", workspaceID).Order("create_time").Find(&workspaceMembers)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting workspace member list of workspace")
+		return nil, result.Error
+	}
+
+	return workspaceMembers, nil
+}
+
+// GetWorkspaceMemberListOfAccount implements WorkspaceMemberDataAccessor.
+func (w *workspaceMemberDataAccessor) GetWorkspaceMemberListOfAccount(ctx context.Context, accountID uint64) ([]WorkspaceMember, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("accountID", accountID))
+
+	var workspaceMembers []WorkspaceMember
+	result := w.database.Where("of_account_id = ?", accountID).Find(&workspaceMembers)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting workspace member list of account")
+		return nil, result.Error
+	}
+
+	return workspaceMembers, nil
+}
+
+// GetWorkspaceMemberCountWithRole implements WorkspaceMemberDataAccessor.
+func (w *workspaceMemberDataAccessor) GetWorkspaceMemberCountWithRole(ctx context.Context, workspaceID uint64, role uint16) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("workspaceID", workspaceID)).With(zap.Uint16("role", role))
+
+	var count int64
+	result := w.database.Model(&WorkspaceMember{}).
+		Where("of_workspace_id = ?", workspaceID).
+		Where("role = ?", role).
+		Count(&count)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error getting workspace member count")
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// UpdateWorkspaceMemberRole implements WorkspaceMemberDataAccessor.
+func (w *workspaceMemberDataAccessor) UpdateWorkspaceMemberRole(ctx context.Context, workspaceID, accountID uint64, role uint16) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("workspaceID", workspaceID)).With(zap.Uint64("accountID", accountID))
+
+	result := w.database.Model(&WorkspaceMember{}).
+		Where("of_workspace_id = ?", workspaceID).
+		Where("of_account_id = ?", accountID).
+		Update("role", role)
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error updating workspace member role")
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeleteWorkspaceMember implements WorkspaceMemberDataAccessor.
+func (w *workspaceMemberDataAccessor) DeleteWorkspaceMember(ctx context.Context, workspaceID, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("workspaceID", workspaceID)).With(zap.Uint64("accountID", accountID))
+
+	result := w.database.
+		Where("of_workspace_id = ?", workspaceID).
+		Where("of_account_id = ?", accountID).
+		Delete(&WorkspaceMember{})
+	if result.Error != nil {
+		logger.With(zap.Error(result.Error)).Error("error deleting workspace member")
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements WorkspaceMemberDataAccessor.
+func (w *workspaceMemberDataAccessor) WithDatabaseTransaction(database Database) WorkspaceMemberDataAccessor {
+	return &workspaceMemberDataAccessor{
+		database: database,
+		logger:   w.logger,
+	}
+}
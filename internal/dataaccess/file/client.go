@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"time"
 
 	"github.com/maxuanquang/idm/internal/configs"
 	"github.com/maxuanquang/idm/internal/utils"
@@ -15,20 +16,20 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	ErrFileNotFound = errors.New("file not found")
+)
+
+type FileInfo struct {
+	Size    int64
+	ModTime time.Time
+}
+
 type Client interface {
 	Write(ctx context.Context, fileName string) (io.WriteCloser, error)
 	Read(ctx context.Context, fileName string) (io.ReadCloser, error)
-}
-
-func NewClient(downloadConfig configs.Download, logger *zap.Logger) (Client, error) {
-	switch downloadConfig.Mode {
-	case configs.DownloadModeLocal:
-		return NewLocalClient(downloadConfig, logger)
-	case configs.DownloadModeS3:
-		return NewS3Client(downloadConfig, logger)
-	default:
-		return nil, fmt.Errorf("unsupported download mode: %s", downloadConfig.Mode)
-	}
+	Stat(ctx context.Context, fileName string) (FileInfo, error)
+	Delete(ctx context.Context, fileName string) error
 }
 
 func NewLocalClient(downloadConfig configs.Download, logger *zap.Logger) (Client, error) {
@@ -67,7 +68,13 @@ func (l *localClient) Read(ctx context.Context, fileName string) (io.ReadCloser,
 func (l *localClient) Write(ctx context.Context, fileName string) (io.WriteCloser, error) {
 	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("file_name", fileName))
 
+	// File names may start with the directories of a storage prefix.
 	filePath := path.Join(l.downloadDirectory, fileName)
+	if err := os.MkdirAll(path.Dir(filePath), 0o755); err != nil {
+		logger.With(zap.Error(err)).Error("can not create file directory")
+		return nil, err
+	}
+
 	file, err := os.Create(filePath)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("can not open file")
@@ -77,6 +84,40 @@ func (l *localClient) Write(ctx context.Context, fileName string) (io.WriteClose
 	return file, nil
 }
 
+// Stat implements Client.
+func (l *localClient) Stat(ctx context.Context, fileName string) (FileInfo, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("file_name", fileName))
+
+	filePath := path.Join(l.downloadDirectory, fileName)
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return FileInfo{}, fmt.Errorf("%w: %s", ErrFileNotFound, fileName)
+		}
+
+		logger.With(zap.Error(err)).Error("can not stat file")
+		return FileInfo{}, err
+	}
+
+	return FileInfo{
+		Size:    fileInfo.Size(),
+		ModTime: fileInfo.ModTime(),
+	}, nil
+}
+
+// Delete implements Client.
+func (l *localClient) Delete(ctx context.Context, fileName string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("file_name", fileName))
+
+	filePath := path.Join(l.downloadDirectory, fileName)
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.With(zap.Error(err)).Error("can not delete file")
+		return err
+	}
+
+	return nil
+}
+
 func NewS3Client(downloadConfig configs.Download, logger *zap.Logger) (Client, error) {
 
 	minioClient, err := minio.New(
@@ -136,7 +177,7 @@ func (s *s3Client) Read(ctx context.Context, fileName string) (io.ReadCloser, er
 
 // Write implements Client.
 func (s *s3Client) Write(ctx context.Context, fileName string) (io.WriteCloser, error) {
-	return newS3ReadWriteCloser(
+	return newS3WriteCloser(
 		ctx,
 		s.minioClient,
 		s.logger,
@@ -145,60 +186,89 @@ func (s *s3Client) Write(ctx context.Context, fileName string) (io.WriteCloser,
 	)
 }
 
-func newS3ReadWriteCloser(
+// Stat implements Client.
+func (s *s3Client) Stat(ctx context.Context, fileName string) (FileInfo, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("file_name", fileName))
+
+	objectInfo, err := s.minioClient.StatObject(ctx, s.bucketName, fileName, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return FileInfo{}, fmt.Errorf("%w: %s", ErrFileNotFound, fileName)
+		}
+
+		logger.With(zap.Error(err)).Error("failed to stat object")
+		return FileInfo{}, err
+	}
+
+	return FileInfo{
+		Size:    objectInfo.Size,
+		ModTime: objectInfo.LastModified,
+	}, nil
+}
+
+// Delete implements Client.
+func (s *s3Client) Delete(ctx context.Context, fileName string) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("file_name", fileName))
+
+	err := s.minioClient.RemoveObject(ctx, s.bucketName, fileName, minio.RemoveObjectOptions{})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to remove object")
+		return err
+	}
+
+	return nil
+}
+
+// newS3WriteCloser streams written bytes into a PutObject call running in the
+// background. Close waits for the upload to finish, so a nil error from Close
+// means the object is stored in the bucket.
+func newS3WriteCloser(
 	ctx context.Context,
 	minioClient *minio.Client,
 	logger *zap.Logger,
 	bucketName string,
 	fileName string,
-) (*s3ReadWriteCloser, error) {
-	logger = utils.LoggerWithContext(ctx, logger)
-	readWriteCloser := &s3ReadWriteCloser{
-		buffer:   make([]byte, 0),
-		isClosed: false,
+) (*s3WriteCloser, error) {
+	logger = utils.LoggerWithContext(ctx, logger).With(zap.String("file_name", fileName))
+	pipeReader, pipeWriter := io.Pipe()
+	writeCloser := &s3WriteCloser{
+		pipeWriter: pipeWriter,
+		doneChan:   make(chan error, 1),
 	}
 
 	go func() {
-		if _, err := minioClient.PutObject(
+		_, err := minioClient.PutObject(
 			ctx,
 			bucketName,
 			fileName,
-			readWriteCloser,
+			pipeReader,
 			-1,
 			minio.PutObjectOptions{},
-		); err != nil {
+		)
+		if err != nil {
 			logger.With(zap.Error(err)).Error("failed to put object")
 		}
+
+		pipeReader.CloseWithError(err)
+		writeCloser.doneChan <- err
 	}()
 
-	return readWriteCloser, nil
+	return writeCloser, nil
 }
 
-type s3ReadWriteCloser struct {
-	buffer   []byte
-	isClosed bool
+type s3WriteCloser struct {
+	pipeWriter *io.PipeWriter
+	doneChan   chan error
 }
 
-func (s *s3ReadWriteCloser) Read(p []byte) (int, error) {
-	if len(s.buffer) > 0 {
-		readLength := copy(p, s.buffer)
-		s.buffer = s.buffer[readLength:]
-		return readLength, nil
-	}
-
-	if s.isClosed {
-		return 0, io.EOF
-	}
-
-	return 0, nil
+func (s *s3WriteCloser) Write(p []byte) (int, error) {
+	return s.pipeWriter.Write(p)
 }
 
-func (s *s3ReadWriteCloser) Write(p []byte) (int, error) {
-	s.buffer = append(s.buffer, p...)
-	return len(p), nil
-}
+func (s *s3WriteCloser) Close() error {
+	if err := s.pipeWriter.Close(); err != nil {
+		return err
+	}
 
-func (s *s3ReadWriteCloser) Close() error {
-	s.isClosed = true
-	return nil
+	return <-s.doneChan
 }
@@ -0,0 +1,176 @@
+package file
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/maxuanquang/idm/internal/configs"
+	"go.uber.org/zap"
+)
+
+// Codec is the compression format of a stored file. Codec names match HTTP content codings.
+type Codec string
+
+const (
+	CodecNone Codec = ""
+	CodecGzip Codec = "gzip"
+	CodecZstd Codec = "zstd"
+)
+
+// CodecSelector decides which codec a file is compressed with based on its content type.
+type CodecSelector interface {
+	GetCodec(contentType string) Codec
+	IsServingCompressed() bool
+}
+
+func NewCodecSelector(downloadConfig configs.Download, logger *zap.Logger) (CodecSelector, error) {
+	compressionConfig := downloadConfig.Compression
+	if !compressionConfig.Enabled {
+		return &codecSelector{}, nil
+	}
+
+	rules := make([]configs.CompressionRule, 0, len(compressionConfig.Rules))
+	for _, rule := range compressionConfig.Rules {
+		switch Codec(rule.Codec) {
+		case CodecGzip, CodecZstd:
+		default:
+			err := fmt.Errorf("unsupported compression codec: %s", rule.Codec)
+			logger.With(zap.Error(err)).Error("invalid compression rule")
+			return nil, err
+		}
+
+		rules = append(rules, configs.CompressionRule{
+			ContentType: strings.ToLower(rule.ContentType),
+			Codec:       rule.Codec,
+		})
+	}
+
+	return &codecSelector{
+		rules:           rules,
+		serveCompressed: compressionConfig.ServeCompressed,
+	}, nil
+}
+
+type codecSelector struct {
+	rules           []configs.CompressionRule
+	serveCompressed bool
+}
+
+// GetCodec implements CodecSelector.
+func (c *codecSelector) GetCodec(contentType string) Codec {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return CodecNone
+	}
+
+	for _, rule := range c.rules {
+		if rule.ContentType == mediaType {
+			return Codec(rule.Codec)
+		}
+
+		if prefix, ok := strings.CutSuffix(rule.ContentType, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return Codec(rule.Codec)
+		}
+	}
+
+	return CodecNone
+}
+
+// IsServingCompressed implements CodecSelector.
+func (c *codecSelector) IsServingCompressed() bool {
+	return c.serveCompressed
+}
+
+// NewCompressionWriteCloser compresses everything written to it with codec before writing it to writeCloser.
+func NewCompressionWriteCloser(writeCloser io.WriteCloser, codec Codec) (io.WriteCloser, error) {
+	switch codec {
+	case CodecNone:
+		return writeCloser, nil
+	case CodecGzip:
+		return &compressionWriteCloser{
+			compressor:  gzip.NewWriter(writeCloser),
+			writeCloser: writeCloser,
+		}, nil
+	case CodecZstd:
+		encoder, err := zstd.NewWriter(writeCloser)
+		if err != nil {
+			return nil, err
+		}
+
+		return &compressionWriteCloser{
+			compressor:  encoder,
+			writeCloser: writeCloser,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression codec: %s", codec)
+	}
+}
+
+// NewDecompressionReadCloser decompresses the content of readCloser, which was compressed with codec.
+func NewDecompressionReadCloser(readCloser io.ReadCloser, codec Codec) (io.ReadCloser, error) {
+	switch codec {
+	case CodecNone:
+		return readCloser, nil
+	case CodecGzip:
+		gzipReader, err := gzip.NewReader(readCloser)
+		if err != nil {
+			return nil, err
+		}
+
+		return &decompressionReadCloser{
+			decompressor: gzipReader,
+			closeFunc:    func() { gzipReader.Close() },
+			readCloser:   readCloser,
+		}, nil
+	case CodecZstd:
+		decoder, err := zstd.NewReader(readCloser)
+		if err != nil {
+			return nil, err
+		}
+
+		return &decompressionReadCloser{
+			decompressor: decoder,
+			closeFunc:    decoder.Close,
+			readCloser:   readCloser,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression codec: %s", codec)
+	}
+}
+
+type compressionWriteCloser struct {
+	compressor  io.WriteCloser
+	writeCloser io.WriteCloser
+}
+
+func (c *compressionWriteCloser) Write(p []byte) (int, error) {
+	return c.compressor.Write(p)
+}
+
+func (c *compressionWriteCloser) Close() error {
+	if err := c.compressor.Close(); err != nil {
+		c.writeCloser.Close()
+		return err
+	}
+
+	return c.writeCloser.Close()
+}
+
+type decompressionReadCloser struct {
+	decompressor io.Reader
+	closeFunc    func()
+	readCloser   io.ReadCloser
+}
+
+func (d *decompressionReadCloser) Read(p []byte) (int, error) {
+	return d.decompressor.Read(p)
+}
+
+func (d *decompressionReadCloser) Close() error {
+	d.closeFunc()
+	return d.readCloser.Close()
+}
@@ -0,0 +1,417 @@
+package file
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	dataKeySizeInBytes     = 32
+	noncePrefixSizeInBytes = 7
+	// AES-GCM tag size, added to every encrypted chunk.
+	dataKeyAEADOverhead   = 16
+	dataKeyFileNameSuffix = ".key"
+)
+
+var (
+	ErrEncryptedFileTruncated = errors.New("encrypted file is truncated")
+)
+
+// EncryptingClient is a Client that encrypts files at rest.
+type EncryptingClient interface {
+	Client
+	// RewrapDataKey wraps the data key of a file with the current master key.
+	// It returns false if the data key is already wrapped with the current master key.
+	RewrapDataKey(ctx context.Context, fileName string) (bool, error)
+}
+
+// dataKeyFile is stored next to each encrypted file. It holds the file's data key,
+// wrapped with a master key, and the parameters needed to decrypt the file.
+type dataKeyFile struct {
+	MasterKeyID    string `json:"master_key_id"`
+	WrappedDataKey []byte `json:"wrapped_data_key"`
+	NoncePrefix    []byte `json:"nonce_prefix"`
+	ChunkSize      int    `json:"chunk_size"`
+}
+
+// NewEncryptingClient wraps a Client so that every written file is encrypted with its own data key
+// using AES-GCM in chunks, allowing files to be streamed without holding them in memory.
+// Files written before encryption was enabled have no data key file and are read as is.
+func NewEncryptingClient(
+	client Client,
+	masterKeyProvider MasterKeyProvider,
+	encryptionConfig configs.Encryption,
+	logger *zap.Logger,
+) (EncryptingClient, error) {
+	chunkSize, err := encryptionConfig.GetChunkSizeInBytes()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("invalid encryption chunk size")
+		return nil, err
+	}
+	if chunkSize == 0 || chunkSize > math.MaxInt32 {
+		return nil, fmt.Errorf("invalid encryption chunk size: %s", encryptionConfig.ChunkSize)
+	}
+
+	return &encryptingClient{
+		client:            client,
+		masterKeyProvider: masterKeyProvider,
+		chunkSize:         int(chunkSize),
+		logger:            logger,
+	}, nil
+}
+
+type encryptingClient struct {
+	client            Client
+	masterKeyProvider MasterKeyProvider
+	chunkSize         int
+	logger            *zap.Logger
+}
+
+// Write implements Client.
+func (e *encryptingClient) Write(ctx context.Context, fileName string) (io.WriteCloser, error) {
+	logger := utils.LoggerWithContext(ctx, e.logger).With(zap.String("file_name", fileName))
+
+	dataKey := make([]byte, dataKeySizeInBytes)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+
+	noncePrefix := make([]byte, noncePrefixSizeInBytes)
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return nil, err
+	}
+
+	masterKeyID := e.masterKeyProvider.GetCurrentMasterKeyID()
+	wrappedDataKey, err := e.masterKeyProvider.WrapDataKey(ctx, masterKeyID, dataKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("can not wrap data key")
+		return nil, err
+	}
+
+	aead, err := newDataKeyAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// The data key file is written before any ciphertext, since a file without one is read as plaintext. If
+	// the server stops while writing the file, it fails to decrypt instead of being served encrypted.
+	err = e.writeDataKeyFile(ctx, fileName, dataKeyFile{
+		MasterKeyID:    masterKeyID,
+		WrappedDataKey: wrappedDataKey,
+		NoncePrefix:    noncePrefix,
+		ChunkSize:      e.chunkSize,
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("can not write data key file")
+		return nil, err
+	}
+
+	writeCloser, err := e.client.Write(ctx, fileName)
+	if err != nil {
+		if deleteErr := e.client.Delete(ctx, fileName+dataKeyFileNameSuffix); deleteErr != nil {
+			logger.With(zap.Error(deleteErr)).Warn("can not delete data key file")
+		}
+
+		return nil, err
+	}
+
+	return &encryptingWriteCloser{
+		writeCloser: writeCloser,
+		aead:        aead,
+		noncePrefix: noncePrefix,
+		buffer:      make([]byte, 0, e.chunkSize),
+	}, nil
+}
+
+// Read implements Client.
+func (e *encryptingClient) Read(ctx context.Context, fileName string) (io.ReadCloser, error) {
+	logger := utils.LoggerWithContext(ctx, e.logger).With(zap.String("file_name", fileName))
+
+	keyFile, err := e.readDataKeyFile(ctx, fileName)
+	if err != nil {
+		if errors.Is(err, ErrFileNotFound) {
+			return e.client.Read(ctx, fileName)
+		}
+
+		logger.With(zap.Error(err)).Error("can not read data key file")
+		return nil, err
+	}
+
+	dataKey, err := e.masterKeyProvider.UnwrapDataKey(ctx, keyFile.MasterKeyID, keyFile.WrappedDataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newDataKeyAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	readCloser, err := e.client.Read(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptingReadCloser{
+		readCloser:   readCloser,
+		aead:         aead,
+		noncePrefix:  keyFile.NoncePrefix,
+		sealedBuffer: make([]byte, keyFile.ChunkSize+aead.Overhead()),
+	}, nil
+}
+
+// Stat implements Client. The returned size is the size of the decrypted content.
+func (e *encryptingClient) Stat(ctx context.Context, fileName string) (FileInfo, error) {
+	fileInfo, err := e.client.Stat(ctx, fileName)
+	if err != nil {
+		return FileInfo{}, err
+	}
+
+	keyFile, err := e.readDataKeyFile(ctx, fileName)
+	if err != nil {
+		if errors.Is(err, ErrFileNotFound) {
+			return fileInfo, nil
+		}
+		return FileInfo{}, err
+	}
+
+	sealedChunkSize := int64(keyFile.ChunkSize + dataKeyAEADOverhead)
+	chunkCount := (fileInfo.Size + sealedChunkSize - 1) / sealedChunkSize
+	fileInfo.Size -= chunkCount * dataKeyAEADOverhead
+	return fileInfo, nil
+}
+
+// Delete implements Client.
+func (e *encryptingClient) Delete(ctx context.Context, fileName string) error {
+	if err := e.client.Delete(ctx, fileName); err != nil {
+		return err
+	}
+
+	return e.client.Delete(ctx, fileName+dataKeyFileNameSuffix)
+}
+
+// RewrapDataKey implements EncryptingClient.
+func (e *encryptingClient) RewrapDataKey(ctx context.Context, fileName string) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, e.logger).With(zap.String("file_name", fileName))
+
+	keyFile, err := e.readDataKeyFile(ctx, fileName)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("can not read data key file")
+		return false, err
+	}
+
+	currentMasterKeyID := e.masterKeyProvider.GetCurrentMasterKeyID()
+	if keyFile.MasterKeyID == currentMasterKeyID {
+		return false, nil
+	}
+
+	dataKey, err := e.masterKeyProvider.UnwrapDataKey(ctx, keyFile.MasterKeyID, keyFile.WrappedDataKey)
+	if err != nil {
+		return false, err
+	}
+
+	wrappedDataKey, err := e.masterKeyProvider.WrapDataKey(ctx, currentMasterKeyID, dataKey)
+	if err != nil {
+		return false, err
+	}
+
+	keyFile.MasterKeyID = currentMasterKeyID
+	keyFile.WrappedDataKey = wrappedDataKey
+	if err = e.writeDataKeyFile(ctx, fileName, keyFile); err != nil {
+		logger.With(zap.Error(err)).Error("can not write data key file")
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (e *encryptingClient) readDataKeyFile(ctx context.Context, fileName string) (dataKeyFile, error) {
+	keyFileName := fileName + dataKeyFileNameSuffix
+	if _, err := e.client.Stat(ctx, keyFileName); err != nil {
+		return dataKeyFile{}, err
+	}
+
+	readCloser, err := e.client.Read(ctx, keyFileName)
+	if err != nil {
+		return dataKeyFile{}, err
+	}
+	defer readCloser.Close()
+
+	var keyFile dataKeyFile
+	if err = json.NewDecoder(readCloser).Decode(&keyFile); err != nil {
+		return dataKeyFile{}, err
+	}
+
+	if len(keyFile.NoncePrefix) != noncePrefixSizeInBytes || keyFile.ChunkSize <= 0 {
+		return dataKeyFile{}, fmt.Errorf("invalid data key file of %s", fileName)
+	}
+
+	return keyFile, nil
+}
+
+func (e *encryptingClient) writeDataKeyFile(ctx context.Context, fileName string, keyFile dataKeyFile) error {
+	keyFileBytes, err := json.Marshal(keyFile)
+	if err != nil {
+		return err
+	}
+
+	writeCloser, err := e.client.Write(ctx, fileName+dataKeyFileNameSuffix)
+	if err != nil {
+		return err
+	}
+
+	if _, err = writeCloser.Write(keyFileBytes); err != nil {
+		writeCloser.Close()
+		return err
+	}
+
+	return writeCloser.Close()
+}
+
+func newDataKeyAEAD(dataKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// getChunkNonce builds the nonce of a chunk from the file's random nonce prefix, the chunk index and
+// whether the chunk is the last one, so chunks can neither be reordered nor dropped from the end.
+func getChunkNonce(noncePrefix []byte, chunkIndex uint32, isFinalChunk bool) []byte {
+	nonce := make([]byte, 0, noncePrefixSizeInBytes+5)
+	nonce = append(nonce, noncePrefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, chunkIndex)
+	if isFinalChunk {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
+
+type encryptingWriteCloser struct {
+	writeCloser io.WriteCloser
+	aead        cipher.AEAD
+	noncePrefix []byte
+	buffer      []byte
+	chunkIndex  uint32
+}
+
+func (e *encryptingWriteCloser) Write(p []byte) (int, error) {
+	writtenByteCount := 0
+	for len(p) > 0 {
+		// A full chunk is only sealed once more data arrives, the last chunk is sealed on Close.
+		if len(e.buffer) == cap(e.buffer) {
+			if err := e.writeChunk(false); err != nil {
+				return writtenByteCount, err
+			}
+		}
+
+		byteCount := min(cap(e.buffer)-len(e.buffer), len(p))
+		e.buffer = append(e.buffer, p[:byteCount]...)
+		p = p[byteCount:]
+		writtenByteCount += byteCount
+	}
+
+	return writtenByteCount, nil
+}
+
+func (e *encryptingWriteCloser) Close() error {
+	if err := e.writeChunk(true); err != nil {
+		e.writeCloser.Close()
+		return err
+	}
+
+	return e.writeCloser.Close()
+}
+
+func (e *encryptingWriteCloser) writeChunk(isFinalChunk bool) error {
+	if e.chunkIndex == math.MaxUint32 {
+		return errors.New("too many chunks to encrypt")
+	}
+
+	sealedChunk := e.aead.Seal(nil, getChunkNonce(e.noncePrefix, e.chunkIndex, isFinalChunk), e.buffer, nil)
+	if _, err := e.writeCloser.Write(sealedChunk); err != nil {
+		return err
+	}
+
+	e.buffer = e.buffer[:0]
+	e.chunkIndex++
+	return nil
+}
+
+type decryptingReadCloser struct {
+	readCloser   io.ReadCloser
+	aead         cipher.AEAD
+	noncePrefix  []byte
+	sealedBuffer []byte
+	plainBuffer  []byte
+	plaintext    []byte
+	chunkIndex   uint32
+	isFinished   bool
+}
+
+func (d *decryptingReadCloser) Read(p []byte) (int, error) {
+	for len(d.plaintext) == 0 {
+		if d.isFinished {
+			return 0, io.EOF
+		}
+
+		if err := d.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	readByteCount := copy(p, d.plaintext)
+	d.plaintext = d.plaintext[readByteCount:]
+	return readByteCount, nil
+}
+
+func (d *decryptingReadCloser) Close() error {
+	return d.readCloser.Close()
+}
+
+func (d *decryptingReadCloser) readChunk() error {
+	readByteCount, err := io.ReadFull(d.readCloser, d.sealedBuffer)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		if errors.Is(err, io.EOF) {
+			return ErrEncryptedFileTruncated
+		}
+		return err
+	}
+	sealedChunk := d.sealedBuffer[:readByteCount]
+
+	// A short chunk can only be the last one. A full chunk is usually followed by more chunks,
+	// but is the last one when the content size is a multiple of the chunk size.
+	if readByteCount == len(d.sealedBuffer) {
+		plaintext, openErr := d.aead.Open(d.plainBuffer[:0], getChunkNonce(d.noncePrefix, d.chunkIndex, false), sealedChunk, nil)
+		if openErr == nil {
+			d.plainBuffer = plaintext
+			d.plaintext = plaintext
+			d.chunkIndex++
+			return nil
+		}
+	}
+
+	plaintext, err := d.aead.Open(d.plainBuffer[:0], getChunkNonce(d.noncePrefix, d.chunkIndex, true), sealedChunk, nil)
+	if err != nil {
+		return fmt.Errorf("can not decrypt chunk %d: %w", d.chunkIndex, err)
+	}
+
+	d.plainBuffer = plaintext
+	d.plaintext = plaintext
+	d.isFinished = true
+	return nil
+}
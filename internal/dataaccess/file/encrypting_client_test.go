@@ -0,0 +1,270 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"go.uber.org/zap"
+)
+
+const testEncryptionChunkSize = 16
+
+func newTestEncryptingClient(t *testing.T, downloadDirectory string, masterKeys map[string]string, currentMasterKeyID string) EncryptingClient {
+	t.Helper()
+
+	encryptionConfig := configs.Encryption{
+		Enabled:            true,
+		ChunkSize:          strconv.Itoa(testEncryptionChunkSize) + "B",
+		MasterKeySource:    configs.MasterKeySourceConfig,
+		CurrentMasterKeyID: currentMasterKeyID,
+		MasterKeys:         masterKeys,
+	}
+
+	localClient, err := NewLocalClient(configs.Download{DownloadDirectory: downloadDirectory}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	masterKeyProvider, err := NewConfigMasterKeyProvider(encryptionConfig, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encryptingClient, err := NewEncryptingClient(localClient, masterKeyProvider, encryptionConfig, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return encryptingClient
+}
+
+func newTestMasterKeys(t *testing.T, masterKeyIDList ...string) map[string]string {
+	t.Helper()
+
+	masterKeys := make(map[string]string)
+	for _, masterKeyID := range masterKeyIDList {
+		masterKey := make([]byte, masterKeySizeInBytes)
+		if _, err := rand.Read(masterKey); err != nil {
+			t.Fatal(err)
+		}
+
+		masterKeys[masterKeyID] = base64.StdEncoding.EncodeToString(masterKey)
+	}
+
+	return masterKeys
+}
+
+func newTestContent(t *testing.T, size int) []byte {
+	t.Helper()
+
+	content := make([]byte, size)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatal(err)
+	}
+
+	return content
+}
+
+func writeTestFile(t *testing.T, client Client, fileName string, content []byte) {
+	t.Helper()
+
+	writeCloser, err := client.Write(context.Background(), fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = writeCloser.Write(content); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writeCloser.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestFile(client Client, fileName string) ([]byte, error) {
+	readCloser, err := client.Read(context.Background(), fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer readCloser.Close()
+
+	return io.ReadAll(readCloser)
+}
+
+func TestEncryptingClientRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name string
+		size int
+	}{
+		{name: "empty", size: 0},
+		{name: "one byte", size: 1},
+		{name: "one byte less than a chunk", size: testEncryptionChunkSize - 1},
+		{name: "one chunk", size: testEncryptionChunkSize},
+		{name: "one byte more than a chunk", size: testEncryptionChunkSize + 1},
+		{name: "several chunks", size: 3 * testEncryptionChunkSize},
+		{name: "large", size: 100*testEncryptionChunkSize + 7},
+	}
+
+	downloadDirectory := t.TempDir()
+	client := newTestEncryptingClient(t, downloadDirectory, newTestMasterKeys(t, "key-1"), "key-1")
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			content := newTestContent(t, testCase.size)
+			writeTestFile(t, client, testCase.name, content)
+
+			storedContent, err := os.ReadFile(path.Join(downloadDirectory, testCase.name))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(content) > 0 && bytes.Contains(storedContent, content) {
+				t.Error("stored file contains the plaintext")
+			}
+
+			readContent, err := readTestFile(client, testCase.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(readContent, content) {
+				t.Errorf("read content differs from written content")
+			}
+
+			fileInfo, err := client.Stat(context.Background(), testCase.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if fileInfo.Size != int64(testCase.size) {
+				t.Errorf("expected size %d, got %d", testCase.size, fileInfo.Size)
+			}
+		})
+	}
+}
+
+func TestEncryptingClientRejectsModifiedFile(t *testing.T) {
+	content := newTestContent(t, 3*testEncryptionChunkSize+5)
+	sealedChunkSize := testEncryptionChunkSize + dataKeyAEADOverhead
+
+	testCases := []struct {
+		name   string
+		modify func(storedContent []byte) []byte
+	}{
+		{
+			name: "flipped byte",
+			modify: func(storedContent []byte) []byte {
+				storedContent[sealedChunkSize+1] ^= 1
+				return storedContent
+			},
+		},
+		{
+			name: "dropped last chunk",
+			modify: func(storedContent []byte) []byte {
+				return storedContent[:3*sealedChunkSize]
+			},
+		},
+		{
+			name: "swapped chunks",
+			modify: func(storedContent []byte) []byte {
+				swappedContent := append([]byte{}, storedContent[sealedChunkSize:2*sealedChunkSize]...)
+				swappedContent = append(swappedContent, storedContent[:sealedChunkSize]...)
+				return append(swappedContent, storedContent[2*sealedChunkSize:]...)
+			},
+		},
+		{
+			name: "truncated chunk",
+			modify: func(storedContent []byte) []byte {
+				return storedContent[:len(storedContent)-1]
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			downloadDirectory := t.TempDir()
+			client := newTestEncryptingClient(t, downloadDirectory, newTestMasterKeys(t, "key-1"), "key-1")
+			writeTestFile(t, client, "file", content)
+
+			filePath := path.Join(downloadDirectory, "file")
+			storedContent, err := os.ReadFile(filePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err = os.WriteFile(filePath, testCase.modify(storedContent), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err = readTestFile(client, "file"); err == nil {
+				t.Error("expected an error reading the modified file")
+			}
+		})
+	}
+}
+
+func TestEncryptingClientReadsPlaintextFile(t *testing.T) {
+	downloadDirectory := t.TempDir()
+	client := newTestEncryptingClient(t, downloadDirectory, newTestMasterKeys(t, "key-1"), "key-1")
+
+	// Files written before encryption was enabled have no data key file.
+	content := newTestContent(t, 2*testEncryptionChunkSize)
+	if err := os.WriteFile(path.Join(downloadDirectory, "file"), content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	readContent, err := readTestFile(client, "file")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(readContent, content) {
+		t.Error("read content differs from the plaintext file")
+	}
+}
+
+func TestEncryptingClientRewrapDataKey(t *testing.T) {
+	downloadDirectory := t.TempDir()
+	masterKeys := newTestMasterKeys(t, "key-1", "key-2")
+
+	content := newTestContent(t, 2*testEncryptionChunkSize+3)
+	writeTestFile(t, newTestEncryptingClient(t, downloadDirectory, masterKeys, "key-1"), "file", content)
+
+	client := newTestEncryptingClient(t, downloadDirectory, masterKeys, "key-2")
+	rewrapped, err := client.RewrapDataKey(context.Background(), "file")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !rewrapped {
+		t.Error("expected the data key to be rewrapped")
+	}
+
+	rewrapped, err = client.RewrapDataKey(context.Background(), "file")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rewrapped {
+		t.Error("expected the data key to be wrapped with the current master key already")
+	}
+
+	// The file no longer needs the retired master key.
+	delete(masterKeys, "key-1")
+	readContent, err := readTestFile(newTestEncryptingClient(t, downloadDirectory, masterKeys, "key-2"), "file")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(readContent, content) {
+		t.Error("read content differs from written content")
+	}
+}
@@ -0,0 +1,240 @@
+package file
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	masterKeySizeInBytes  = 32
+	localKMSKeyFileSuffix = ".key"
+)
+
+var (
+	ErrMasterKeyNotFound = errors.New("master key not found")
+)
+
+// MasterKeyProvider wraps and unwraps per-file data keys with master keys.
+type MasterKeyProvider interface {
+	GetCurrentMasterKeyID() string
+	WrapDataKey(ctx context.Context, masterKeyID string, dataKey []byte) ([]byte, error)
+	UnwrapDataKey(ctx context.Context, masterKeyID string, wrappedDataKey []byte) ([]byte, error)
+}
+
+func NewMasterKeyProvider(encryptionConfig configs.Encryption, logger *zap.Logger) (MasterKeyProvider, error) {
+	switch encryptionConfig.MasterKeySource {
+	case configs.MasterKeySourceConfig:
+		return NewConfigMasterKeyProvider(encryptionConfig, logger)
+	case configs.MasterKeySourceLocalKMS:
+		return NewLocalKMSMasterKeyProvider(encryptionConfig, logger)
+	default:
+		return nil, fmt.Errorf("unsupported master key source: %s", encryptionConfig.MasterKeySource)
+	}
+}
+
+// NewConfigMasterKeyProvider uses the base64 encoded master keys listed in the configuration file.
+func NewConfigMasterKeyProvider(encryptionConfig configs.Encryption, logger *zap.Logger) (MasterKeyProvider, error) {
+	masterKeys := make(map[string][]byte)
+	for masterKeyID, encodedMasterKey := range encryptionConfig.MasterKeys {
+		masterKey, err := decodeMasterKey(encodedMasterKey)
+		if err != nil {
+			logger.With(zap.String("master_key_id", masterKeyID)).With(zap.Error(err)).Error("invalid master key")
+			return nil, err
+		}
+
+		masterKeys[masterKeyID] = masterKey
+	}
+
+	if _, ok := masterKeys[encryptionConfig.CurrentMasterKeyID]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMasterKeyNotFound, encryptionConfig.CurrentMasterKeyID)
+	}
+
+	return &masterKeyProvider{
+		currentMasterKeyID: encryptionConfig.CurrentMasterKeyID,
+		masterKeys:         masterKeys,
+		masterKeysMutex:    &sync.RWMutex{},
+		logger:             logger,
+	}, nil
+}
+
+// NewLocalKMSMasterKeyProvider is a stand-in for a key management service. Each master key is stored in
+// its own file inside the local KMS directory, and the current master key is generated when it does not exist yet.
+func NewLocalKMSMasterKeyProvider(encryptionConfig configs.Encryption, logger *zap.Logger) (MasterKeyProvider, error) {
+	if err := os.MkdirAll(encryptionConfig.LocalKMSDirectory, 0o700); err != nil {
+		logger.With(zap.Error(err)).Error("can not create local kms directory")
+		return nil, err
+	}
+
+	provider := &masterKeyProvider{
+		currentMasterKeyID: encryptionConfig.CurrentMasterKeyID,
+		masterKeys:         make(map[string][]byte),
+		masterKeysMutex:    &sync.RWMutex{},
+		localKMSDirectory:  encryptionConfig.LocalKMSDirectory,
+		logger:             logger,
+	}
+
+	_, err := provider.getMasterKey(encryptionConfig.CurrentMasterKeyID)
+	if err == nil {
+		return provider, nil
+	}
+	if !errors.Is(err, ErrMasterKeyNotFound) {
+		return nil, err
+	}
+
+	if err = provider.generateLocalKMSMasterKey(encryptionConfig.CurrentMasterKeyID); err != nil {
+		logger.With(zap.Error(err)).Error("can not generate master key in local kms")
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+type masterKeyProvider struct {
+	currentMasterKeyID string
+	masterKeys         map[string][]byte
+	masterKeysMutex    *sync.RWMutex
+	localKMSDirectory  string
+	logger             *zap.Logger
+}
+
+// GetCurrentMasterKeyID implements MasterKeyProvider.
+func (m *masterKeyProvider) GetCurrentMasterKeyID() string {
+	return m.currentMasterKeyID
+}
+
+// WrapDataKey implements MasterKeyProvider.
+func (m *masterKeyProvider) WrapDataKey(ctx context.Context, masterKeyID string, dataKey []byte) ([]byte, error) {
+	logger := utils.LoggerWithContext(ctx, m.logger).With(zap.String("master_key_id", masterKeyID))
+
+	aead, err := m.getMasterKeyAEAD(masterKeyID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("can not get master key")
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, dataKey, []byte(masterKeyID)), nil
+}
+
+// UnwrapDataKey implements MasterKeyProvider.
+func (m *masterKeyProvider) UnwrapDataKey(ctx context.Context, masterKeyID string, wrappedDataKey []byte) ([]byte, error) {
+	logger := utils.LoggerWithContext(ctx, m.logger).With(zap.String("master_key_id", masterKeyID))
+
+	aead, err := m.getMasterKeyAEAD(masterKeyID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("can not get master key")
+		return nil, err
+	}
+
+	if len(wrappedDataKey) < aead.NonceSize() {
+		return nil, errors.New("wrapped data key is too short")
+	}
+
+	nonce, sealedDataKey := wrappedDataKey[:aead.NonceSize()], wrappedDataKey[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, sealedDataKey, []byte(masterKeyID))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("can not unwrap data key")
+		return nil, err
+	}
+
+	return dataKey, nil
+}
+
+func (m *masterKeyProvider) getMasterKeyAEAD(masterKeyID string) (cipher.AEAD, error) {
+	masterKey, err := m.getMasterKey(masterKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func (m *masterKeyProvider) getMasterKey(masterKeyID string) ([]byte, error) {
+	m.masterKeysMutex.RLock()
+	masterKey, ok := m.masterKeys[masterKeyID]
+	m.masterKeysMutex.RUnlock()
+	if ok {
+		return masterKey, nil
+	}
+
+	if m.localKMSDirectory == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMasterKeyNotFound, masterKeyID)
+	}
+
+	encodedMasterKey, err := os.ReadFile(m.getLocalKMSKeyFilePath(masterKeyID))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrMasterKeyNotFound, masterKeyID)
+		}
+		return nil, err
+	}
+
+	masterKey, err = decodeMasterKey(strings.TrimSpace(string(encodedMasterKey)))
+	if err != nil {
+		return nil, err
+	}
+
+	m.masterKeysMutex.Lock()
+	m.masterKeys[masterKeyID] = masterKey
+	m.masterKeysMutex.Unlock()
+
+	return masterKey, nil
+}
+
+func (m *masterKeyProvider) generateLocalKMSMasterKey(masterKeyID string) error {
+	masterKey := make([]byte, masterKeySizeInBytes)
+	if _, err := rand.Read(masterKey); err != nil {
+		return err
+	}
+
+	encodedMasterKey := base64.StdEncoding.EncodeToString(masterKey)
+	if err := os.WriteFile(m.getLocalKMSKeyFilePath(masterKeyID), []byte(encodedMasterKey), 0o600); err != nil {
+		return err
+	}
+
+	m.masterKeysMutex.Lock()
+	m.masterKeys[masterKeyID] = masterKey
+	m.masterKeysMutex.Unlock()
+
+	m.logger.With(zap.String("master_key_id", masterKeyID)).Info("generated new master key in local kms")
+	return nil
+}
+
+func (m *masterKeyProvider) getLocalKMSKeyFilePath(masterKeyID string) string {
+	return path.Join(m.localKMSDirectory, path.Base(masterKeyID)+localKMSKeyFileSuffix)
+}
+
+func decodeMasterKey(encodedMasterKey string) ([]byte, error) {
+	masterKey, err := base64.StdEncoding.DecodeString(encodedMasterKey)
+	if err != nil {
+		return nil, fmt.Errorf("master key is not valid base64: %w", err)
+	}
+
+	if len(masterKey) != masterKeySizeInBytes {
+		return nil, fmt.Errorf("master key must be %d bytes long, got %d", masterKeySizeInBytes, len(masterKey))
+	}
+
+	return masterKey, nil
+}
@@ -0,0 +1,106 @@
+package file
+
+import (
+	"fmt"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"go.uber.org/zap"
+)
+
+type Tier string
+
+const (
+	TierLocal Tier = "local"
+	TierS3    Tier = "s3"
+)
+
+// TieredClient gives access to the storage tiers configured for downloaded files.
+// New files are always written to the hot tier. When a cold tier is configured,
+// completed files are moved there by a background job.
+type TieredClient interface {
+	GetHotTier() Tier
+	GetColdTier() (Tier, bool)
+	GetClient(tier Tier) (Client, error)
+}
+
+func NewTieredClient(downloadConfig configs.Download, logger *zap.Logger) (TieredClient, error) {
+	var (
+		tieredClient = &tieredClient{
+			tierClients: make(map[Tier]Client),
+		}
+		err error
+	)
+
+	switch downloadConfig.Mode {
+	case configs.DownloadModeLocal:
+		tieredClient.hotTier = TierLocal
+		tieredClient.tierClients[TierLocal], err = NewLocalClient(downloadConfig, logger)
+		if err != nil {
+			return nil, err
+		}
+	case configs.DownloadModeS3:
+		tieredClient.hotTier = TierS3
+		tieredClient.tierClients[TierS3], err = NewS3Client(downloadConfig, logger)
+		if err != nil {
+			return nil, err
+		}
+	case configs.DownloadModeTiered:
+		tieredClient.hotTier = TierLocal
+		tieredClient.coldTier = TierS3
+		tieredClient.tierClients[TierLocal], err = NewLocalClient(downloadConfig, logger)
+		if err != nil {
+			return nil, err
+		}
+		tieredClient.tierClients[TierS3], err = NewS3Client(downloadConfig, logger)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported download mode: %s", downloadConfig.Mode)
+	}
+
+	if !downloadConfig.Encryption.Enabled {
+		return tieredClient, nil
+	}
+
+	masterKeyProvider, err := NewMasterKeyProvider(downloadConfig.Encryption, logger)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create master key provider")
+		return nil, err
+	}
+
+	for tier, client := range tieredClient.tierClients {
+		tieredClient.tierClients[tier], err = NewEncryptingClient(client, masterKeyProvider, downloadConfig.Encryption, logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tieredClient, nil
+}
+
+type tieredClient struct {
+	hotTier     Tier
+	coldTier    Tier
+	tierClients map[Tier]Client
+}
+
+// GetHotTier implements TieredClient.
+func (t *tieredClient) GetHotTier() Tier {
+	return t.hotTier
+}
+
+// GetColdTier implements TieredClient.
+func (t *tieredClient) GetColdTier() (Tier, bool) {
+	return t.coldTier, t.coldTier != ""
+}
+
+// GetClient implements TieredClient.
+func (t *tieredClient) GetClient(tier Tier) (Client, error) {
+	client, ok := t.tierClients[tier]
+	if !ok {
+		return nil, fmt.Errorf("storage tier not configured: %s", tier)
+	}
+
+	return client, nil
+}
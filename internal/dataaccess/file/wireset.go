@@ -3,5 +3,6 @@ package file
 import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
-	NewClient,
+	NewTieredClient,
+	NewCodecSelector,
 )
@@ -2,9 +2,10 @@ package consumer
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/maxuanquang/idm/internal/configs"
@@ -12,6 +13,15 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// handlerRetryDelay is the time waited before handling again a message whose handler failed.
+	handlerRetryDelay = 5 * time.Second
+	// consumeBaseRetryDelay is doubled each time joining the consumer group fails in a row, up to
+	// consumeMaxRetryDelay.
+	consumeBaseRetryDelay = time.Second
+	consumeMaxRetryDelay  = time.Minute
+)
+
 type Consumer interface {
 	Start(ctx context.Context) error
 	RegisterHandler(queueName string, handlerFunc HandlerFunc)
@@ -21,25 +31,31 @@ func NewConsumer(
 	mqConfig configs.MQ,
 	logger *zap.Logger,
 ) (Consumer, error) {
-	saramaConsumer, err := sarama.NewConsumer(mqConfig.Addresses, newSaramaConfig(mqConfig))
+	saramaConsumerGroup, err := sarama.NewConsumerGroup(mqConfig.Addresses, mqConfig.ConsumerGroupID, newSaramaConfig(mqConfig))
 	if err != nil {
-		logger.With(zap.Error(err)).Error("can not create sarama consumer")
+		logger.With(zap.Error(err)).Error("can not create sarama consumer group")
 		return nil, err
 	}
 
 	return &consumer{
-		saramaConsumer:            saramaConsumer,
+		saramaConsumerGroup:       saramaConsumerGroup,
+		mqConfig:                  mqConfig,
 		logger:                    logger,
 		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
 	}, nil
 }
 
+// HandlerFunc handles a message, the message is committed once it returns no error and is handled again
+// otherwise, so it may be called more than once with the same message. A message still failing after
+// configs.MQ.ConsumerMaxAttemptCount attempts is skipped, so handlers should return no error for messages that
+// can never be handled.
 type HandlerFunc func(ctx context.Context, payload []byte) error
 
 type consumer struct {
+	mqConfig                  configs.MQ
 	logger                    *zap.Logger
 	queueNameToHandlerFuncMap map[string]HandlerFunc
-	saramaConsumer            sarama.Consumer
+	saramaConsumerGroup       sarama.ConsumerGroup
 }
 
 // RegisterHandler implements Consumer.
@@ -54,43 +70,149 @@ func (c *consumer) Start(ctx context.Context) error {
 	exitSignalChannel := make(chan os.Signal, 1)
 	signal.Notify(exitSignalChannel, os.Interrupt)
 
-	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
-		go func(queueName string, handerFunc HandlerFunc) {
-			err := c.consume(queueName, handerFunc, exitSignalChannel)
-			if err != nil {
-				logger.With(zap.String("queueName", queueName)).With(zap.Error(err)).Error("failed to consume message from queue")
-			}
-		}(queueName, handlerFunc)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	queueNameList := make([]string, 0, len(c.queueNameToHandlerFuncMap))
+	for queueName := range c.queueNameToHandlerFuncMap {
+		queueNameList = append(queueNameList, queueName)
 	}
 
+	consumeDoneChannel := make(chan struct{})
+	go func() {
+		defer close(consumeDoneChannel)
+		c.consume(ctx, queueNameList)
+	}()
+
 	<-exitSignalChannel
+	cancel()
+	<-consumeDoneChannel
+
+	if err := c.saramaConsumerGroup.Close(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to close sarama consumer group")
+		return err
+	}
+
 	return nil
 }
 
-func (c *consumer) consume(queueName string, handlerFunc HandlerFunc, exitSignalChannel chan os.Signal) error {
-	logger := c.logger.With(zap.String("queueName", queueName))
+// consume joins the consumer group until ctx is done. Consume returns at each rebalance of the partitions
+// among the members of the group, so it is called again to rejoin.
+func (c *consumer) consume(ctx context.Context, queueNameList []string) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Strings("queueNameList", queueNameList))
 
-	partitionConsumer, err := c.saramaConsumer.ConsumePartition(queueName, 0, sarama.OffsetNewest)
-	if err != nil {
-		return fmt.Errorf("failed to create sarama partition consumer: %w", err)
+	retryDelay := consumeBaseRetryDelay
+	for {
+		err := c.saramaConsumerGroup.Consume(ctx, queueNameList, c)
+		if err == nil {
+			retryDelay = consumeBaseRetryDelay
+		} else {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return
+			}
+
+			logger.With(zap.Error(err)).With(zap.Duration("retry_delay", retryDelay)).Error("failed to consume message from queues")
+
+			select {
+			case <-time.After(retryDelay):
+			case <-ctx.Done():
+			}
+
+			retryDelay = min(retryDelay*2, consumeMaxRetryDelay)
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// Setup implements sarama.ConsumerGroupHandler.
+func (c *consumer) Setup(session sarama.ConsumerGroupSession) error {
+	c.logger.With(zap.Any("claims", session.Claims())).Info("consumer group session started")
+	return nil
+}
+
+// Cleanup implements sarama.ConsumerGroupHandler.
+func (c *consumer) Cleanup(session sarama.ConsumerGroupSession) error {
+	c.logger.With(zap.Any("claims", session.Claims())).Info("consumer group session ended")
+	return nil
+}
+
+// ConsumeClaim implements sarama.ConsumerGroupHandler. The messages of a partition are handled in order, a
+// message whose handler fails is handled again before the next one so that the offset committed does not skip
+// it, until it runs out of attempts.
+func (c *consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	logger := c.logger.With(zap.String("queueName", claim.Topic())).With(zap.Int32("partition", claim.Partition()))
+
+	handlerFunc, ok := c.queueNameToHandlerFuncMap[claim.Topic()]
+	if !ok {
+		logger.Error("no handler registered for queue")
+		return nil
 	}
 
 	for {
 		select {
-		case message := <-partitionConsumer.Messages():
-			err = handlerFunc(context.Background(), message.Value)
-			if err != nil {
-				logger.With(zap.Error(err)).Error("failed to handle message")
+		case message, ok := <-claim.Messages():
+			if !ok {
+				return nil
 			}
-		case <-exitSignalChannel:
+
+			if !c.handleMessage(session, handlerFunc, message) {
+				return nil
+			}
+
+			session.MarkMessage(message, "")
+			session.Commit()
+		case <-session.Context().Done():
 			return nil
 		}
 	}
 }
 
+// handleMessage returns false if the session ends before the handler succeeds, the message is then handled by
+// the member the partition is assigned to next. It returns true once the handler succeeds or fails
+// configs.MQ.ConsumerMaxAttemptCount times, the message is then skipped.
+func (c *consumer) handleMessage(
+	session sarama.ConsumerGroupSession,
+	handlerFunc HandlerFunc,
+	message *sarama.ConsumerMessage,
+) bool {
+	logger := c.logger.
+		With(zap.String("queueName", message.Topic)).
+		With(zap.Int32("partition", message.Partition)).
+		With(zap.Int64("offset", message.Offset))
+
+	for attemptCount := 1; ; attemptCount++ {
+		err := handlerFunc(context.Background(), message.Value)
+		if err == nil {
+			return true
+		}
+
+		logger := logger.With(zap.Int("attempt_count", attemptCount)).With(zap.Error(err))
+		if attemptCount >= c.mqConfig.ConsumerMaxAttemptCount {
+			logger.With(zap.ByteString("payload", message.Value)).Error("failed to handle message, skipping")
+			return true
+		}
+
+		logger.Error("failed to handle message")
+
+		select {
+		case <-time.After(handlerRetryDelay):
+		case <-session.Context().Done():
+			return false
+		}
+	}
+}
+
 func newSaramaConfig(mqConfig configs.MQ) *sarama.Config {
 	config := sarama.NewConfig()
 	config.ClientID = mqConfig.ClientID
 	config.Metadata.Full = true
+	// A group without committed offsets starts from the oldest message kept, so that no message produced
+	// before it is first started is lost.
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	// Offsets are committed by ConsumeClaim once their message is handled.
+	config.Consumer.Offsets.AutoCommit.Enable = false
 	return config
 }
@@ -11,7 +11,9 @@ import (
 )
 
 type Client interface {
-	Produce(ctx context.Context, queueName string, payload []byte) error
+	// Produce sends the payload to the queue, the messages with the same key go to the same partition and are
+	// consumed in the order they are produced. Messages with an empty key are spread across partitions.
+	Produce(ctx context.Context, queueName string, key string, payload []byte) error
 }
 
 func NewClient(mqConfig configs.MQ, logger *zap.Logger) (Client, error) {
@@ -32,15 +34,21 @@ type client struct {
 }
 
 // Produce implements Client.
-func (c *client) Produce(ctx context.Context, queueName string, payload []byte) error {
+func (c *client) Produce(ctx context.Context, queueName string, key string, payload []byte) error {
 	logger := utils.LoggerWithContext(ctx, c.logger).
 		With(zap.String("queue_name", queueName)).
+		With(zap.String("key", key)).
 		With(zap.ByteString("payload", payload))
 
-	_, _, err := c.saramaSyncProducer.SendMessage(&sarama.ProducerMessage{
+	message := &sarama.ProducerMessage{
 		Topic: queueName,
 		Value: sarama.ByteEncoder(payload),
-	})
+	}
+	if key != "" {
+		message.Key = sarama.StringEncoder(key)
+	}
+
+	_, _, err := c.saramaSyncProducer.SendMessage(message)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to produce message")
 		return err
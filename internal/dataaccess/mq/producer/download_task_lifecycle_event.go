@@ -0,0 +1,40 @@
+package producer
+
+import (
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// DownloadTaskLifecycleEventVersion is the version of the download task lifecycle events produced.
+	DownloadTaskLifecycleEventVersion = 1
+
+	MessageQueueDownloadTaskLifecycleCreated   = "download_task_lifecycle_created"
+	MessageQueueDownloadTaskLifecycleStarted   = "download_task_lifecycle_started"
+	MessageQueueDownloadTaskLifecycleSucceeded = "download_task_lifecycle_succeeded"
+	MessageQueueDownloadTaskLifecycleFailed    = "download_task_lifecycle_failed"
+	MessageQueueDownloadTaskLifecycleCancelled = "download_task_lifecycle_cancelled"
+	MessageQueueDownloadTaskLifecycleRequeued  = "download_task_lifecycle_requeued"
+)
+
+var downloadTaskLifecycleEventTypeToQueueNameMap = map[idm.DownloadTaskLifecycleEventType]string{
+	idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventCreated:   MessageQueueDownloadTaskLifecycleCreated,
+	idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventStarted:   MessageQueueDownloadTaskLifecycleStarted,
+	idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventSucceeded: MessageQueueDownloadTaskLifecycleSucceeded,
+	idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventFailed:    MessageQueueDownloadTaskLifecycleFailed,
+	idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventCancelled: MessageQueueDownloadTaskLifecycleCancelled,
+	idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventRequeued:  MessageQueueDownloadTaskLifecycleRequeued,
+}
+
+// NewDownloadTaskLifecycleEventPayload returns the JSON payload of the message produced for the event to the
+// queue of its type.
+func NewDownloadTaskLifecycleEventPayload(event *idm.DownloadTaskLifecycleEvent) ([]byte, error) {
+	return protojson.Marshal(event)
+}
+
+// GetDownloadTaskLifecycleEventQueueName returns the queue download task lifecycle events of the type are
+// produced to.
+func GetDownloadTaskLifecycleEventQueueName(eventType idm.DownloadTaskLifecycleEventType) (string, bool) {
+	queueName, ok := downloadTaskLifecycleEventTypeToQueueNameMap[eventType]
+	return queueName, ok
+}
@@ -19,14 +19,51 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	IdmService_CreateAccount_FullMethodName       = "/idm.IdmService/CreateAccount"
-	IdmService_CreateSession_FullMethodName       = "/idm.IdmService/CreateSession"
-	IdmService_DeleteSession_FullMethodName       = "/idm.IdmService/DeleteSession"
-	IdmService_CreateDownloadTask_FullMethodName  = "/idm.IdmService/CreateDownloadTask"
-	IdmService_GetDownloadTaskList_FullMethodName = "/idm.IdmService/GetDownloadTaskList"
-	IdmService_UpdateDownloadTask_FullMethodName  = "/idm.IdmService/UpdateDownloadTask"
-	IdmService_DeleteDownloadTask_FullMethodName  = "/idm.IdmService/DeleteDownloadTask"
-	IdmService_GetDownloadTaskFile_FullMethodName = "/idm.IdmService/GetDownloadTaskFile"
+	IdmService_CreateAccount_FullMethodName          = "/idm.IdmService/CreateAccount"
+	IdmService_CreateSession_FullMethodName          = "/idm.IdmService/CreateSession"
+	IdmService_CompleteTOTPLogin_FullMethodName      = "/idm.IdmService/CompleteTOTPLogin"
+	IdmService_RefreshSession_FullMethodName         = "/idm.IdmService/RefreshSession"
+	IdmService_DeleteSession_FullMethodName          = "/idm.IdmService/DeleteSession"
+	IdmService_ListSessions_FullMethodName           = "/idm.IdmService/ListSessions"
+	IdmService_ChangePassword_FullMethodName         = "/idm.IdmService/ChangePassword"
+	IdmService_ResetPassword_FullMethodName          = "/idm.IdmService/ResetPassword"
+	IdmService_EnrollTOTP_FullMethodName             = "/idm.IdmService/EnrollTOTP"
+	IdmService_ActivateTOTP_FullMethodName           = "/idm.IdmService/ActivateTOTP"
+	IdmService_DisableTOTP_FullMethodName            = "/idm.IdmService/DisableTOTP"
+	IdmService_GetOIDCProviderList_FullMethodName    = "/idm.IdmService/GetOIDCProviderList"
+	IdmService_StartOIDCLogin_FullMethodName         = "/idm.IdmService/StartOIDCLogin"
+	IdmService_FinishOIDCLogin_FullMethodName        = "/idm.IdmService/FinishOIDCLogin"
+	IdmService_CreateAPIKey_FullMethodName           = "/idm.IdmService/CreateAPIKey"
+	IdmService_GetAPIKeyList_FullMethodName          = "/idm.IdmService/GetAPIKeyList"
+	IdmService_DeleteAPIKey_FullMethodName           = "/idm.IdmService/DeleteAPIKey"
+	IdmService_CreateWorkspace_FullMethodName        = "/idm.IdmService/CreateWorkspace"
+	IdmService_GetWorkspaceList_FullMethodName       = "/idm.IdmService/GetWorkspaceList"
+	IdmService_GetWorkspaceMemberList_FullMethodName = "/idm.IdmService/GetWorkspaceMemberList"
+	IdmService_AddWorkspaceMember_FullMethodName     = "/idm.IdmService/AddWorkspaceMember"
+	IdmService_UpdateWorkspaceMember_FullMethodName  = "/idm.IdmService/UpdateWorkspaceMember"
+	IdmService_RemoveWorkspaceMember_FullMethodName  = "/idm.IdmService/RemoveWorkspaceMember"
+	IdmService_CreateDownloadTask_FullMethodName     = "/idm.IdmService/CreateDownloadTask"
+	IdmService_GetDownloadTaskList_FullMethodName    = "/idm.IdmService/GetDownloadTaskList"
+	IdmService_UpdateDownloadTask_FullMethodName     = "/idm.IdmService/UpdateDownloadTask"
+	IdmService_DeleteDownloadTask_FullMethodName     = "/idm.IdmService/DeleteDownloadTask"
+	IdmService_GetDownloadTaskHistory_FullMethodName = "/idm.IdmService/GetDownloadTaskHistory"
+	IdmService_GetDownloadTaskFile_FullMethodName    = "/idm.IdmService/GetDownloadTaskFile"
+	IdmService_CreateTag_FullMethodName              = "/idm.IdmService/CreateTag"
+	IdmService_GetTagList_FullMethodName             = "/idm.IdmService/GetTagList"
+	IdmService_UpdateTag_FullMethodName              = "/idm.IdmService/UpdateTag"
+	IdmService_DeleteTag_FullMethodName              = "/idm.IdmService/DeleteTag"
+	IdmService_CreateCategory_FullMethodName         = "/idm.IdmService/CreateCategory"
+	IdmService_GetCategoryList_FullMethodName        = "/idm.IdmService/GetCategoryList"
+	IdmService_UpdateCategory_FullMethodName         = "/idm.IdmService/UpdateCategory"
+	IdmService_DeleteCategory_FullMethodName         = "/idm.IdmService/DeleteCategory"
+	IdmService_CreateShareLink_FullMethodName        = "/idm.IdmService/CreateShareLink"
+	IdmService_GetShareLinkList_FullMethodName       = "/idm.IdmService/GetShareLinkList"
+	IdmService_DeleteShareLink_FullMethodName        = "/idm.IdmService/DeleteShareLink"
+	IdmService_GetSharedFile_FullMethodName          = "/idm.IdmService/GetSharedFile"
+	IdmService_CreateWebhook_FullMethodName          = "/idm.IdmService/CreateWebhook"
+	IdmService_GetWebhookList_FullMethodName         = "/idm.IdmService/GetWebhookList"
+	IdmService_DeleteWebhook_FullMethodName          = "/idm.IdmService/DeleteWebhook"
+	IdmService_GetWebhookDeliveryList_FullMethodName = "/idm.IdmService/GetWebhookDeliveryList"
 )
 
 // IdmServiceClient is the client API for IdmService service.
@@ -35,12 +72,49 @@ const (
 type IdmServiceClient interface {
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	CompleteTOTPLogin(ctx context.Context, in *CompleteTOTPLoginRequest, opts ...grpc.CallOption) (*CompleteTOTPLoginResponse, error)
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)
 	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error)
+	ActivateTOTP(ctx context.Context, in *ActivateTOTPRequest, opts ...grpc.CallOption) (*ActivateTOTPResponse, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error)
+	GetOIDCProviderList(ctx context.Context, in *GetOIDCProviderListRequest, opts ...grpc.CallOption) (*GetOIDCProviderListResponse, error)
+	StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error)
+	FinishOIDCLogin(ctx context.Context, in *FinishOIDCLoginRequest, opts ...grpc.CallOption) (*FinishOIDCLoginResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	GetAPIKeyList(ctx context.Context, in *GetAPIKeyListRequest, opts ...grpc.CallOption) (*GetAPIKeyListResponse, error)
+	DeleteAPIKey(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*DeleteAPIKeyResponse, error)
+	CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceResponse, error)
+	GetWorkspaceList(ctx context.Context, in *GetWorkspaceListRequest, opts ...grpc.CallOption) (*GetWorkspaceListResponse, error)
+	GetWorkspaceMemberList(ctx context.Context, in *GetWorkspaceMemberListRequest, opts ...grpc.CallOption) (*GetWorkspaceMemberListResponse, error)
+	AddWorkspaceMember(ctx context.Context, in *AddWorkspaceMemberRequest, opts ...grpc.CallOption) (*AddWorkspaceMemberResponse, error)
+	UpdateWorkspaceMember(ctx context.Context, in *UpdateWorkspaceMemberRequest, opts ...grpc.CallOption) (*UpdateWorkspaceMemberResponse, error)
+	RemoveWorkspaceMember(ctx context.Context, in *RemoveWorkspaceMemberRequest, opts ...grpc.CallOption) (*RemoveWorkspaceMemberResponse, error)
 	CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error)
 	GetDownloadTaskList(ctx context.Context, in *GetDownloadTaskListRequest, opts ...grpc.CallOption) (*GetDownloadTaskListResponse, error)
 	UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error)
 	DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error)
+	GetDownloadTaskHistory(ctx context.Context, in *GetDownloadTaskHistoryRequest, opts ...grpc.CallOption) (*GetDownloadTaskHistoryResponse, error)
 	GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (IdmService_GetDownloadTaskFileClient, error)
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error)
+	GetTagList(ctx context.Context, in *GetTagListRequest, opts ...grpc.CallOption) (*GetTagListResponse, error)
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+	GetCategoryList(ctx context.Context, in *GetCategoryListRequest, opts ...grpc.CallOption) (*GetCategoryListResponse, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error)
+	GetShareLinkList(ctx context.Context, in *GetShareLinkListRequest, opts ...grpc.CallOption) (*GetShareLinkListResponse, error)
+	DeleteShareLink(ctx context.Context, in *DeleteShareLinkRequest, opts ...grpc.CallOption) (*DeleteShareLinkResponse, error)
+	GetSharedFile(ctx context.Context, in *GetSharedFileRequest, opts ...grpc.CallOption) (IdmService_GetSharedFileClient, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	GetWebhookList(ctx context.Context, in *GetWebhookListRequest, opts ...grpc.CallOption) (*GetWebhookListResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	GetWebhookDeliveryList(ctx context.Context, in *GetWebhookDeliveryListRequest, opts ...grpc.CallOption) (*GetWebhookDeliveryListResponse, error)
 }
 
 type idmServiceClient struct {
@@ -69,6 +143,24 @@ func (c *idmServiceClient) CreateSession(ctx context.Context, in *CreateSessionR
 	return out, nil
 }
 
+func (c *idmServiceClient) CompleteTOTPLogin(ctx context.Context, in *CompleteTOTPLoginRequest, opts ...grpc.CallOption) (*CompleteTOTPLoginResponse, error) {
+	out := new(CompleteTOTPLoginResponse)
+	err := c.cc.Invoke(ctx, IdmService_CompleteTOTPLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error) {
+	out := new(RefreshSessionResponse)
+	err := c.cc.Invoke(ctx, IdmService_RefreshSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *idmServiceClient) DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error) {
 	out := new(DeleteSessionResponse)
 	err := c.cc.Invoke(ctx, IdmService_DeleteSession_FullMethodName, in, out, opts...)
@@ -78,319 +170,2046 @@ func (c *idmServiceClient) DeleteSession(ctx context.Context, in *DeleteSessionR
 	return out, nil
 }
 
-func (c *idmServiceClient) CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error) {
-	out := new(CreateDownloadTaskResponse)
-	err := c.cc.Invoke(ctx, IdmService_CreateDownloadTask_FullMethodName, in, out, opts...)
+func (c *idmServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, IdmService_ListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, IdmService_ChangePassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, IdmService_ResetPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error) {
+	out := new(EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, IdmService_EnrollTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) ActivateTOTP(ctx context.Context, in *ActivateTOTPRequest, opts ...grpc.CallOption) (*ActivateTOTPResponse, error) {
+	out := new(ActivateTOTPResponse)
+	err := c.cc.Invoke(ctx, IdmService_ActivateTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error) {
+	out := new(DisableTOTPResponse)
+	err := c.cc.Invoke(ctx, IdmService_DisableTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetOIDCProviderList(ctx context.Context, in *GetOIDCProviderListRequest, opts ...grpc.CallOption) (*GetOIDCProviderListResponse, error) {
+	out := new(GetOIDCProviderListResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetOIDCProviderList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error) {
+	out := new(StartOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, IdmService_StartOIDCLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) FinishOIDCLogin(ctx context.Context, in *FinishOIDCLoginRequest, opts ...grpc.CallOption) (*FinishOIDCLoginResponse, error) {
+	out := new(FinishOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, IdmService_FinishOIDCLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, IdmService_CreateAPIKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetAPIKeyList(ctx context.Context, in *GetAPIKeyListRequest, opts ...grpc.CallOption) (*GetAPIKeyListResponse, error) {
+	out := new(GetAPIKeyListResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetAPIKeyList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) DeleteAPIKey(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*DeleteAPIKeyResponse, error) {
+	out := new(DeleteAPIKeyResponse)
+	err := c.cc.Invoke(ctx, IdmService_DeleteAPIKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceResponse, error) {
+	out := new(CreateWorkspaceResponse)
+	err := c.cc.Invoke(ctx, IdmService_CreateWorkspace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetWorkspaceList(ctx context.Context, in *GetWorkspaceListRequest, opts ...grpc.CallOption) (*GetWorkspaceListResponse, error) {
+	out := new(GetWorkspaceListResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetWorkspaceList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetWorkspaceMemberList(ctx context.Context, in *GetWorkspaceMemberListRequest, opts ...grpc.CallOption) (*GetWorkspaceMemberListResponse, error) {
+	out := new(GetWorkspaceMemberListResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetWorkspaceMemberList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) AddWorkspaceMember(ctx context.Context, in *AddWorkspaceMemberRequest, opts ...grpc.CallOption) (*AddWorkspaceMemberResponse, error) {
+	out := new(AddWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, IdmService_AddWorkspaceMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) UpdateWorkspaceMember(ctx context.Context, in *UpdateWorkspaceMemberRequest, opts ...grpc.CallOption) (*UpdateWorkspaceMemberResponse, error) {
+	out := new(UpdateWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, IdmService_UpdateWorkspaceMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) RemoveWorkspaceMember(ctx context.Context, in *RemoveWorkspaceMemberRequest, opts ...grpc.CallOption) (*RemoveWorkspaceMemberResponse, error) {
+	out := new(RemoveWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, IdmService_RemoveWorkspaceMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error) {
+	out := new(CreateDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, IdmService_CreateDownloadTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetDownloadTaskList(ctx context.Context, in *GetDownloadTaskListRequest, opts ...grpc.CallOption) (*GetDownloadTaskListResponse, error) {
+	out := new(GetDownloadTaskListResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetDownloadTaskList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error) {
+	out := new(UpdateDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, IdmService_UpdateDownloadTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error) {
+	out := new(DeleteDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, IdmService_DeleteDownloadTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetDownloadTaskHistory(ctx context.Context, in *GetDownloadTaskHistoryRequest, opts ...grpc.CallOption) (*GetDownloadTaskHistoryResponse, error) {
+	out := new(GetDownloadTaskHistoryResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetDownloadTaskHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (IdmService_GetDownloadTaskFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &IdmService_ServiceDesc.Streams[0], IdmService_GetDownloadTaskFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &idmServiceGetDownloadTaskFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IdmService_GetDownloadTaskFileClient interface {
+	Recv() (*GetDownloadTaskFileResponse, error)
+	grpc.ClientStream
+}
+
+type idmServiceGetDownloadTaskFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *idmServiceGetDownloadTaskFileClient) Recv() (*GetDownloadTaskFileResponse, error) {
+	m := new(GetDownloadTaskFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *idmServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error) {
+	out := new(CreateTagResponse)
+	err := c.cc.Invoke(ctx, IdmService_CreateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetTagList(ctx context.Context, in *GetTagListRequest, opts ...grpc.CallOption) (*GetTagListResponse, error) {
+	out := new(GetTagListResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetTagList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error) {
+	out := new(UpdateTagResponse)
+	err := c.cc.Invoke(ctx, IdmService_UpdateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, IdmService_DeleteTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
+	out := new(CreateCategoryResponse)
+	err := c.cc.Invoke(ctx, IdmService_CreateCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetCategoryList(ctx context.Context, in *GetCategoryListRequest, opts ...grpc.CallOption) (*GetCategoryListResponse, error) {
+	out := new(GetCategoryListResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetCategoryList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error) {
+	out := new(UpdateCategoryResponse)
+	err := c.cc.Invoke(ctx, IdmService_UpdateCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, IdmService_DeleteCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error) {
+	out := new(CreateShareLinkResponse)
+	err := c.cc.Invoke(ctx, IdmService_CreateShareLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetShareLinkList(ctx context.Context, in *GetShareLinkListRequest, opts ...grpc.CallOption) (*GetShareLinkListResponse, error) {
+	out := new(GetShareLinkListResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetShareLinkList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) DeleteShareLink(ctx context.Context, in *DeleteShareLinkRequest, opts ...grpc.CallOption) (*DeleteShareLinkResponse, error) {
+	out := new(DeleteShareLinkResponse)
+	err := c.cc.Invoke(ctx, IdmService_DeleteShareLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetSharedFile(ctx context.Context, in *GetSharedFileRequest, opts ...grpc.CallOption) (IdmService_GetSharedFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &IdmService_ServiceDesc.Streams[1], IdmService_GetSharedFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &idmServiceGetSharedFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IdmService_GetSharedFileClient interface {
+	Recv() (*GetSharedFileResponse, error)
+	grpc.ClientStream
+}
+
+type idmServiceGetSharedFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *idmServiceGetSharedFileClient) Recv() (*GetSharedFileResponse, error) {
+	m := new(GetSharedFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *idmServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, IdmService_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetWebhookList(ctx context.Context, in *GetWebhookListRequest, opts ...grpc.CallOption) (*GetWebhookListResponse, error) {
+	out := new(GetWebhookListResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetWebhookList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, IdmService_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idmServiceClient) GetWebhookDeliveryList(ctx context.Context, in *GetWebhookDeliveryListRequest, opts ...grpc.CallOption) (*GetWebhookDeliveryListResponse, error) {
+	out := new(GetWebhookDeliveryListResponse)
+	err := c.cc.Invoke(ctx, IdmService_GetWebhookDeliveryList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IdmServiceServer is the server API for IdmService service.
+// All implementations must embed UnimplementedIdmServiceServer
+// for forward compatibility
+type IdmServiceServer interface {
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	CompleteTOTPLogin(context.Context, *CompleteTOTPLoginRequest) (*CompleteTOTPLoginResponse, error)
+	RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error)
+	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error)
+	ActivateTOTP(context.Context, *ActivateTOTPRequest) (*ActivateTOTPResponse, error)
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error)
+	GetOIDCProviderList(context.Context, *GetOIDCProviderListRequest) (*GetOIDCProviderListResponse, error)
+	StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error)
+	FinishOIDCLogin(context.Context, *FinishOIDCLoginRequest) (*FinishOIDCLoginResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	GetAPIKeyList(context.Context, *GetAPIKeyListRequest) (*GetAPIKeyListResponse, error)
+	DeleteAPIKey(context.Context, *DeleteAPIKeyRequest) (*DeleteAPIKeyResponse, error)
+	CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error)
+	GetWorkspaceList(context.Context, *GetWorkspaceListRequest) (*GetWorkspaceListResponse, error)
+	GetWorkspaceMemberList(context.Context, *GetWorkspaceMemberListRequest) (*GetWorkspaceMemberListResponse, error)
+	AddWorkspaceMember(context.Context, *AddWorkspaceMemberRequest) (*AddWorkspaceMemberResponse, error)
+	UpdateWorkspaceMember(context.Context, *UpdateWorkspaceMemberRequest) (*UpdateWorkspaceMemberResponse, error)
+	RemoveWorkspaceMember(context.Context, *RemoveWorkspaceMemberRequest) (*RemoveWorkspaceMemberResponse, error)
+	CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error)
+	GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error)
+	UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error)
+	DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error)
+	GetDownloadTaskHistory(context.Context, *GetDownloadTaskHistoryRequest) (*GetDownloadTaskHistoryResponse, error)
+	GetDownloadTaskFile(*GetDownloadTaskFileRequest, IdmService_GetDownloadTaskFileServer) error
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	GetTagList(context.Context, *GetTagListRequest) (*GetTagListResponse, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	GetCategoryList(context.Context, *GetCategoryListRequest) (*GetCategoryListResponse, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error)
+	GetShareLinkList(context.Context, *GetShareLinkListRequest) (*GetShareLinkListResponse, error)
+	DeleteShareLink(context.Context, *DeleteShareLinkRequest) (*DeleteShareLinkResponse, error)
+	GetSharedFile(*GetSharedFileRequest, IdmService_GetSharedFileServer) error
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	GetWebhookList(context.Context, *GetWebhookListRequest) (*GetWebhookListResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	GetWebhookDeliveryList(context.Context, *GetWebhookDeliveryListRequest) (*GetWebhookDeliveryListResponse, error)
+	mustEmbedUnimplementedIdmServiceServer()
+}
+
+// UnimplementedIdmServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedIdmServiceServer struct {
+}
+
+func (UnimplementedIdmServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedIdmServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedIdmServiceServer) CompleteTOTPLogin(context.Context, *CompleteTOTPLoginRequest) (*CompleteTOTPLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTOTPLogin not implemented")
+}
+func (UnimplementedIdmServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedIdmServiceServer) DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
+}
+func (UnimplementedIdmServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedIdmServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedIdmServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedIdmServiceServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedIdmServiceServer) ActivateTOTP(context.Context, *ActivateTOTPRequest) (*ActivateTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateTOTP not implemented")
+}
+func (UnimplementedIdmServiceServer) DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedIdmServiceServer) GetOIDCProviderList(context.Context, *GetOIDCProviderListRequest) (*GetOIDCProviderListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCProviderList not implemented")
+}
+func (UnimplementedIdmServiceServer) StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOIDCLogin not implemented")
+}
+func (UnimplementedIdmServiceServer) FinishOIDCLogin(context.Context, *FinishOIDCLoginRequest) (*FinishOIDCLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishOIDCLogin not implemented")
+}
+func (UnimplementedIdmServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedIdmServiceServer) GetAPIKeyList(context.Context, *GetAPIKeyListRequest) (*GetAPIKeyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPIKeyList not implemented")
+}
+func (UnimplementedIdmServiceServer) DeleteAPIKey(context.Context, *DeleteAPIKeyRequest) (*DeleteAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAPIKey not implemented")
+}
+func (UnimplementedIdmServiceServer) CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspace not implemented")
+}
+func (UnimplementedIdmServiceServer) GetWorkspaceList(context.Context, *GetWorkspaceListRequest) (*GetWorkspaceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceList not implemented")
+}
+func (UnimplementedIdmServiceServer) GetWorkspaceMemberList(context.Context, *GetWorkspaceMemberListRequest) (*GetWorkspaceMemberListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceMemberList not implemented")
+}
+func (UnimplementedIdmServiceServer) AddWorkspaceMember(context.Context, *AddWorkspaceMemberRequest) (*AddWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorkspaceMember not implemented")
+}
+func (UnimplementedIdmServiceServer) UpdateWorkspaceMember(context.Context, *UpdateWorkspaceMemberRequest) (*UpdateWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceMember not implemented")
+}
+func (UnimplementedIdmServiceServer) RemoveWorkspaceMember(context.Context, *RemoveWorkspaceMemberRequest) (*RemoveWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWorkspaceMember not implemented")
+}
+func (UnimplementedIdmServiceServer) CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDownloadTask not implemented")
+}
+func (UnimplementedIdmServiceServer) GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTaskList not implemented")
+}
+func (UnimplementedIdmServiceServer) UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDownloadTask not implemented")
+}
+func (UnimplementedIdmServiceServer) DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDownloadTask not implemented")
+}
+func (UnimplementedIdmServiceServer) GetDownloadTaskHistory(context.Context, *GetDownloadTaskHistoryRequest) (*GetDownloadTaskHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTaskHistory not implemented")
+}
+func (UnimplementedIdmServiceServer) GetDownloadTaskFile(*GetDownloadTaskFileRequest, IdmService_GetDownloadTaskFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetDownloadTaskFile not implemented")
+}
+func (UnimplementedIdmServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedIdmServiceServer) GetTagList(context.Context, *GetTagListRequest) (*GetTagListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagList not implemented")
+}
+func (UnimplementedIdmServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedIdmServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedIdmServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedIdmServiceServer) GetCategoryList(context.Context, *GetCategoryListRequest) (*GetCategoryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryList not implemented")
+}
+func (UnimplementedIdmServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedIdmServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedIdmServiceServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedIdmServiceServer) GetShareLinkList(context.Context, *GetShareLinkListRequest) (*GetShareLinkListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShareLinkList not implemented")
+}
+func (UnimplementedIdmServiceServer) DeleteShareLink(context.Context, *DeleteShareLinkRequest) (*DeleteShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShareLink not implemented")
+}
+func (UnimplementedIdmServiceServer) GetSharedFile(*GetSharedFileRequest, IdmService_GetSharedFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetSharedFile not implemented")
+}
+func (UnimplementedIdmServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedIdmServiceServer) GetWebhookList(context.Context, *GetWebhookListRequest) (*GetWebhookListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookList not implemented")
+}
+func (UnimplementedIdmServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedIdmServiceServer) GetWebhookDeliveryList(context.Context, *GetWebhookDeliveryListRequest) (*GetWebhookDeliveryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveryList not implemented")
+}
+func (UnimplementedIdmServiceServer) mustEmbedUnimplementedIdmServiceServer() {}
+
+// UnsafeIdmServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IdmServiceServer will
+// result in compilation errors.
+type UnsafeIdmServiceServer interface {
+	mustEmbedUnimplementedIdmServiceServer()
+}
+
+func RegisterIdmServiceServer(s grpc.ServiceRegistrar, srv IdmServiceServer) {
+	s.RegisterService(&IdmService_ServiceDesc, srv)
+}
+
+func _IdmService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_CompleteTOTPLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTOTPLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).CompleteTOTPLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_CompleteTOTPLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).CompleteTOTPLogin(ctx, req.(*CompleteTOTPLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).RefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_RefreshSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).DeleteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_DeleteSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_ActivateTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).ActivateTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_ActivateTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).ActivateTOTP(ctx, req.(*ActivateTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetOIDCProviderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOIDCProviderListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetOIDCProviderList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetOIDCProviderList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetOIDCProviderList(ctx, req.(*GetOIDCProviderListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_StartOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).StartOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_StartOIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).StartOIDCLogin(ctx, req.(*StartOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_FinishOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishOIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).FinishOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_FinishOIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).FinishOIDCLogin(ctx, req.(*FinishOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetAPIKeyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIKeyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetAPIKeyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetAPIKeyList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetAPIKeyList(ctx, req.(*GetAPIKeyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_DeleteAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).DeleteAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_DeleteAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).DeleteAPIKey(ctx, req.(*DeleteAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_CreateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).CreateWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_CreateWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).CreateWorkspace(ctx, req.(*CreateWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetWorkspaceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetWorkspaceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetWorkspaceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetWorkspaceList(ctx, req.(*GetWorkspaceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetWorkspaceMemberList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceMemberListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetWorkspaceMemberList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetWorkspaceMemberList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetWorkspaceMemberList(ctx, req.(*GetWorkspaceMemberListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_AddWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorkspaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).AddWorkspaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_AddWorkspaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).AddWorkspaceMember(ctx, req.(*AddWorkspaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_UpdateWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).UpdateWorkspaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_UpdateWorkspaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).UpdateWorkspaceMember(ctx, req.(*UpdateWorkspaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_RemoveWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWorkspaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).RemoveWorkspaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_RemoveWorkspaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).RemoveWorkspaceMember(ctx, req.(*RemoveWorkspaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_CreateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).CreateDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_CreateDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).CreateDownloadTask(ctx, req.(*CreateDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetDownloadTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetDownloadTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetDownloadTaskList(ctx, req.(*GetDownloadTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_UpdateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).UpdateDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_UpdateDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).UpdateDownloadTask(ctx, req.(*UpdateDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_DeleteDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).DeleteDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_DeleteDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).DeleteDownloadTask(ctx, req.(*DeleteDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetDownloadTaskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadTaskHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetDownloadTaskHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetDownloadTaskHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetDownloadTaskHistory(ctx, req.(*GetDownloadTaskHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetDownloadTaskFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDownloadTaskFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IdmServiceServer).GetDownloadTaskFile(m, &idmServiceGetDownloadTaskFileServer{stream})
+}
+
+type IdmService_GetDownloadTaskFileServer interface {
+	Send(*GetDownloadTaskFileResponse) error
+	grpc.ServerStream
+}
+
+type idmServiceGetDownloadTaskFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *idmServiceGetDownloadTaskFileServer) Send(m *GetDownloadTaskFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _IdmService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetTagList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetTagList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetTagList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetTagList(ctx, req.(*GetTagListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetCategoryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetCategoryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetCategoryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetCategoryList(ctx, req.(*GetCategoryListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).CreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_CreateShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetShareLinkList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShareLinkListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetShareLinkList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetShareLinkList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetShareLinkList(ctx, req.(*GetShareLinkListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_DeleteShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).DeleteShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_DeleteShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).DeleteShareLink(ctx, req.(*DeleteShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetSharedFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSharedFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IdmServiceServer).GetSharedFile(m, &idmServiceGetSharedFileServer{stream})
+}
+
+type IdmService_GetSharedFileServer interface {
+	Send(*GetSharedFileResponse) error
+	grpc.ServerStream
+}
+
+type idmServiceGetSharedFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *idmServiceGetSharedFileServer) Send(m *GetSharedFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _IdmService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetWebhookList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetWebhookList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetWebhookList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetWebhookList(ctx, req.(*GetWebhookListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdmService_GetWebhookDeliveryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveryListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdmServiceServer).GetWebhookDeliveryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdmService_GetWebhookDeliveryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdmServiceServer).GetWebhookDeliveryList(ctx, req.(*GetWebhookDeliveryListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IdmService_ServiceDesc is the grpc.ServiceDesc for IdmService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IdmService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "idm.IdmService",
+	HandlerType: (*IdmServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccount",
+			Handler:    _IdmService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _IdmService_CreateSession_Handler,
+		},
+		{
+			MethodName: "CompleteTOTPLogin",
+			Handler:    _IdmService_CompleteTOTPLogin_Handler,
+		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _IdmService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _IdmService_DeleteSession_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _IdmService_ListSessions_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _IdmService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _IdmService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _IdmService_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ActivateTOTP",
+			Handler:    _IdmService_ActivateTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _IdmService_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "GetOIDCProviderList",
+			Handler:    _IdmService_GetOIDCProviderList_Handler,
+		},
+		{
+			MethodName: "StartOIDCLogin",
+			Handler:    _IdmService_StartOIDCLogin_Handler,
+		},
+		{
+			MethodName: "FinishOIDCLogin",
+			Handler:    _IdmService_FinishOIDCLogin_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _IdmService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "GetAPIKeyList",
+			Handler:    _IdmService_GetAPIKeyList_Handler,
+		},
+		{
+			MethodName: "DeleteAPIKey",
+			Handler:    _IdmService_DeleteAPIKey_Handler,
+		},
+		{
+			MethodName: "CreateWorkspace",
+			Handler:    _IdmService_CreateWorkspace_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceList",
+			Handler:    _IdmService_GetWorkspaceList_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceMemberList",
+			Handler:    _IdmService_GetWorkspaceMemberList_Handler,
+		},
+		{
+			MethodName: "AddWorkspaceMember",
+			Handler:    _IdmService_AddWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "UpdateWorkspaceMember",
+			Handler:    _IdmService_UpdateWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "RemoveWorkspaceMember",
+			Handler:    _IdmService_RemoveWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "CreateDownloadTask",
+			Handler:    _IdmService_CreateDownloadTask_Handler,
+		},
+		{
+			MethodName: "GetDownloadTaskList",
+			Handler:    _IdmService_GetDownloadTaskList_Handler,
+		},
+		{
+			MethodName: "UpdateDownloadTask",
+			Handler:    _IdmService_UpdateDownloadTask_Handler,
+		},
+		{
+			MethodName: "DeleteDownloadTask",
+			Handler:    _IdmService_DeleteDownloadTask_Handler,
+		},
+		{
+			MethodName: "GetDownloadTaskHistory",
+			Handler:    _IdmService_GetDownloadTaskHistory_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _IdmService_CreateTag_Handler,
+		},
+		{
+			MethodName: "GetTagList",
+			Handler:    _IdmService_GetTagList_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _IdmService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _IdmService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _IdmService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "GetCategoryList",
+			Handler:    _IdmService_GetCategoryList_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _IdmService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _IdmService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _IdmService_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "GetShareLinkList",
+			Handler:    _IdmService_GetShareLinkList_Handler,
+		},
+		{
+			MethodName: "DeleteShareLink",
+			Handler:    _IdmService_DeleteShareLink_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _IdmService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookList",
+			Handler:    _IdmService_GetWebhookList_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _IdmService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveryList",
+			Handler:    _IdmService_GetWebhookDeliveryList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetDownloadTaskFile",
+			Handler:       _IdmService_GetDownloadTaskFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetSharedFile",
+			Handler:       _IdmService_GetSharedFile_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "idm.proto",
+}
+
+const (
+	AdminService_GetAccountList_FullMethodName           = "/idm.AdminService/GetAccountList"
+	AdminService_UpdateAccountRole_FullMethodName        = "/idm.AdminService/UpdateAccountRole"
+	AdminService_DisableAccount_FullMethodName           = "/idm.AdminService/DisableAccount"
+	AdminService_EnableAccount_FullMethodName            = "/idm.AdminService/EnableAccount"
+	AdminService_ResetAccountPassword_FullMethodName     = "/idm.AdminService/ResetAccountPassword"
+	AdminService_CreatePasswordResetToken_FullMethodName = "/idm.AdminService/CreatePasswordResetToken"
+	AdminService_DisableAccountTOTP_FullMethodName       = "/idm.AdminService/DisableAccountTOTP"
+	AdminService_GetAllDownloadTaskList_FullMethodName   = "/idm.AdminService/GetAllDownloadTaskList"
+	AdminService_CancelDownloadTask_FullMethodName       = "/idm.AdminService/CancelDownloadTask"
+	AdminService_GetSystemStats_FullMethodName           = "/idm.AdminService/GetSystemStats"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	GetAccountList(ctx context.Context, in *GetAccountListRequest, opts ...grpc.CallOption) (*GetAccountListResponse, error)
+	UpdateAccountRole(ctx context.Context, in *UpdateAccountRoleRequest, opts ...grpc.CallOption) (*UpdateAccountRoleResponse, error)
+	DisableAccount(ctx context.Context, in *DisableAccountRequest, opts ...grpc.CallOption) (*DisableAccountResponse, error)
+	EnableAccount(ctx context.Context, in *EnableAccountRequest, opts ...grpc.CallOption) (*EnableAccountResponse, error)
+	ResetAccountPassword(ctx context.Context, in *ResetAccountPasswordRequest, opts ...grpc.CallOption) (*ResetAccountPasswordResponse, error)
+	CreatePasswordResetToken(ctx context.Context, in *CreatePasswordResetTokenRequest, opts ...grpc.CallOption) (*CreatePasswordResetTokenResponse, error)
+	DisableAccountTOTP(ctx context.Context, in *DisableAccountTOTPRequest, opts ...grpc.CallOption) (*DisableAccountTOTPResponse, error)
+	GetAllDownloadTaskList(ctx context.Context, in *GetAllDownloadTaskListRequest, opts ...grpc.CallOption) (*GetAllDownloadTaskListResponse, error)
+	CancelDownloadTask(ctx context.Context, in *CancelDownloadTaskRequest, opts ...grpc.CallOption) (*CancelDownloadTaskResponse, error)
+	GetSystemStats(ctx context.Context, in *GetSystemStatsRequest, opts ...grpc.CallOption) (*GetSystemStatsResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) GetAccountList(ctx context.Context, in *GetAccountListRequest, opts ...grpc.CallOption) (*GetAccountListResponse, error) {
+	out := new(GetAccountListResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAccountList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateAccountRole(ctx context.Context, in *UpdateAccountRoleRequest, opts ...grpc.CallOption) (*UpdateAccountRoleResponse, error) {
+	out := new(UpdateAccountRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateAccountRole_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *idmServiceClient) GetDownloadTaskList(ctx context.Context, in *GetDownloadTaskListRequest, opts ...grpc.CallOption) (*GetDownloadTaskListResponse, error) {
-	out := new(GetDownloadTaskListResponse)
-	err := c.cc.Invoke(ctx, IdmService_GetDownloadTaskList_FullMethodName, in, out, opts...)
+func (c *adminServiceClient) DisableAccount(ctx context.Context, in *DisableAccountRequest, opts ...grpc.CallOption) (*DisableAccountResponse, error) {
+	out := new(DisableAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_DisableAccount_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *idmServiceClient) UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error) {
-	out := new(UpdateDownloadTaskResponse)
-	err := c.cc.Invoke(ctx, IdmService_UpdateDownloadTask_FullMethodName, in, out, opts...)
+func (c *adminServiceClient) EnableAccount(ctx context.Context, in *EnableAccountRequest, opts ...grpc.CallOption) (*EnableAccountResponse, error) {
+	out := new(EnableAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_EnableAccount_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *idmServiceClient) DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error) {
-	out := new(DeleteDownloadTaskResponse)
-	err := c.cc.Invoke(ctx, IdmService_DeleteDownloadTask_FullMethodName, in, out, opts...)
+func (c *adminServiceClient) ResetAccountPassword(ctx context.Context, in *ResetAccountPasswordRequest, opts ...grpc.CallOption) (*ResetAccountPasswordResponse, error) {
+	out := new(ResetAccountPasswordResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResetAccountPassword_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *idmServiceClient) GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (IdmService_GetDownloadTaskFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &IdmService_ServiceDesc.Streams[0], IdmService_GetDownloadTaskFile_FullMethodName, opts...)
+func (c *adminServiceClient) CreatePasswordResetToken(ctx context.Context, in *CreatePasswordResetTokenRequest, opts ...grpc.CallOption) (*CreatePasswordResetTokenResponse, error) {
+	out := new(CreatePasswordResetTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreatePasswordResetToken_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &idmServiceGetDownloadTaskFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *adminServiceClient) DisableAccountTOTP(ctx context.Context, in *DisableAccountTOTPRequest, opts ...grpc.CallOption) (*DisableAccountTOTPResponse, error) {
+	out := new(DisableAccountTOTPResponse)
+	err := c.cc.Invoke(ctx, AdminService_DisableAccountTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type IdmService_GetDownloadTaskFileClient interface {
-	Recv() (*GetDownloadTaskFileResponse, error)
-	grpc.ClientStream
+func (c *adminServiceClient) GetAllDownloadTaskList(ctx context.Context, in *GetAllDownloadTaskListRequest, opts ...grpc.CallOption) (*GetAllDownloadTaskListResponse, error) {
+	out := new(GetAllDownloadTaskListResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAllDownloadTaskList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type idmServiceGetDownloadTaskFileClient struct {
-	grpc.ClientStream
+func (c *adminServiceClient) CancelDownloadTask(ctx context.Context, in *CancelDownloadTaskRequest, opts ...grpc.CallOption) (*CancelDownloadTaskResponse, error) {
+	out := new(CancelDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, AdminService_CancelDownloadTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *idmServiceGetDownloadTaskFileClient) Recv() (*GetDownloadTaskFileResponse, error) {
-	m := new(GetDownloadTaskFileResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *adminServiceClient) GetSystemStats(ctx context.Context, in *GetSystemStatsRequest, opts ...grpc.CallOption) (*GetSystemStatsResponse, error) {
+	out := new(GetSystemStatsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetSystemStats_FullMethodName, in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-// IdmServiceServer is the server API for IdmService service.
-// All implementations must embed UnimplementedIdmServiceServer
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
-type IdmServiceServer interface {
-	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
-	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
-	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
-	CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error)
-	GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error)
-	UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error)
-	DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error)
-	GetDownloadTaskFile(*GetDownloadTaskFileRequest, IdmService_GetDownloadTaskFileServer) error
-	mustEmbedUnimplementedIdmServiceServer()
+type AdminServiceServer interface {
+	GetAccountList(context.Context, *GetAccountListRequest) (*GetAccountListResponse, error)
+	UpdateAccountRole(context.Context, *UpdateAccountRoleRequest) (*UpdateAccountRoleResponse, error)
+	DisableAccount(context.Context, *DisableAccountRequest) (*DisableAccountResponse, error)
+	EnableAccount(context.Context, *EnableAccountRequest) (*EnableAccountResponse, error)
+	ResetAccountPassword(context.Context, *ResetAccountPasswordRequest) (*ResetAccountPasswordResponse, error)
+	CreatePasswordResetToken(context.Context, *CreatePasswordResetTokenRequest) (*CreatePasswordResetTokenResponse, error)
+	DisableAccountTOTP(context.Context, *DisableAccountTOTPRequest) (*DisableAccountTOTPResponse, error)
+	GetAllDownloadTaskList(context.Context, *GetAllDownloadTaskListRequest) (*GetAllDownloadTaskListResponse, error)
+	CancelDownloadTask(context.Context, *CancelDownloadTaskRequest) (*CancelDownloadTaskResponse, error)
+	GetSystemStats(context.Context, *GetSystemStatsRequest) (*GetSystemStatsResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
 }
 
-// UnimplementedIdmServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedIdmServiceServer struct {
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
 }
 
-func (UnimplementedIdmServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+func (UnimplementedAdminServiceServer) GetAccountList(context.Context, *GetAccountListRequest) (*GetAccountListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountList not implemented")
 }
-func (UnimplementedIdmServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+func (UnimplementedAdminServiceServer) UpdateAccountRole(context.Context, *UpdateAccountRoleRequest) (*UpdateAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountRole not implemented")
 }
-func (UnimplementedIdmServiceServer) DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
+func (UnimplementedAdminServiceServer) DisableAccount(context.Context, *DisableAccountRequest) (*DisableAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAccount not implemented")
 }
-func (UnimplementedIdmServiceServer) CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDownloadTask not implemented")
+func (UnimplementedAdminServiceServer) EnableAccount(context.Context, *EnableAccountRequest) (*EnableAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableAccount not implemented")
 }
-func (UnimplementedIdmServiceServer) GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTaskList not implemented")
+func (UnimplementedAdminServiceServer) ResetAccountPassword(context.Context, *ResetAccountPasswordRequest) (*ResetAccountPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetAccountPassword not implemented")
 }
-func (UnimplementedIdmServiceServer) UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDownloadTask not implemented")
+func (UnimplementedAdminServiceServer) CreatePasswordResetToken(context.Context, *CreatePasswordResetTokenRequest) (*CreatePasswordResetTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePasswordResetToken not implemented")
 }
-func (UnimplementedIdmServiceServer) DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDownloadTask not implemented")
+func (UnimplementedAdminServiceServer) DisableAccountTOTP(context.Context, *DisableAccountTOTPRequest) (*DisableAccountTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAccountTOTP not implemented")
 }
-func (UnimplementedIdmServiceServer) GetDownloadTaskFile(*GetDownloadTaskFileRequest, IdmService_GetDownloadTaskFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetDownloadTaskFile not implemented")
+func (UnimplementedAdminServiceServer) GetAllDownloadTaskList(context.Context, *GetAllDownloadTaskListRequest) (*GetAllDownloadTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllDownloadTaskList not implemented")
 }
-func (UnimplementedIdmServiceServer) mustEmbedUnimplementedIdmServiceServer() {}
+func (UnimplementedAdminServiceServer) CancelDownloadTask(context.Context, *CancelDownloadTaskRequest) (*CancelDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDownloadTask not implemented")
+}
+func (UnimplementedAdminServiceServer) GetSystemStats(context.Context, *GetSystemStatsRequest) (*GetSystemStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemStats not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
-// UnsafeIdmServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to IdmServiceServer will
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
 // result in compilation errors.
-type UnsafeIdmServiceServer interface {
-	mustEmbedUnimplementedIdmServiceServer()
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
 }
 
-func RegisterIdmServiceServer(s grpc.ServiceRegistrar, srv IdmServiceServer) {
-	s.RegisterService(&IdmService_ServiceDesc, srv)
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
 }
 
-func _IdmService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _AdminService_GetAccountList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdmServiceServer).CreateAccount(ctx, in)
+		return srv.(AdminServiceServer).GetAccountList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IdmService_CreateAccount_FullMethodName,
+		FullMethod: AdminService_GetAccountList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdmServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(AdminServiceServer).GetAccountList(ctx, req.(*GetAccountListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdmService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSessionRequest)
+func _AdminService_UpdateAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdmServiceServer).CreateSession(ctx, in)
+		return srv.(AdminServiceServer).UpdateAccountRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IdmService_CreateSession_FullMethodName,
+		FullMethod: AdminService_UpdateAccountRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdmServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+		return srv.(AdminServiceServer).UpdateAccountRole(ctx, req.(*UpdateAccountRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdmService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSessionRequest)
+func _AdminService_DisableAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdmServiceServer).DeleteSession(ctx, in)
+		return srv.(AdminServiceServer).DisableAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IdmService_DeleteSession_FullMethodName,
+		FullMethod: AdminService_DisableAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdmServiceServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
+		return srv.(AdminServiceServer).DisableAccount(ctx, req.(*DisableAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdmService_CreateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDownloadTaskRequest)
+func _AdminService_EnableAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdmServiceServer).CreateDownloadTask(ctx, in)
+		return srv.(AdminServiceServer).EnableAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IdmService_CreateDownloadTask_FullMethodName,
+		FullMethod: AdminService_EnableAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdmServiceServer).CreateDownloadTask(ctx, req.(*CreateDownloadTaskRequest))
+		return srv.(AdminServiceServer).EnableAccount(ctx, req.(*EnableAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdmService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDownloadTaskListRequest)
+func _AdminService_ResetAccountPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetAccountPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdmServiceServer).GetDownloadTaskList(ctx, in)
+		return srv.(AdminServiceServer).ResetAccountPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IdmService_GetDownloadTaskList_FullMethodName,
+		FullMethod: AdminService_ResetAccountPassword_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdmServiceServer).GetDownloadTaskList(ctx, req.(*GetDownloadTaskListRequest))
+		return srv.(AdminServiceServer).ResetAccountPassword(ctx, req.(*ResetAccountPasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdmService_UpdateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDownloadTaskRequest)
+func _AdminService_CreatePasswordResetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePasswordResetTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdmServiceServer).UpdateDownloadTask(ctx, in)
+		return srv.(AdminServiceServer).CreatePasswordResetToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IdmService_UpdateDownloadTask_FullMethodName,
+		FullMethod: AdminService_CreatePasswordResetToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdmServiceServer).UpdateDownloadTask(ctx, req.(*UpdateDownloadTaskRequest))
+		return srv.(AdminServiceServer).CreatePasswordResetToken(ctx, req.(*CreatePasswordResetTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdmService_DeleteDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDownloadTaskRequest)
+func _AdminService_DisableAccountTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableAccountTOTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IdmServiceServer).DeleteDownloadTask(ctx, in)
+		return srv.(AdminServiceServer).DisableAccountTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IdmService_DeleteDownloadTask_FullMethodName,
+		FullMethod: AdminService_DisableAccountTOTP_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IdmServiceServer).DeleteDownloadTask(ctx, req.(*DeleteDownloadTaskRequest))
+		return srv.(AdminServiceServer).DisableAccountTOTP(ctx, req.(*DisableAccountTOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IdmService_GetDownloadTaskFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetDownloadTaskFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _AdminService_GetAllDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllDownloadTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(IdmServiceServer).GetDownloadTaskFile(m, &idmServiceGetDownloadTaskFileServer{stream})
-}
-
-type IdmService_GetDownloadTaskFileServer interface {
-	Send(*GetDownloadTaskFileResponse) error
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAllDownloadTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAllDownloadTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAllDownloadTaskList(ctx, req.(*GetAllDownloadTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type idmServiceGetDownloadTaskFileServer struct {
-	grpc.ServerStream
+func _AdminService_CancelDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CancelDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelDownloadTask(ctx, req.(*CancelDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *idmServiceGetDownloadTaskFileServer) Send(m *GetDownloadTaskFileResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _AdminService_GetSystemStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetSystemStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetSystemStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetSystemStats(ctx, req.(*GetSystemStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// IdmService_ServiceDesc is the grpc.ServiceDesc for IdmService service.
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var IdmService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "idm.IdmService",
-	HandlerType: (*IdmServiceServer)(nil),
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "idm.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateAccount",
-			Handler:    _IdmService_CreateAccount_Handler,
+			MethodName: "GetAccountList",
+			Handler:    _AdminService_GetAccountList_Handler,
 		},
 		{
-			MethodName: "CreateSession",
-			Handler:    _IdmService_CreateSession_Handler,
+			MethodName: "UpdateAccountRole",
+			Handler:    _AdminService_UpdateAccountRole_Handler,
 		},
 		{
-			MethodName: "DeleteSession",
-			Handler:    _IdmService_DeleteSession_Handler,
+			MethodName: "DisableAccount",
+			Handler:    _AdminService_DisableAccount_Handler,
 		},
 		{
-			MethodName: "CreateDownloadTask",
-			Handler:    _IdmService_CreateDownloadTask_Handler,
+			MethodName: "EnableAccount",
+			Handler:    _AdminService_EnableAccount_Handler,
 		},
 		{
-			MethodName: "GetDownloadTaskList",
-			Handler:    _IdmService_GetDownloadTaskList_Handler,
+			MethodName: "ResetAccountPassword",
+			Handler:    _AdminService_ResetAccountPassword_Handler,
 		},
 		{
-			MethodName: "UpdateDownloadTask",
-			Handler:    _IdmService_UpdateDownloadTask_Handler,
+			MethodName: "CreatePasswordResetToken",
+			Handler:    _AdminService_CreatePasswordResetToken_Handler,
 		},
 		{
-			MethodName: "DeleteDownloadTask",
-			Handler:    _IdmService_DeleteDownloadTask_Handler,
+			MethodName: "DisableAccountTOTP",
+			Handler:    _AdminService_DisableAccountTOTP_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "GetDownloadTaskFile",
-			Handler:       _IdmService_GetDownloadTaskFile_Handler,
-			ServerStreams: true,
+			MethodName: "GetAllDownloadTaskList",
+			Handler:    _AdminService_GetAllDownloadTaskList_Handler,
+		},
+		{
+			MethodName: "CancelDownloadTask",
+			Handler:    _AdminService_CancelDownloadTask_Handler,
+		},
+		{
+			MethodName: "GetSystemStats",
+			Handler:    _AdminService_GetSystemStats_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "idm.proto",
 }
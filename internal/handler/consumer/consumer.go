@@ -42,7 +42,9 @@ func (r *rootConsumer) Start(ctx context.Context) error {
 
 			err := json.Unmarshal(payload, &downloadTaskID)
 			if err != nil {
-				return err
+				// A payload that can not be read never will be, it is skipped instead of being handled again.
+				r.logger.With(zap.ByteString("payload", payload)).With(zap.Error(err)).Error("failed to unmarshal download task created payload, skipping")
+				return nil
 			}
 
 			return r.downloadTaskCreatedHandler.Handle(ctx, database.DownloadTask{
@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+
+	idm "github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"github.com/maxuanquang/idm/internal/logic"
+)
+
+func NewAdminHandler(adminLogic logic.AdminLogic) idm.AdminServiceServer {
+	return &AdminHandler{
+		adminLogic: adminLogic,
+	}
+}
+
+// AdminHandler serves AdminService, its callers are authorized by AuthorizationInterceptor.
+type AdminHandler struct {
+	idm.UnimplementedAdminServiceServer
+	adminLogic logic.AdminLogic
+}
+
+// GetAccountList implements idm.AdminServiceServer.
+func (a *AdminHandler) GetAccountList(ctx context.Context, in *idm.GetAccountListRequest) (*idm.GetAccountListResponse, error) {
+	output, err := a.adminLogic.GetAccountList(ctx, logic.GetAccountListInput{
+		Offset: in.Offset,
+		Limit:  in.Limit,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetAccountListResponse{
+		AccountList:       output.AccountList,
+		TotalAccountCount: output.TotalAccountCount,
+	}, nil
+}
+
+// UpdateAccountRole implements idm.AdminServiceServer.
+func (a *AdminHandler) UpdateAccountRole(ctx context.Context, in *idm.UpdateAccountRoleRequest) (*idm.UpdateAccountRoleResponse, error) {
+	err := a.adminLogic.UpdateAccountRole(ctx, logic.UpdateAccountRoleInput{
+		AdminAccountID: getAuthorizedAccountID(ctx),
+		AccountID:      in.AccountId,
+		Role:           in.Role,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.UpdateAccountRoleResponse{}, nil
+}
+
+// DisableAccount implements idm.AdminServiceServer.
+func (a *AdminHandler) DisableAccount(ctx context.Context, in *idm.DisableAccountRequest) (*idm.DisableAccountResponse, error) {
+	err := a.adminLogic.DisableAccount(ctx, logic.DisableAccountInput{
+		AdminAccountID: getAuthorizedAccountID(ctx),
+		AccountID:      in.AccountId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.DisableAccountResponse{}, nil
+}
+
+// EnableAccount implements idm.AdminServiceServer.
+func (a *AdminHandler) EnableAccount(ctx context.Context, in *idm.EnableAccountRequest) (*idm.EnableAccountResponse, error) {
+	err := a.adminLogic.EnableAccount(ctx, logic.EnableAccountInput{
+		AccountID: in.AccountId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.EnableAccountResponse{}, nil
+}
+
+// ResetAccountPassword implements idm.AdminServiceServer.
+func (a *AdminHandler) ResetAccountPassword(ctx context.Context, in *idm.ResetAccountPasswordRequest) (*idm.ResetAccountPasswordResponse, error) {
+	err := a.adminLogic.ResetAccountPassword(ctx, logic.ResetAccountPasswordInput{
+		AccountID: in.AccountId,
+		Password:  in.Password,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.ResetAccountPasswordResponse{}, nil
+}
+
+// CreatePasswordResetToken implements idm.AdminServiceServer.
+func (a *AdminHandler) CreatePasswordResetToken(ctx context.Context, in *idm.CreatePasswordResetTokenRequest) (*idm.CreatePasswordResetTokenResponse, error) {
+	output, err := a.adminLogic.CreatePasswordResetToken(ctx, logic.CreatePasswordResetTokenInput{
+		AccountID: in.AccountId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.CreatePasswordResetTokenResponse{
+		ResetToken: output.ResetToken,
+		ExpireTime: output.ExpireTime,
+	}, nil
+}
+
+// DisableAccountTOTP implements idm.AdminServiceServer.
+func (a *AdminHandler) DisableAccountTOTP(ctx context.Context, in *idm.DisableAccountTOTPRequest) (*idm.DisableAccountTOTPResponse, error) {
+	err := a.adminLogic.DisableAccountTOTP(ctx, logic.DisableAccountTOTPInput{
+		AccountID: in.AccountId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.DisableAccountTOTPResponse{}, nil
+}
+
+// GetAllDownloadTaskList implements idm.AdminServiceServer.
+func (a *AdminHandler) GetAllDownloadTaskList(ctx context.Context, in *idm.GetAllDownloadTaskListRequest) (*idm.GetAllDownloadTaskListResponse, error) {
+	output, err := a.adminLogic.GetAllDownloadTaskList(ctx, logic.GetAllDownloadTaskListInput{
+		OfAccountID: in.OfAccountId,
+		Offset:      in.Offset,
+		Limit:       in.Limit,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetAllDownloadTaskListResponse{
+		DownloadTaskList:       output.DownloadTaskList,
+		TotalDownloadTaskCount: output.TotalDownloadTaskCount,
+	}, nil
+}
+
+// CancelDownloadTask implements idm.AdminServiceServer.
+func (a *AdminHandler) CancelDownloadTask(ctx context.Context, in *idm.CancelDownloadTaskRequest) (*idm.CancelDownloadTaskResponse, error) {
+	output, err := a.adminLogic.CancelDownloadTask(ctx, logic.CancelDownloadTaskInput{
+		AdminAccountID: getAuthorizedAccountID(ctx),
+		DownloadTaskID: in.DownloadTaskId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.CancelDownloadTaskResponse{
+		DownloadTask: output.DownloadTask,
+	}, nil
+}
+
+// GetSystemStats implements idm.AdminServiceServer.
+func (a *AdminHandler) GetSystemStats(ctx context.Context, in *idm.GetSystemStatsRequest) (*idm.GetSystemStatsResponse, error) {
+	output, err := a.adminLogic.GetSystemStats(ctx)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetSystemStatsResponse{
+		TotalAccountCount:       output.TotalAccountCount,
+		DisabledAccountCount:    output.DisabledAccountCount,
+		ActiveSessionCount:      output.ActiveSessionCount,
+		TotalDownloadTaskCount:  output.TotalDownloadTaskCount,
+		DownloadStatusCountList: output.DownloadStatusCountList,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	idm "github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"github.com/maxuanquang/idm/internal/logic"
+	"google.golang.org/grpc"
+)
+
+// serviceRoleMap lists the services only accounts with a role can call, the methods of other services
+// authorize their callers themselves.
+var serviceRoleMap = map[string]idm.Role{
+	idm.AdminService_ServiceDesc.ServiceName: idm.Role_Admin,
+}
+
+type authorizedAccountIDContextKey struct{}
+
+// AuthorizationInterceptor rejects calls to the services of serviceRoleMap from accounts without the role
+// of the service.
+type AuthorizationInterceptor interface {
+	UnaryServerInterceptor() grpc.UnaryServerInterceptor
+	StreamServerInterceptor() grpc.StreamServerInterceptor
+}
+
+func NewAuthorizationInterceptor(accountLogic logic.AccountLogic) AuthorizationInterceptor {
+	return &authorizationInterceptor{
+		accountLogic: accountLogic,
+	}
+}
+
+type authorizationInterceptor struct {
+	accountLogic logic.AccountLogic
+}
+
+// UnaryServerInterceptor implements AuthorizationInterceptor.
+func (a *authorizationInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor implements AuthorizationInterceptor.
+func (a *authorizationInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authorizedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize returns ctx with the ID of the authorized account if fullMethod requires a role.
+func (a *authorizationInterceptor) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	// fullMethod has the format "/package.Service/Method".
+	serviceName, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	role, ok := serviceRoleMap[serviceName]
+	if !ok {
+		return ctx, nil
+	}
+
+	accountID, err := a.accountLogic.AuthorizeAccount(ctx, logic.AuthorizeAccountInput{
+		Token: getAuthTokenFromMetadata(ctx),
+		Role:  role,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return context.WithValue(ctx, authorizedAccountIDContextKey{}, accountID), nil
+}
+
+type authorizedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (a *authorizedServerStream) Context() context.Context {
+	return a.ctx
+}
+
+// getAuthorizedAccountID returns the account authorized by AuthorizationInterceptor.
+func getAuthorizedAccountID(ctx context.Context) uint64 {
+	accountID, _ := ctx.Value(authorizedAccountIDContextKey{}).(uint64)
+	return accountID
+}
@@ -3,47 +3,108 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
-
-	// "strings"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
 
 	"github.com/maxuanquang/idm/internal/configs"
 	idm "github.com/maxuanquang/idm/internal/generated/grpc/idm"
 	"github.com/maxuanquang/idm/internal/logic"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
-	AuthTokenMetadataName         = "IDM_AUTH"
-	GRPCGatewayCookieMetadataName = "grpcgateway-cookie"
+	AuthTokenMetadataName            = "IDM_AUTH"
+	RefreshTokenMetadataName         = "IDM_REFRESH"
+	OIDCStateMetadataName            = "IDM_OIDC_STATE"
+	LocationMetadataName             = "IDM_LOCATION"
+	SharePasswordMetadataName        = "IDM_SHARE_PASSWORD"
+	AuthorizationMetadataName        = "authorization"
+	BearerAuthorizationPrefix        = "Bearer "
+	GRPCGatewayCookieMetadataName    = "grpcgateway-cookie"
+	GRPCGatewayUserAgentMetadataName = "grpcgateway-user-agent"
+	UserAgentMetadataName            = "user-agent"
+	XForwardedForMetadataName        = "x-forwarded-for"
 )
 
 func NewHandler(
 	accountLogic logic.AccountLogic,
 	downloadTaskLogic logic.DownloadTaskLogic,
+	shareLinkLogic logic.ShareLinkLogic,
+	oidcLogic logic.OIDCLogic,
+	passwordLogic logic.PasswordLogic,
+	totpLogic logic.TOTPLogic,
+	apiKeyLogic logic.APIKeyLogic,
+	workspaceLogic logic.WorkspaceLogic,
+	tagLogic logic.TagLogic,
+	categoryLogic logic.CategoryLogic,
+	webhookLogic logic.WebhookLogic,
 	grpcConfig configs.GRPC,
-) idm.IdmServiceServer {
+) (idm.IdmServiceServer, error) {
+	trustedProxyPrefixList := make([]netip.Prefix, 0, len(grpcConfig.TrustedProxyCIDRList))
+	for _, trustedProxyCIDR := range grpcConfig.TrustedProxyCIDRList {
+		trustedProxyPrefix, err := netip.ParsePrefix(trustedProxyCIDR)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy cidr %s: %w", trustedProxyCIDR, err)
+		}
+
+		trustedProxyPrefixList = append(trustedProxyPrefixList, trustedProxyPrefix)
+	}
+
 	return &Handler{
 		accountLogic:              accountLogic,
 		downloadTaskLogic:         downloadTaskLogic,
+		shareLinkLogic:            shareLinkLogic,
+		oidcLogic:                 oidcLogic,
+		passwordLogic:             passwordLogic,
+		totpLogic:                 totpLogic,
+		apiKeyLogic:               apiKeyLogic,
+		workspaceLogic:            workspaceLogic,
+		tagLogic:                  tagLogic,
+		categoryLogic:             categoryLogic,
+		webhookLogic:              webhookLogic,
 		getDownloadTaskFileConfig: grpcConfig.GetDownloadTaskFile,
-	}
+		trustedProxyPrefixList:    trustedProxyPrefixList,
+	}, nil
 }
 
 type Handler struct {
 	idm.UnimplementedIdmServiceServer
 	accountLogic              logic.AccountLogic
 	downloadTaskLogic         logic.DownloadTaskLogic
+	shareLinkLogic            logic.ShareLinkLogic
+	oidcLogic                 logic.OIDCLogic
+	passwordLogic             logic.PasswordLogic
+	totpLogic                 logic.TOTPLogic
+	apiKeyLogic               logic.APIKeyLogic
+	workspaceLogic            logic.WorkspaceLogic
+	tagLogic                  logic.TagLogic
+	categoryLogic             logic.CategoryLogic
+	webhookLogic              logic.WebhookLogic
 	getDownloadTaskFileConfig configs.GetDownloadTaskFile
+	trustedProxyPrefixList    []netip.Prefix
 }
 
-func (h *Handler) getAuthTokenFromMetadata(ctx context.Context) string {
+// getAuthTokenFromMetadata returns the bearer token of the authorization metadata, which scripts use to send
+// API keys, or else the token of the auth cookie.
+func getAuthTokenFromMetadata(ctx context.Context) string {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return ""
 	}
 
+	for _, authorization := range md.Get(AuthorizationMetadataName) {
+		if strings.HasPrefix(authorization, BearerAuthorizationPrefix) {
+			return strings.TrimPrefix(authorization, BearerAuthorizationPrefix)
+		}
+	}
+
 	authTokenValues := md.Get(AuthTokenMetadataName)
 	if len(authTokenValues) == 0 {
 		return ""
@@ -52,6 +113,98 @@ func (h *Handler) getAuthTokenFromMetadata(ctx context.Context) string {
 	return authTokenValues[0]
 }
 
+func (h *Handler) getRefreshTokenFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	refreshTokenValues := md.Get(RefreshTokenMetadataName)
+	if len(refreshTokenValues) == 0 {
+		return ""
+	}
+
+	return refreshTokenValues[0]
+}
+
+func (h *Handler) getOIDCStateFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	oidcStateValues := md.Get(OIDCStateMetadataName)
+	if len(oidcStateValues) == 0 {
+		return ""
+	}
+
+	return oidcStateValues[0]
+}
+
+func (h *Handler) getSharePasswordFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	sharePasswordValues := md.Get(SharePasswordMetadataName)
+	if len(sharePasswordValues) == 0 {
+		return ""
+	}
+
+	return sharePasswordValues[0]
+}
+
+// getClientInfoFromMetadata returns the user agent and the IP address of the client, requests
+// proxied by grpc-gateway carry them in dedicated metadata. Clients can send any X-Forwarded-For, so it is
+// only read from the right for as long as the addresses are trusted proxies, each of which appends the address
+// it received the request from.
+func (h *Handler) getClientInfoFromMetadata(ctx context.Context) (string, string) {
+	var userAgent, ipAddress string
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(GRPCGatewayUserAgentMetadataName); len(values) > 0 {
+		userAgent = values[0]
+	} else if values := md.Get(UserAgentMetadataName); len(values) > 0 {
+		userAgent = values[0]
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		ipAddress = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ipAddress); err == nil {
+			ipAddress = host
+		}
+	}
+
+	forwardedForList := strings.Split(strings.Join(md.Get(XForwardedForMetadataName), ","), ",")
+	for i := len(forwardedForList) - 1; i >= 0 && h.isTrustedProxy(ipAddress); i-- {
+		forwardedFor := strings.TrimSpace(forwardedForList[i])
+		if forwardedFor == "" {
+			continue
+		}
+
+		ipAddress = forwardedFor
+	}
+
+	return userAgent, ipAddress
+}
+
+func (h *Handler) isTrustedProxy(ipAddress string) bool {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, trustedProxyPrefix := range h.trustedProxyPrefixList {
+		if trustedProxyPrefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // CreateAccount implements idm.IdmServiceServer.
 func (h *Handler) CreateAccount(ctx context.Context, in *idm.CreateAccountRequest) (*idm.CreateAccountResponse, error) {
 	account, err := h.accountLogic.CreateAccount(ctx, logic.CreateAccountInput{
@@ -68,18 +221,30 @@ func (h *Handler) CreateAccount(ctx context.Context, in *idm.CreateAccountReques
 
 // CreateSession implements idm.IdmServiceServer.
 func (h *Handler) CreateSession(ctx context.Context, in *idm.CreateSessionRequest) (*idm.CreateSessionResponse, error) {
+	userAgent, ipAddress := h.getClientInfoFromMetadata(ctx)
 	session, err := h.accountLogic.CreateSession(
 		ctx,
 		logic.CreateSessionInput{
 			AccountName: in.AccountName,
 			Password:    in.Password,
+			UserAgent:   userAgent,
+			IPAddress:   ipAddress,
 		},
 	)
 	if err != nil {
 		return nil, clientResponseError(err)
 	}
 
-	err = grpc.SendHeader(ctx, metadata.Pairs(AuthTokenMetadataName, session.Token))
+	if session.TOTPChallenge != "" {
+		return &idm.CreateSessionResponse{
+			TotpChallenge: session.TOTPChallenge,
+		}, nil
+	}
+
+	err = grpc.SendHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, session.Token,
+		RefreshTokenMetadataName, session.RefreshToken,
+	))
 	if err != nil {
 		return nil, clientResponseError(err)
 	}
@@ -92,94 +257,460 @@ func (h *Handler) CreateSession(ctx context.Context, in *idm.CreateSessionReques
 	}, nil
 }
 
+// CompleteTOTPLogin implements idm.IdmServiceServer.
+func (h *Handler) CompleteTOTPLogin(ctx context.Context, in *idm.CompleteTOTPLoginRequest) (*idm.CompleteTOTPLoginResponse, error) {
+	session, err := h.accountLogic.CompleteTOTPLogin(ctx, logic.CompleteTOTPLoginInput{
+		TOTPChallenge: in.TotpChallenge,
+		Code:          in.Code,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	err = grpc.SendHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, session.Token,
+		RefreshTokenMetadataName, session.RefreshToken,
+	))
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.CompleteTOTPLoginResponse{
+		Account: &idm.Account{
+			Id:          session.AccountID,
+			AccountName: session.AccountName,
+		},
+	}, nil
+}
+
+// RefreshSession implements idm.IdmServiceServer.
+func (h *Handler) RefreshSession(ctx context.Context, in *idm.RefreshSessionRequest) (*idm.RefreshSessionResponse, error) {
+	refreshToken := in.RefreshToken
+	if refreshToken == "" {
+		refreshToken = h.getRefreshTokenFromMetadata(ctx)
+	}
+
+	session, err := h.accountLogic.RefreshSession(ctx, logic.RefreshSessionInput{
+		RefreshToken: refreshToken,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	err = grpc.SendHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, session.Token,
+		RefreshTokenMetadataName, session.RefreshToken,
+	))
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.RefreshSessionResponse{}, nil
+}
+
 // DeleteSession implements idm.IdmServiceServer.
 func (h *Handler) DeleteSession(ctx context.Context, in *idm.DeleteSessionRequest) (*idm.DeleteSessionResponse, error) {
 	err := h.accountLogic.DeleteSession(
 		ctx,
 		logic.DeleteSessionInput{
-			Token: h.getAuthTokenFromMetadata(ctx),
+			Token:       getAuthTokenFromMetadata(ctx),
+			SessionID:   in.SessionId,
+			AllSessions: in.AllSessions,
 		},
 	)
 	if err != nil {
 		return nil, clientResponseError(err)
 	}
 
-	err = grpc.SendHeader(ctx, metadata.Pairs(AuthTokenMetadataName, ""))
+	// Revoking another session keeps the current one logged in.
+	if in.SessionId == "" {
+		err = grpc.SendHeader(ctx, metadata.Pairs(AuthTokenMetadataName, "", RefreshTokenMetadataName, ""))
+		if err != nil {
+			return nil, clientResponseError(err)
+		}
+	}
+
+	return &idm.DeleteSessionResponse{}, nil
+}
+
+// ListSessions implements idm.IdmServiceServer.
+func (h *Handler) ListSessions(ctx context.Context, in *idm.ListSessionsRequest) (*idm.ListSessionsResponse, error) {
+	out, err := h.accountLogic.ListSessions(ctx, logic.ListSessionsInput{
+		Token: getAuthTokenFromMetadata(ctx),
+	})
 	if err != nil {
 		return nil, clientResponseError(err)
 	}
 
-	return &idm.DeleteSessionResponse{}, nil
+	return &idm.ListSessionsResponse{
+		SessionList: out.SessionList,
+	}, nil
 }
 
-// CreateDownloadTask implements idm.IdmServiceServer.
-func (h *Handler) CreateDownloadTask(ctx context.Context, in *idm.CreateDownloadTaskRequest) (*idm.CreateDownloadTaskResponse, error) {
-	out, err := h.downloadTaskLogic.CreateDownloadTask(ctx, logic.CreateDownloadTaskInput{
-		Token: h.getAuthTokenFromMetadata(ctx),
-		Type:  in.DownloadType,
-		URL:   in.Url,
+// ChangePassword implements idm.IdmServiceServer.
+func (h *Handler) ChangePassword(ctx context.Context, in *idm.ChangePasswordRequest) (*idm.ChangePasswordResponse, error) {
+	err := h.passwordLogic.ChangePassword(ctx, logic.ChangePasswordInput{
+		Token:           getAuthTokenFromMetadata(ctx),
+		CurrentPassword: in.CurrentPassword,
+		NewPassword:     in.NewPassword,
 	})
 	if err != nil {
 		return nil, clientResponseError(err)
 	}
 
-	return &idm.CreateDownloadTaskResponse{
-		DownloadTask: &out.DownloadTask,
+	return &idm.ChangePasswordResponse{}, nil
+}
+
+// ResetPassword implements idm.IdmServiceServer.
+func (h *Handler) ResetPassword(ctx context.Context, in *idm.ResetPasswordRequest) (*idm.ResetPasswordResponse, error) {
+	err := h.passwordLogic.ResetPassword(ctx, logic.ResetPasswordInput{
+		ResetToken:  in.ResetToken,
+		NewPassword: in.NewPassword,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.ResetPasswordResponse{}, nil
+}
+
+// EnrollTOTP implements idm.IdmServiceServer.
+func (h *Handler) EnrollTOTP(ctx context.Context, in *idm.EnrollTOTPRequest) (*idm.EnrollTOTPResponse, error) {
+	out, err := h.totpLogic.EnrollTOTP(ctx, logic.EnrollTOTPInput{
+		Token: getAuthTokenFromMetadata(ctx),
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.EnrollTOTPResponse{
+		Secret:     out.Secret,
+		OtpauthUri: out.OTPAuthURI,
 	}, nil
 }
 
-// GetDownloadTaskFile implements idm.IdmServiceServer.
-func (h *Handler) GetDownloadTaskFile(in *idm.GetDownloadTaskFileRequest, server idm.IdmService_GetDownloadTaskFileServer) error {
-	output, err := h.downloadTaskLogic.GetDownloadTaskFile(context.Background(), logic.GetDownloadTaskFileInput{
-		Token:          h.getAuthTokenFromMetadata(server.Context()),
-		DownloadTaskID: in.DownloadTaskId,
+// ActivateTOTP implements idm.IdmServiceServer.
+func (h *Handler) ActivateTOTP(ctx context.Context, in *idm.ActivateTOTPRequest) (*idm.ActivateTOTPResponse, error) {
+	out, err := h.totpLogic.ActivateTOTP(ctx, logic.ActivateTOTPInput{
+		Token: getAuthTokenFromMetadata(ctx),
+		Code:  in.Code,
 	})
 	if err != nil {
-		return clientResponseError(err)
+		return nil, clientResponseError(err)
 	}
 
-	outputReader := output.Reader
-	defer outputReader.Close()
+	return &idm.ActivateTOTPResponse{
+		RecoveryCodeList: out.RecoveryCodeList,
+	}, nil
+}
 
-	responseBufferSizeInBytes, err := h.getDownloadTaskFileConfig.GetResponseBufferSizeInBytes()
+// DisableTOTP implements idm.IdmServiceServer.
+func (h *Handler) DisableTOTP(ctx context.Context, in *idm.DisableTOTPRequest) (*idm.DisableTOTPResponse, error) {
+	err := h.totpLogic.DisableTOTP(ctx, logic.DisableTOTPInput{
+		Token: getAuthTokenFromMetadata(ctx),
+		Code:  in.Code,
+	})
 	if err != nil {
-		return clientResponseError(err)
+		return nil, clientResponseError(err)
 	}
-	
-	responseBuffer := make([]byte, responseBufferSizeInBytes)
-	for {
-		readByteCount, err := outputReader.Read(responseBuffer)
 
-		if readByteCount > 0 {
-			err = server.Send(&idm.GetDownloadTaskFileResponse{
-				Data: responseBuffer[:readByteCount],
-			})
-			if err != nil {
-				return clientResponseError(err)
-			}
+	return &idm.DisableTOTPResponse{}, nil
+}
 
-			continue
-		}
+// GetOIDCProviderList implements idm.IdmServiceServer.
+func (h *Handler) GetOIDCProviderList(ctx context.Context, in *idm.GetOIDCProviderListRequest) (*idm.GetOIDCProviderListResponse, error) {
+	return &idm.GetOIDCProviderListResponse{
+		ProviderList: h.oidcLogic.GetOIDCProviderNameList(ctx),
+	}, nil
+}
 
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				break
-			}
+// StartOIDCLogin implements idm.IdmServiceServer.
+func (h *Handler) StartOIDCLogin(ctx context.Context, in *idm.StartOIDCLoginRequest) (*idm.StartOIDCLoginResponse, error) {
+	out, err := h.oidcLogic.StartOIDCLogin(ctx, logic.StartOIDCLoginInput{
+		Token:        getAuthTokenFromMetadata(ctx),
+		ProviderName: in.Provider,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
 
-			return clientResponseError(err)
+	err = grpc.SendHeader(ctx, metadata.Pairs(
+		OIDCStateMetadataName, out.State,
+		LocationMetadataName, out.AuthorizationURL,
+	))
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.StartOIDCLoginResponse{
+		AuthorizationUrl: out.AuthorizationURL,
+	}, nil
+}
+
+// FinishOIDCLogin implements idm.IdmServiceServer.
+func (h *Handler) FinishOIDCLogin(ctx context.Context, in *idm.FinishOIDCLoginRequest) (*idm.FinishOIDCLoginResponse, error) {
+	userAgent, ipAddress := h.getClientInfoFromMetadata(ctx)
+	out, err := h.oidcLogic.FinishOIDCLogin(ctx, logic.FinishOIDCLoginInput{
+		ProviderName: in.Provider,
+		Code:         in.Code,
+		State:        in.State,
+		BrowserState: h.getOIDCStateFromMetadata(ctx),
+		Error:        in.Error,
+		UserAgent:    userAgent,
+		IPAddress:    ipAddress,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	if out.Session.TOTPChallenge != "" {
+		err = grpc.SendHeader(ctx, metadata.Pairs(
+			OIDCStateMetadataName, "",
+			LocationMetadataName, out.PostLoginRedirectURL,
+		))
+		if err != nil {
+			return nil, clientResponseError(err)
 		}
 
+		return &idm.FinishOIDCLoginResponse{
+			TotpChallenge: out.Session.TOTPChallenge,
+		}, nil
 	}
 
-	return nil
+	err = grpc.SendHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, out.Session.Token,
+		RefreshTokenMetadataName, out.Session.RefreshToken,
+		OIDCStateMetadataName, "",
+		LocationMetadataName, out.PostLoginRedirectURL,
+	))
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.FinishOIDCLoginResponse{
+		Account: &idm.Account{
+			Id:          out.Session.AccountID,
+			AccountName: out.Session.AccountName,
+		},
+	}, nil
+}
+
+// CreateAPIKey implements idm.IdmServiceServer.
+func (h *Handler) CreateAPIKey(ctx context.Context, in *idm.CreateAPIKeyRequest) (*idm.CreateAPIKeyResponse, error) {
+	var expireTime *time.Time
+	if in.ExpireTime != nil {
+		t := in.ExpireTime.AsTime()
+		expireTime = &t
+	}
+
+	out, err := h.apiKeyLogic.CreateAPIKey(ctx, logic.CreateAPIKeyInput{
+		Token:      getAuthTokenFromMetadata(ctx),
+		Name:       in.Name,
+		Scopes:     in.Scopes,
+		ExpireTime: expireTime,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.CreateAPIKeyResponse{
+		ApiKey: out.APIKey,
+		Key:    out.Key,
+	}, nil
+}
+
+// GetAPIKeyList implements idm.IdmServiceServer.
+func (h *Handler) GetAPIKeyList(ctx context.Context, in *idm.GetAPIKeyListRequest) (*idm.GetAPIKeyListResponse, error) {
+	out, err := h.apiKeyLogic.GetAPIKeyList(ctx, logic.GetAPIKeyListInput{
+		Token: getAuthTokenFromMetadata(ctx),
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetAPIKeyListResponse{
+		ApiKeyList: out.APIKeyList,
+	}, nil
+}
+
+// DeleteAPIKey implements idm.IdmServiceServer.
+func (h *Handler) DeleteAPIKey(ctx context.Context, in *idm.DeleteAPIKeyRequest) (*idm.DeleteAPIKeyResponse, error) {
+	err := h.apiKeyLogic.DeleteAPIKey(ctx, logic.DeleteAPIKeyInput{
+		Token:    getAuthTokenFromMetadata(ctx),
+		APIKeyID: in.ApiKeyId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.DeleteAPIKeyResponse{}, nil
+}
+
+// CreateWorkspace implements idm.IdmServiceServer.
+func (h *Handler) CreateWorkspace(ctx context.Context, in *idm.CreateWorkspaceRequest) (*idm.CreateWorkspaceResponse, error) {
+	out, err := h.workspaceLogic.CreateWorkspace(ctx, logic.CreateWorkspaceInput{
+		Token: getAuthTokenFromMetadata(ctx),
+		Name:  in.Name,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.CreateWorkspaceResponse{
+		Workspace: out.Workspace,
+	}, nil
+}
+
+// GetWorkspaceList implements idm.IdmServiceServer.
+func (h *Handler) GetWorkspaceList(ctx context.Context, in *idm.GetWorkspaceListRequest) (*idm.GetWorkspaceListResponse, error) {
+	out, err := h.workspaceLogic.GetWorkspaceList(ctx, logic.GetWorkspaceListInput{
+		Token: getAuthTokenFromMetadata(ctx),
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetWorkspaceListResponse{
+		WorkspaceList: out.WorkspaceList,
+	}, nil
+}
+
+// GetWorkspaceMemberList implements idm.IdmServiceServer.
+func (h *Handler) GetWorkspaceMemberList(ctx context.Context, in *idm.GetWorkspaceMemberListRequest) (*idm.GetWorkspaceMemberListResponse, error) {
+	out, err := h.workspaceLogic.GetWorkspaceMemberList(ctx, logic.GetWorkspaceMemberListInput{
+		Token:       getAuthTokenFromMetadata(ctx),
+		WorkspaceID: in.WorkspaceId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetWorkspaceMemberListResponse{
+		WorkspaceMemberList: out.WorkspaceMemberList,
+	}, nil
+}
+
+// AddWorkspaceMember implements idm.IdmServiceServer.
+func (h *Handler) AddWorkspaceMember(ctx context.Context, in *idm.AddWorkspaceMemberRequest) (*idm.AddWorkspaceMemberResponse, error) {
+	out, err := h.workspaceLogic.AddWorkspaceMember(ctx, logic.AddWorkspaceMemberInput{
+		Token:       getAuthTokenFromMetadata(ctx),
+		WorkspaceID: in.WorkspaceId,
+		AccountName: in.AccountName,
+		Role:        in.Role,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.AddWorkspaceMemberResponse{
+		WorkspaceMember: out.WorkspaceMember,
+	}, nil
+}
+
+// UpdateWorkspaceMember implements idm.IdmServiceServer.
+func (h *Handler) UpdateWorkspaceMember(ctx context.Context, in *idm.UpdateWorkspaceMemberRequest) (*idm.UpdateWorkspaceMemberResponse, error) {
+	out, err := h.workspaceLogic.UpdateWorkspaceMember(ctx, logic.UpdateWorkspaceMemberInput{
+		Token:       getAuthTokenFromMetadata(ctx),
+		WorkspaceID: in.WorkspaceId,
+		AccountID:   in.AccountId,
+		Role:        in.Role,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.UpdateWorkspaceMemberResponse{
+		WorkspaceMember: out.WorkspaceMember,
+	}, nil
+}
+
+// RemoveWorkspaceMember implements idm.IdmServiceServer.
+func (h *Handler) RemoveWorkspaceMember(ctx context.Context, in *idm.RemoveWorkspaceMemberRequest) (*idm.RemoveWorkspaceMemberResponse, error) {
+	err := h.workspaceLogic.RemoveWorkspaceMember(ctx, logic.RemoveWorkspaceMemberInput{
+		Token:       getAuthTokenFromMetadata(ctx),
+		WorkspaceID: in.WorkspaceId,
+		AccountID:   in.AccountId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.RemoveWorkspaceMemberResponse{}, nil
+}
+
+// CreateDownloadTask implements idm.IdmServiceServer.
+func (h *Handler) CreateDownloadTask(ctx context.Context, in *idm.CreateDownloadTaskRequest) (*idm.CreateDownloadTaskResponse, error) {
+	out, err := h.downloadTaskLogic.CreateDownloadTask(ctx, logic.CreateDownloadTaskInput{
+		Token:       getAuthTokenFromMetadata(ctx),
+		WorkspaceID: in.WorkspaceId,
+		Type:        in.DownloadType,
+		URL:         in.Url,
+		TagIDList:   in.TagIdList,
+		CategoryID:  in.CategoryId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.CreateDownloadTaskResponse{
+		DownloadTask: out.DownloadTask,
+	}, nil
+}
+
+// GetDownloadTaskHistory implements idm.IdmServiceServer.
+func (h *Handler) GetDownloadTaskHistory(ctx context.Context, in *idm.GetDownloadTaskHistoryRequest) (*idm.GetDownloadTaskHistoryResponse, error) {
+	out, err := h.downloadTaskLogic.GetDownloadTaskHistory(ctx, logic.GetDownloadTaskHistoryInput{
+		Token:          getAuthTokenFromMetadata(ctx),
+		DownloadTaskID: in.DownloadTaskId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetDownloadTaskHistoryResponse{
+		DownloadTaskEventList: out.DownloadTaskEventList,
+	}, nil
+}
+
+// GetDownloadTaskFile implements idm.IdmServiceServer.
+func (h *Handler) GetDownloadTaskFile(in *idm.GetDownloadTaskFileRequest, server idm.IdmService_GetDownloadTaskFileServer) error {
+	output, err := h.downloadTaskLogic.GetDownloadTaskFile(context.Background(), logic.GetDownloadTaskFileInput{
+		Token:          getAuthTokenFromMetadata(server.Context()),
+		DownloadTaskID: in.DownloadTaskId,
+	})
+	if err != nil {
+		return clientResponseError(err)
+	}
+
+	return h.sendFile(output.Reader, func(data []byte) error {
+		return server.Send(&idm.GetDownloadTaskFileResponse{
+			Data: data,
+		})
+	})
 }
 
 // GetDownloadTaskList implements idm.IdmServiceServer.
 func (h *Handler) GetDownloadTaskList(ctx context.Context, in *idm.GetDownloadTaskListRequest) (*idm.GetDownloadTaskListResponse, error) {
 	out, err := h.downloadTaskLogic.GetDownloadTaskList(ctx, logic.GetDownloadTaskListInput{
-		Token:  h.getAuthTokenFromMetadata(ctx),
-		Offset: in.Offset,
-		Limit:  in.Limit,
+		Token:                      getAuthTokenFromMetadata(ctx),
+		WorkspaceID:                in.WorkspaceId,
+		Offset:                     in.Offset,
+		Limit:                      in.Limit,
+		DownloadStatusList:         in.DownloadStatusList,
+		DownloadTypeList:           in.DownloadTypeList,
+		URLSubstring:               in.UrlSubstring,
+		URLHost:                    in.UrlHost,
+		CreateTimeFrom:             timestampToTime(in.CreateTimeFrom),
+		CreateTimeTo:               timestampToTime(in.CreateTimeTo),
+		UpdateTimeFrom:             timestampToTime(in.UpdateTimeFrom),
+		UpdateTimeTo:               timestampToTime(in.UpdateTimeTo),
+		SearchQuery:                in.SearchQuery,
+		SortField:                  in.SortField,
+		SortDescending:             in.SortDescending,
+		PageToken:                  in.PageToken,
+		SkipTotalDownloadTaskCount: in.SkipTotalDownloadTaskCount,
+		TagIDList:                  in.TagIdList,
+		CategoryIDList:             in.CategoryIdList,
 	})
 	if err != nil {
 		return nil, clientResponseError(err)
@@ -188,42 +719,40 @@ func (h *Handler) GetDownloadTaskList(ctx context.Context, in *idm.GetDownloadTa
 	return &idm.GetDownloadTaskListResponse{
 		DownloadTaskList:       out.DownloadTaskList,
 		TotalDownloadTaskCount: out.TotalDownloadTaskCount,
+		NextPageToken:          out.NextPageToken,
 	}, nil
 }
 
 // UpdateDownloadTask implements idm.IdmServiceServer.
 func (h *Handler) UpdateDownloadTask(ctx context.Context, in *idm.UpdateDownloadTaskRequest) (*idm.UpdateDownloadTaskResponse, error) {
-	var (
-		downloadStatus uint16 = 0
-		metadata       string = ""
-	)
-
+	var downloadStatus uint16 = 0
 	if in.DownloadStatus != nil {
 		downloadStatus = uint16(*in.DownloadStatus)
 	}
-	if in.Metadata != nil {
-		metadata = *in.Metadata
-	}
 
 	out, err := h.downloadTaskLogic.UpdateDownloadTask(ctx, logic.UpdateDownloadTaskInput{
-		Token:          h.getAuthTokenFromMetadata(ctx),
-		DownloadTaskID: in.DownloadTaskId,
-		DownloadStatus: downloadStatus,
-		Metadata:       metadata,
+		Token:           getAuthTokenFromMetadata(ctx),
+		DownloadTaskID:  in.DownloadTaskId,
+		DownloadStatus:  downloadStatus,
+		SetLabels:       in.SetLabels,
+		DeleteLabelKeys: in.DeleteLabelKeys,
+		AddTagIDList:    in.AddTagIdList,
+		RemoveTagIDList: in.RemoveTagIdList,
+		CategoryID:      in.CategoryId,
 	})
 	if err != nil {
 		return nil, clientResponseError(err)
 	}
 
 	return &idm.UpdateDownloadTaskResponse{
-		DownloadTask: &out.DownloadTask,
+		DownloadTask: out.DownloadTask,
 	}, nil
 }
 
 // DeleteDownloadTask implements idm.IdmServiceServer.
 func (h *Handler) DeleteDownloadTask(ctx context.Context, in *idm.DeleteDownloadTaskRequest) (*idm.DeleteDownloadTaskResponse, error) {
 	err := h.downloadTaskLogic.DeleteDownloadTask(ctx, logic.DeleteDownloadTaskInput{
-		Token:          h.getAuthTokenFromMetadata(ctx),
+		Token:          getAuthTokenFromMetadata(ctx),
 		DownloadTaskID: in.DownloadTaskId,
 	})
 	if err != nil {
@@ -232,3 +761,293 @@ func (h *Handler) DeleteDownloadTask(ctx context.Context, in *idm.DeleteDownload
 
 	return &idm.DeleteDownloadTaskResponse{}, nil
 }
+
+// CreateTag implements idm.IdmServiceServer.
+func (h *Handler) CreateTag(ctx context.Context, in *idm.CreateTagRequest) (*idm.CreateTagResponse, error) {
+	out, err := h.tagLogic.CreateTag(ctx, logic.CreateTagInput{
+		Token:       getAuthTokenFromMetadata(ctx),
+		WorkspaceID: in.WorkspaceId,
+		Name:        in.Name,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.CreateTagResponse{
+		Tag: out.Tag,
+	}, nil
+}
+
+// GetTagList implements idm.IdmServiceServer.
+func (h *Handler) GetTagList(ctx context.Context, in *idm.GetTagListRequest) (*idm.GetTagListResponse, error) {
+	out, err := h.tagLogic.GetTagList(ctx, logic.GetTagListInput{
+		Token:       getAuthTokenFromMetadata(ctx),
+		WorkspaceID: in.WorkspaceId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetTagListResponse{
+		TagList: out.TagList,
+	}, nil
+}
+
+// UpdateTag implements idm.IdmServiceServer.
+func (h *Handler) UpdateTag(ctx context.Context, in *idm.UpdateTagRequest) (*idm.UpdateTagResponse, error) {
+	out, err := h.tagLogic.UpdateTag(ctx, logic.UpdateTagInput{
+		Token: getAuthTokenFromMetadata(ctx),
+		TagID: in.TagId,
+		Name:  in.Name,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.UpdateTagResponse{
+		Tag: out.Tag,
+	}, nil
+}
+
+// DeleteTag implements idm.IdmServiceServer.
+func (h *Handler) DeleteTag(ctx context.Context, in *idm.DeleteTagRequest) (*idm.DeleteTagResponse, error) {
+	err := h.tagLogic.DeleteTag(ctx, logic.DeleteTagInput{
+		Token: getAuthTokenFromMetadata(ctx),
+		TagID: in.TagId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.DeleteTagResponse{}, nil
+}
+
+// CreateCategory implements idm.IdmServiceServer.
+func (h *Handler) CreateCategory(ctx context.Context, in *idm.CreateCategoryRequest) (*idm.CreateCategoryResponse, error) {
+	out, err := h.categoryLogic.CreateCategory(ctx, logic.CreateCategoryInput{
+		Token:       getAuthTokenFromMetadata(ctx),
+		WorkspaceID: in.WorkspaceId,
+		Category:    in.Category,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.CreateCategoryResponse{
+		Category: out.Category,
+	}, nil
+}
+
+// GetCategoryList implements idm.IdmServiceServer.
+func (h *Handler) GetCategoryList(ctx context.Context, in *idm.GetCategoryListRequest) (*idm.GetCategoryListResponse, error) {
+	out, err := h.categoryLogic.GetCategoryList(ctx, logic.GetCategoryListInput{
+		Token:       getAuthTokenFromMetadata(ctx),
+		WorkspaceID: in.WorkspaceId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetCategoryListResponse{
+		CategoryList: out.CategoryList,
+	}, nil
+}
+
+// UpdateCategory implements idm.IdmServiceServer.
+func (h *Handler) UpdateCategory(ctx context.Context, in *idm.UpdateCategoryRequest) (*idm.UpdateCategoryResponse, error) {
+	out, err := h.categoryLogic.UpdateCategory(ctx, logic.UpdateCategoryInput{
+		Token:      getAuthTokenFromMetadata(ctx),
+		CategoryID: in.CategoryId,
+		Category:   in.Category,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.UpdateCategoryResponse{
+		Category: out.Category,
+	}, nil
+}
+
+// DeleteCategory implements idm.IdmServiceServer.
+func (h *Handler) DeleteCategory(ctx context.Context, in *idm.DeleteCategoryRequest) (*idm.DeleteCategoryResponse, error) {
+	err := h.categoryLogic.DeleteCategory(ctx, logic.DeleteCategoryInput{
+		Token:      getAuthTokenFromMetadata(ctx),
+		CategoryID: in.CategoryId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.DeleteCategoryResponse{}, nil
+}
+
+// CreateShareLink implements idm.IdmServiceServer.
+func (h *Handler) CreateShareLink(ctx context.Context, in *idm.CreateShareLinkRequest) (*idm.CreateShareLinkResponse, error) {
+	out, err := h.shareLinkLogic.CreateShareLink(ctx, logic.CreateShareLinkInput{
+		Token:            getAuthTokenFromMetadata(ctx),
+		DownloadTaskID:   in.DownloadTaskId,
+		ExpireIn:         time.Duration(in.ExpireInSeconds) * time.Second,
+		Password:         in.Password,
+		MaxDownloadCount: in.MaxDownloadCount,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.CreateShareLinkResponse{
+		ShareLink: out.ShareLink,
+	}, nil
+}
+
+// GetShareLinkList implements idm.IdmServiceServer.
+func (h *Handler) GetShareLinkList(ctx context.Context, in *idm.GetShareLinkListRequest) (*idm.GetShareLinkListResponse, error) {
+	out, err := h.shareLinkLogic.GetShareLinkList(ctx, logic.GetShareLinkListInput{
+		Token:          getAuthTokenFromMetadata(ctx),
+		DownloadTaskID: in.DownloadTaskId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetShareLinkListResponse{
+		ShareLinkList: out.ShareLinkList,
+	}, nil
+}
+
+// DeleteShareLink implements idm.IdmServiceServer.
+func (h *Handler) DeleteShareLink(ctx context.Context, in *idm.DeleteShareLinkRequest) (*idm.DeleteShareLinkResponse, error) {
+	err := h.shareLinkLogic.DeleteShareLink(ctx, logic.DeleteShareLinkInput{
+		Token:       getAuthTokenFromMetadata(ctx),
+		ShareLinkID: in.ShareLinkId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.DeleteShareLinkResponse{}, nil
+}
+
+// GetSharedFile implements idm.IdmServiceServer. It does not require an authentication token,
+// the share token in the request grants access to the file.
+func (h *Handler) GetSharedFile(in *idm.GetSharedFileRequest, server idm.IdmService_GetSharedFileServer) error {
+	output, err := h.shareLinkLogic.GetSharedFile(server.Context(), logic.GetSharedFileInput{
+		ShareToken: in.ShareToken,
+		Password:   h.getSharePasswordFromMetadata(server.Context()),
+	})
+	if err != nil {
+		return clientResponseError(err)
+	}
+
+	return h.sendFile(output.Reader, func(data []byte) error {
+		return server.Send(&idm.GetSharedFileResponse{
+			Data: data,
+		})
+	})
+}
+
+// CreateWebhook implements idm.IdmServiceServer.
+func (h *Handler) CreateWebhook(ctx context.Context, in *idm.CreateWebhookRequest) (*idm.CreateWebhookResponse, error) {
+	out, err := h.webhookLogic.CreateWebhook(ctx, logic.CreateWebhookInput{
+		Token:         getAuthTokenFromMetadata(ctx),
+		URL:           in.Url,
+		EventTypeList: in.EventTypeList,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.CreateWebhookResponse{
+		Webhook: out.Webhook,
+		Secret:  out.Secret,
+	}, nil
+}
+
+// GetWebhookList implements idm.IdmServiceServer.
+func (h *Handler) GetWebhookList(ctx context.Context, in *idm.GetWebhookListRequest) (*idm.GetWebhookListResponse, error) {
+	out, err := h.webhookLogic.GetWebhookList(ctx, logic.GetWebhookListInput{
+		Token: getAuthTokenFromMetadata(ctx),
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetWebhookListResponse{
+		WebhookList: out.WebhookList,
+	}, nil
+}
+
+// DeleteWebhook implements idm.IdmServiceServer.
+func (h *Handler) DeleteWebhook(ctx context.Context, in *idm.DeleteWebhookRequest) (*idm.DeleteWebhookResponse, error) {
+	err := h.webhookLogic.DeleteWebhook(ctx, logic.DeleteWebhookInput{
+		Token:     getAuthTokenFromMetadata(ctx),
+		WebhookID: in.WebhookId,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.DeleteWebhookResponse{}, nil
+}
+
+// GetWebhookDeliveryList implements idm.IdmServiceServer.
+func (h *Handler) GetWebhookDeliveryList(ctx context.Context, in *idm.GetWebhookDeliveryListRequest) (*idm.GetWebhookDeliveryListResponse, error) {
+	out, err := h.webhookLogic.GetWebhookDeliveryList(ctx, logic.GetWebhookDeliveryListInput{
+		Token:     getAuthTokenFromMetadata(ctx),
+		WebhookID: in.WebhookId,
+		Offset:    in.Offset,
+		Limit:     in.Limit,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &idm.GetWebhookDeliveryListResponse{
+		WebhookDeliveryList:       out.WebhookDeliveryList,
+		TotalWebhookDeliveryCount: out.TotalWebhookDeliveryCount,
+	}, nil
+}
+
+// sendFile streams the content of reader in chunks of the configured response buffer size and closes it.
+func (h *Handler) sendFile(reader io.ReadCloser, send func(data []byte) error) error {
+	defer reader.Close()
+
+	responseBufferSizeInBytes, err := h.getDownloadTaskFileConfig.GetResponseBufferSizeInBytes()
+	if err != nil {
+		return clientResponseError(err)
+	}
+
+	responseBuffer := make([]byte, responseBufferSizeInBytes)
+	for {
+		readByteCount, err := reader.Read(responseBuffer)
+
+		if readByteCount > 0 {
+			err = send(responseBuffer[:readByteCount])
+			if err != nil {
+				return clientResponseError(err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return clientResponseError(err)
+		}
+
+	}
+
+	return nil
+}
+
+// timestampToTime returns the zero time for an unset timestamp.
+func timestampToTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+
+	return timestamp.AsTime()
+}
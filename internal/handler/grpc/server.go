@@ -15,16 +15,25 @@ type Server interface {
 	Start(ctx context.Context) error
 }
 
-func NewServer(grpcConfig configs.GRPC, handler idm.IdmServiceServer) Server {
+func NewServer(
+	grpcConfig configs.GRPC,
+	handler idm.IdmServiceServer,
+	adminHandler idm.AdminServiceServer,
+	authorizationInterceptor AuthorizationInterceptor,
+) Server {
 	return &server{
-		grpcConfig: grpcConfig,
-		handler:    handler,
+		grpcConfig:               grpcConfig,
+		handler:                  handler,
+		adminHandler:             adminHandler,
+		authorizationInterceptor: authorizationInterceptor,
 	}
 }
 
 type server struct {
-	grpcConfig configs.GRPC
-	handler    idm.IdmServiceServer
+	grpcConfig               configs.GRPC
+	handler                  idm.IdmServiceServer
+	adminHandler             idm.AdminServiceServer
+	authorizationInterceptor AuthorizationInterceptor
 }
 
 // Start implements Server.
@@ -37,14 +46,17 @@ func (s *server) Start(ctx context.Context) error {
 
 	var opts = []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
+			s.authorizationInterceptor.UnaryServerInterceptor(),
 			validator.UnaryServerInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
+			s.authorizationInterceptor.StreamServerInterceptor(),
 			validator.StreamServerInterceptor(),
 		),
 	}
 	server := grpc.NewServer(opts...)
 	idm.RegisterIdmServiceServer(server, s.handler)
+	idm.RegisterAdminServiceServer(server, s.adminHandler)
 
 	fmt.Printf("gRPC server is running on %s\n", s.grpcConfig.Address)
 	return server.Serve(listener)
@@ -0,0 +1,233 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	grpcHandler "github.com/maxuanquang/idm/internal/handler/grpc"
+	"github.com/maxuanquang/idm/internal/logic"
+	"github.com/maxuanquang/idm/internal/utils"
+)
+
+const (
+	downloadTaskFilePattern            = "GET /api/v1/tasks/{download_task_id}/files"
+	downloadTaskIDPathValueName        = "download_task_id"
+	defaultDownloadTaskFileContentType = "application/octet-stream"
+)
+
+// DownloadTaskFileHandler serves the raw file of a download task, taking precedence over the
+// grpc-gateway route of GetDownloadTaskFile which streams the file as JSON encoded chunks.
+type DownloadTaskFileHandler http.Handler
+
+func NewDownloadTaskFileHandler(
+	downloadTaskLogic logic.DownloadTaskLogic,
+	logger *zap.Logger,
+) DownloadTaskFileHandler {
+	return &downloadTaskFileHandler{
+		downloadTaskLogic: downloadTaskLogic,
+		logger:            logger,
+	}
+}
+
+type downloadTaskFileHandler struct {
+	downloadTaskLogic logic.DownloadTaskLogic
+	logger            *zap.Logger
+}
+
+// ServeHTTP implements DownloadTaskFileHandler.
+func (d *downloadTaskFileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	downloadTaskID, err := strconv.ParseUint(r.PathValue(downloadTaskIDPathValueName), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid download task id", http.StatusBadRequest)
+		return
+	}
+
+	var token string
+	if authorization := r.Header.Get("Authorization"); strings.HasPrefix(authorization, grpcHandler.BearerAuthorizationPrefix) {
+		token = strings.TrimPrefix(authorization, grpcHandler.BearerAuthorizationPrefix)
+	} else if cookie, err := r.Cookie(AuthCookieName); err == nil {
+		token = cookie.Value
+	}
+
+	acceptedEncodings := parseAcceptEncoding(r.Header.Get("Accept-Encoding"))
+	fileInfo, err := d.downloadTaskLogic.GetDownloadTaskFileInfo(ctx, logic.GetDownloadTaskFileInfoInput{
+		Token:             token,
+		DownloadTaskID:    downloadTaskID,
+		AcceptedEncodings: acceptedEncodings,
+	})
+	if err != nil {
+		d.writeError(w, err)
+		return
+	}
+
+	contentType := fileInfo.ContentType
+	if contentType == "" {
+		contentType = defaultDownloadTaskFileContentType
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileInfo.FileName}))
+	w.Header().Set("Vary", "Accept-Encoding")
+	if fileInfo.ContentEncoding != "" {
+		// Ranges then apply to the compressed content, which is the representation being served.
+		w.Header().Set("Content-Encoding", fileInfo.ContentEncoding)
+		w.Header().Set("ETag", fmt.Sprintf(`"%x-%x-%x-%s"`, downloadTaskID, fileInfo.Size, fileInfo.ModTime.UnixNano(), fileInfo.ContentEncoding))
+	} else {
+		w.Header().Set("ETag", fmt.Sprintf(`"%x-%x-%x"`, downloadTaskID, fileInfo.Size, fileInfo.ModTime.UnixNano()))
+	}
+
+	content := &lazyReadSeeker{
+		size: fileInfo.Size,
+		open: func() (io.ReadCloser, error) {
+			output, err := d.downloadTaskLogic.OpenDownloadTaskFile(ctx, logic.OpenDownloadTaskFileInput{
+				DownloadTaskID:    downloadTaskID,
+				AcceptedEncodings: acceptedEncodings,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if output.ContentEncoding != fileInfo.ContentEncoding {
+				output.Reader.Close()
+				return nil, fmt.Errorf("download task file is served with content coding %q instead of %q", output.ContentEncoding, fileInfo.ContentEncoding)
+			}
+
+			return output.Reader, nil
+		},
+	}
+	defer func() {
+		if err := content.Close(); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to close download task file")
+		}
+	}()
+
+	// ServeContent takes care of Content-Length, Last-Modified, conditional requests and ranges.
+	http.ServeContent(w, r, fileInfo.FileName, fileInfo.ModTime, content)
+}
+
+func (d *downloadTaskFileHandler) writeError(w http.ResponseWriter, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		http.Error(w, "Something went wrong", http.StatusInternalServerError)
+		return
+	}
+
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	if httpStatus == http.StatusInternalServerError {
+		http.Error(w, "Something went wrong", httpStatus)
+		return
+	}
+
+	http.Error(w, st.Message(), httpStatus)
+}
+
+// parseAcceptEncoding returns the content codings of an Accept-Encoding header the client accepts, that is
+// the ones not given a quality value of 0.
+func parseAcceptEncoding(acceptEncoding string) []string {
+	var acceptedEncodings []string
+	for _, element := range strings.Split(acceptEncoding, ",") {
+		coding, parameters, _ := strings.Cut(element, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding == "" {
+			continue
+		}
+
+		if qualityValue, ok := strings.CutPrefix(strings.TrimSpace(parameters), "q="); ok {
+			if quality, err := strconv.ParseFloat(qualityValue, 64); err != nil || quality == 0 {
+				continue
+			}
+		}
+
+		acceptedEncodings = append(acceptedEncodings, coding)
+	}
+
+	return acceptedEncodings
+}
+
+// lazyReadSeeker lets http.ServeContent seek in a file that can only be read sequentially. The file is
+// opened on the first read, seeking forward skips content and seeking backward reopens the file.
+type lazyReadSeeker struct {
+	size         int64
+	open         func() (io.ReadCloser, error)
+	offset       int64
+	reader       io.ReadCloser
+	readerOffset int64
+}
+
+func (l *lazyReadSeeker) Read(p []byte) (int, error) {
+	if l.offset >= l.size {
+		return 0, io.EOF
+	}
+
+	if l.reader != nil && l.readerOffset > l.offset {
+		if err := l.Close(); err != nil {
+			return 0, err
+		}
+	}
+
+	if l.reader == nil {
+		reader, err := l.open()
+		if err != nil {
+			return 0, err
+		}
+
+		l.reader = reader
+		l.readerOffset = 0
+	}
+
+	if l.readerOffset < l.offset {
+		skippedByteCount, err := io.CopyN(io.Discard, l.reader, l.offset-l.readerOffset)
+		l.readerOffset += skippedByteCount
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	readByteCount, err := l.reader.Read(p)
+	l.offset += int64(readByteCount)
+	l.readerOffset += int64(readByteCount)
+	return readByteCount, err
+}
+
+func (l *lazyReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = l.offset + offset
+	case io.SeekEnd:
+		newOffset = l.size + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+
+	if newOffset < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	l.offset = newOffset
+	return newOffset, nil
+}
+
+func (l *lazyReadSeeker) Close() error {
+	if l.reader == nil {
+		return nil
+	}
+
+	err := l.reader.Close()
+	l.reader = nil
+	return err
+}
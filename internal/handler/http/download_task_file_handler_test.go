@@ -0,0 +1,33 @@
+package http
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseAcceptEncoding(t *testing.T) {
+	testCases := []struct {
+		name           string
+		acceptEncoding string
+		expected       []string
+	}{
+		{name: "empty", acceptEncoding: "", expected: nil},
+		{name: "single coding", acceptEncoding: "gzip", expected: []string{"gzip"}},
+		{name: "several codings", acceptEncoding: "gzip, deflate, br, zstd", expected: []string{"gzip", "deflate", "br", "zstd"}},
+		{name: "upper case", acceptEncoding: "GZip", expected: []string{"gzip"}},
+		{name: "quality values", acceptEncoding: "gzip;q=1.0, zstd;q=0.5", expected: []string{"gzip", "zstd"}},
+		{name: "refused coding", acceptEncoding: "gzip;q=0, zstd", expected: []string{"zstd"}},
+		{name: "refused coding with spaces", acceptEncoding: "gzip ; q=0.000, zstd", expected: []string{"zstd"}},
+		{name: "invalid quality value", acceptEncoding: "gzip;q=abc, zstd", expected: []string{"zstd"}},
+		{name: "empty elements", acceptEncoding: " , gzip,,", expected: []string{"gzip"}},
+		{name: "wildcard", acceptEncoding: "*", expected: []string{"*"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if acceptedEncodings := parseAcceptEncoding(testCase.acceptEncoding); !slices.Equal(acceptedEncodings, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, acceptedEncodings)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/maxuanquang/idm/internal/logic"
+	"github.com/maxuanquang/idm/internal/utils"
+)
+
+const (
+	jwksPattern            = "GET /.well-known/jwks.json"
+	jwksCacheControlMaxAge = "public, max-age=300"
+)
+
+// JWKSHandler publishes the public keys verifying tokens so that other services can verify them
+// without calling this service.
+type JWKSHandler http.Handler
+
+func NewJWKSHandler(
+	tokenSigningKeyLogic logic.TokenSigningKeyLogic,
+	logger *zap.Logger,
+) JWKSHandler {
+	return &jwksHandler{
+		tokenSigningKeyLogic: tokenSigningKeyLogic,
+		logger:               logger,
+	}
+}
+
+type jwksHandler struct {
+	tokenSigningKeyLogic logic.TokenSigningKeyLogic
+	logger               *zap.Logger
+}
+
+// ServeHTTP implements JWKSHandler.
+func (j *jwksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := utils.LoggerWithContext(ctx, j.logger)
+
+	jwks, err := j.tokenSigningKeyLogic.GetJWKS(ctx)
+	if err != nil {
+		http.Error(w, "Something went wrong", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", jwksCacheControlMaxAge)
+	if err = json.NewEncoder(w).Encode(jwks); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to write jwks")
+	}
+}
@@ -6,7 +6,7 @@ func WithCORS(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "http://localhost:5173")
 		w.Header().Set("Access-Control-Allow-Methods", "GET,HEAD,POST,PUT,DELETE,OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept,Content-Type,User-Agent,Authorization,Cookie,Cache-Control,Set-Cookie,Content-Length")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept,Content-Type,User-Agent,Authorization,Cookie,Cache-Control,Set-Cookie,Content-Length,X-Share-Password")
 		w.Header().Set("Access-Control-Expose-Headers", "*")
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
 
@@ -55,3 +55,58 @@ func WithAuthMetadataToAuthCookie(authCookieName string, authTokenMetadataName s
 		},
 	)
 }
+
+// WithRefreshCookieToRefreshMetadata forwards the refresh cookie, which browsers only send to the refresh path.
+func WithRefreshCookieToRefreshMetadata(refreshCookieName string, refreshTokenMetadataName string) runtime.ServeMuxOption {
+	return runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+		cookie, err := r.Cookie(refreshCookieName)
+		if err != nil {
+			return make(metadata.MD)
+		}
+		return metadata.New(
+			map[string]string{
+				refreshTokenMetadataName: cookie.Value,
+			},
+		)
+	})
+}
+
+// WithRefreshMetadataToRefreshCookie sets the refresh cookie scoped to refreshCookiePath, an empty refresh token
+// deletes the cookie.
+func WithRefreshMetadataToRefreshCookie(
+	refreshCookieName string,
+	refreshTokenMetadataName string,
+	refreshCookiePath string,
+	expiresInDuration time.Duration,
+) runtime.ServeMuxOption {
+	return runtime.WithForwardResponseOption(
+		func(ctx context.Context, w http.ResponseWriter, m proto.Message) error {
+			md, ok := runtime.ServerMetadataFromContext(ctx)
+			if !ok {
+				return nil
+			}
+
+			refreshTokenMetadataValues := md.HeaderMD.Get(refreshTokenMetadataName)
+			if len(refreshTokenMetadataValues) == 0 {
+				return nil
+			}
+
+			cookie := &http.Cookie{
+				Name:     refreshCookieName,
+				Value:    refreshTokenMetadataValues[0],
+				HttpOnly: true,
+				SameSite: http.SameSiteStrictMode,
+				Path:     refreshCookiePath,
+				Expires:  time.Now().Add(expiresInDuration),
+				Secure:   true,
+			}
+			if cookie.Value == "" {
+				cookie.Expires = time.Time{}
+				cookie.MaxAge = -1
+			}
+
+			http.SetCookie(w, cookie)
+			return nil
+		},
+	)
+}
@@ -0,0 +1,66 @@
+package servemuxoption
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// WithOIDCStateCookieToOIDCStateMetadata forwards the state cookie set when an OIDC login started.
+func WithOIDCStateCookieToOIDCStateMetadata(oidcStateCookieName string, oidcStateMetadataName string) runtime.ServeMuxOption {
+	return runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+		cookie, err := r.Cookie(oidcStateCookieName)
+		if err != nil {
+			return make(metadata.MD)
+		}
+		return metadata.New(
+			map[string]string{
+				oidcStateMetadataName: cookie.Value,
+			},
+		)
+	})
+}
+
+// WithOIDCStateMetadataToOIDCStateCookie sets the state cookie scoped to oidcStateCookiePath, an empty state
+// deletes the cookie. The cookie is Lax as the provider redirects back with a cross-site navigation.
+func WithOIDCStateMetadataToOIDCStateCookie(
+	oidcStateCookieName string,
+	oidcStateMetadataName string,
+	oidcStateCookiePath string,
+	expiresInDuration time.Duration,
+) runtime.ServeMuxOption {
+	return runtime.WithForwardResponseOption(
+		func(ctx context.Context, w http.ResponseWriter, m proto.Message) error {
+			md, ok := runtime.ServerMetadataFromContext(ctx)
+			if !ok {
+				return nil
+			}
+
+			oidcStateMetadataValues := md.HeaderMD.Get(oidcStateMetadataName)
+			if len(oidcStateMetadataValues) == 0 {
+				return nil
+			}
+
+			cookie := &http.Cookie{
+				Name:     oidcStateCookieName,
+				Value:    oidcStateMetadataValues[0],
+				HttpOnly: true,
+				SameSite: http.SameSiteLaxMode,
+				Path:     oidcStateCookiePath,
+				Expires:  time.Now().Add(expiresInDuration),
+				Secure:   true,
+			}
+			if cookie.Value == "" {
+				cookie.Expires = time.Time{}
+				cookie.MaxAge = -1
+			}
+
+			http.SetCookie(w, cookie)
+			return nil
+		},
+	)
+}
@@ -0,0 +1,31 @@
+package servemuxoption
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+)
+
+// WithLocationMetadataToRedirect redirects the browser to the location sent in metadata. It writes the status
+// code, so it has to be registered after every option setting headers.
+func WithLocationMetadataToRedirect(locationMetadataName string) runtime.ServeMuxOption {
+	return runtime.WithForwardResponseOption(
+		func(ctx context.Context, w http.ResponseWriter, m proto.Message) error {
+			md, ok := runtime.ServerMetadataFromContext(ctx)
+			if !ok {
+				return nil
+			}
+
+			locationMetadataValues := md.HeaderMD.Get(locationMetadataName)
+			if len(locationMetadataValues) == 0 || locationMetadataValues[0] == "" {
+				return nil
+			}
+
+			w.Header().Set("Location", locationMetadataValues[0])
+			w.WriteHeader(http.StatusFound)
+			return nil
+		},
+	)
+}
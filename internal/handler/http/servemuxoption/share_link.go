@@ -0,0 +1,25 @@
+package servemuxoption
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+// WithSharePasswordHeaderToSharePasswordMetadata forwards the password of a share link, which is sent in a header
+// rather than in the URL of the shared file.
+func WithSharePasswordHeaderToSharePasswordMetadata(sharePasswordHeaderName string, sharePasswordMetadataName string) runtime.ServeMuxOption {
+	return runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+		sharePassword := r.Header.Get(sharePasswordHeaderName)
+		if sharePassword == "" {
+			return make(metadata.MD)
+		}
+		return metadata.New(
+			map[string]string{
+				sharePasswordMetadataName: sharePassword,
+			},
+		)
+	})
+}
@@ -18,7 +18,13 @@ import (
 )
 
 const (
-	AuthCookieName = "IDM_AUTH"
+	AuthCookieName      = "IDM_AUTH"
+	RefreshCookieName   = "IDM_REFRESH"
+	RefreshCookiePath   = "/api/v1/sessions/refresh"
+	OIDCStateCookieName = "IDM_OIDC_STATE"
+	OIDCStateCookiePath = "/api/v1/oidc"
+	// SharePasswordHeaderName is the header the password of password protected share links is sent in.
+	SharePasswordHeaderName = "X-Share-Password"
 )
 
 type Server interface {
@@ -29,30 +35,55 @@ func NewServer(
 	httpConfig configs.HTTP,
 	grpcConfig configs.GRPC,
 	authConfig configs.Auth,
+	oidcConfig configs.OIDC,
 	spaHandler SPAHandler,
+	downloadTaskFileHandler DownloadTaskFileHandler,
+	jwksHandler JWKSHandler,
 	logger *zap.Logger,
 ) Server {
 	return &server{
-		httpConfig: httpConfig,
-		grpcConfig: grpcConfig,
-		authConfig: authConfig,
-		spaHandler: spaHandler,
-		logger:     logger,
+		httpConfig:              httpConfig,
+		grpcConfig:              grpcConfig,
+		authConfig:              authConfig,
+		oidcConfig:              oidcConfig,
+		spaHandler:              spaHandler,
+		downloadTaskFileHandler: downloadTaskFileHandler,
+		jwksHandler:             jwksHandler,
+		logger:                  logger,
 	}
 }
 
 type server struct {
-	httpConfig configs.HTTP
-	grpcConfig configs.GRPC
-	authConfig configs.Auth
-	spaHandler SPAHandler
-	logger     *zap.Logger
+	httpConfig              configs.HTTP
+	grpcConfig              configs.GRPC
+	authConfig              configs.Auth
+	oidcConfig              configs.OIDC
+	spaHandler              SPAHandler
+	downloadTaskFileHandler DownloadTaskFileHandler
+	jwksHandler             JWKSHandler
+	logger                  *zap.Logger
 }
 
 func (s *server) Start(ctx context.Context) error {
 	gwMux := runtime.NewServeMux(
 		servemuxoption.WithAuthCookieToAuthMetadata(AuthCookieName, grpcHandler.AuthTokenMetadataName),
 		servemuxoption.WithAuthMetadataToAuthCookie(AuthCookieName, grpcHandler.AuthTokenMetadataName, s.authConfig.Token.GetTokenDuration()),
+		servemuxoption.WithRefreshCookieToRefreshMetadata(RefreshCookieName, grpcHandler.RefreshTokenMetadataName),
+		servemuxoption.WithRefreshMetadataToRefreshCookie(
+			RefreshCookieName,
+			grpcHandler.RefreshTokenMetadataName,
+			RefreshCookiePath,
+			s.authConfig.Token.GetRefreshTokenDuration(),
+		),
+		servemuxoption.WithOIDCStateCookieToOIDCStateMetadata(OIDCStateCookieName, grpcHandler.OIDCStateMetadataName),
+		servemuxoption.WithOIDCStateMetadataToOIDCStateCookie(
+			OIDCStateCookieName,
+			grpcHandler.OIDCStateMetadataName,
+			OIDCStateCookiePath,
+			s.oidcConfig.GetLoginStateDuration(),
+		),
+		servemuxoption.WithLocationMetadataToRedirect(grpcHandler.LocationMetadataName),
+		servemuxoption.WithSharePasswordHeaderToSharePasswordMetadata(SharePasswordHeaderName, grpcHandler.SharePasswordMetadataName),
 	)
 
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
@@ -66,9 +97,21 @@ func (s *server) Start(ctx context.Context) error {
 		return err
 	}
 
+	err = gw.RegisterAdminServiceHandlerFromEndpoint(
+		ctx,
+		gwMux,
+		s.grpcConfig.Address,
+		opts,
+	)
+	if err != nil {
+		return err
+	}
+
 	mux := http.NewServeMux()
 	mux.Handle("/", s.spaHandler)
 	mux.Handle("/api/", gwMux)
+	mux.Handle(downloadTaskFilePattern, s.downloadTaskFileHandler)
+	mux.Handle(jwksPattern, s.jwksHandler)
 
 	fmt.Printf("http server is running on %s\n", s.httpConfig.Address)
 	if s.httpConfig.Mode == configs.HTTPModeDevelopment {
@@ -21,6 +21,10 @@ type Cron interface {
 func NewCron(
 	executeAllPendingDownloadTaskJob ExecuteAllPendingDownloadTaskJob,
 	updateFailedDownloadTaskStatusToPending UpdateFailedDownloadTaskStatusToPendingJob,
+	moveDownloadTaskFileToColdTierJob MoveDownloadTaskFileToColdTierJob,
+	rotateTokenSigningKeyJob RotateTokenSigningKeyJob,
+	deliverAllPendingWebhookDeliveryJob DeliverAllPendingWebhookDeliveryJob,
+	relayAllUnsentOutboxMessageJob RelayAllUnsentOutboxMessageJob,
 	logger *zap.Logger,
 ) (Cron, error) {
 	scheduler, err := gocron.NewScheduler()
@@ -29,7 +33,16 @@ func NewCron(
 		return nil, err
 	}
 
-	err = scheduleCronJobs(scheduler, logger, executeAllPendingDownloadTaskJob, updateFailedDownloadTaskStatusToPending)
+	err = scheduleCronJobs(
+		scheduler,
+		logger,
+		executeAllPendingDownloadTaskJob,
+		updateFailedDownloadTaskStatusToPending,
+		moveDownloadTaskFileToColdTierJob,
+		rotateTokenSigningKeyJob,
+		deliverAllPendingWebhookDeliveryJob,
+		relayAllUnsentOutboxMessageJob,
+	)
 	if err != nil {
 		logger.Error("failed to schedule jobs", zap.Error(err))
 		return nil, err
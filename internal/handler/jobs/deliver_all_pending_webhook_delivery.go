@@ -0,0 +1,38 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/logic"
+)
+
+type DeliverAllPendingWebhookDeliveryJob interface {
+	Run(ctx context.Context) error
+	GetSchedule() string
+}
+
+func NewDeliverAllPendingWebhookDeliveryJob(
+	webhookLogic logic.WebhookLogic,
+	cronConfig configs.Cron,
+) DeliverAllPendingWebhookDeliveryJob {
+	return &deliverAllPendingWebhookDeliveryJob{
+		webhookLogic: webhookLogic,
+		cronConfig:   cronConfig,
+	}
+}
+
+type deliverAllPendingWebhookDeliveryJob struct {
+	webhookLogic logic.WebhookLogic
+	cronConfig   configs.Cron
+}
+
+// GetSchedule implements DeliverAllPendingWebhookDeliveryJob.
+func (d *deliverAllPendingWebhookDeliveryJob) GetSchedule() string {
+	return d.cronConfig.DeliverAllPendingWebhookDelivery.Schedule
+}
+
+// Run implements DeliverAllPendingWebhookDeliveryJob.
+func (d *deliverAllPendingWebhookDeliveryJob) Run(ctx context.Context) error {
+	return d.webhookLogic.DeliverAllPendingWebhookDelivery(ctx)
+}
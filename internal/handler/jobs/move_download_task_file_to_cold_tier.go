@@ -0,0 +1,38 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/logic"
+)
+
+type MoveDownloadTaskFileToColdTierJob interface {
+	Run(ctx context.Context) error
+	GetSchedule() string
+}
+
+func NewMoveDownloadTaskFileToColdTierJob(
+	downloadTaskLogic logic.DownloadTaskLogic,
+	cronConfig configs.Cron,
+) MoveDownloadTaskFileToColdTierJob {
+	return &moveDownloadTaskFileToColdTierJob{
+		downloadTaskLogic: downloadTaskLogic,
+		cronConfig:        cronConfig,
+	}
+}
+
+type moveDownloadTaskFileToColdTierJob struct {
+	downloadTaskLogic logic.DownloadTaskLogic
+	cronConfig        configs.Cron
+}
+
+// GetSchedule implements MoveDownloadTaskFileToColdTierJob.
+func (m *moveDownloadTaskFileToColdTierJob) GetSchedule() string {
+	return m.cronConfig.MoveDownloadTaskFileToColdTier.Schedule
+}
+
+// Run implements MoveDownloadTaskFileToColdTierJob.
+func (m *moveDownloadTaskFileToColdTierJob) Run(ctx context.Context) error {
+	return m.downloadTaskLogic.MoveAllDownloadTaskFileToColdTier(ctx)
+}
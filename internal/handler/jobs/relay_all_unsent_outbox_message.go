@@ -0,0 +1,38 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/logic"
+)
+
+type RelayAllUnsentOutboxMessageJob interface {
+	Run(ctx context.Context) error
+	GetSchedule() string
+}
+
+func NewRelayAllUnsentOutboxMessageJob(
+	outboxMessageLogic logic.OutboxMessageLogic,
+	cronConfig configs.Cron,
+) RelayAllUnsentOutboxMessageJob {
+	return &relayAllUnsentOutboxMessageJob{
+		outboxMessageLogic: outboxMessageLogic,
+		cronConfig:         cronConfig,
+	}
+}
+
+type relayAllUnsentOutboxMessageJob struct {
+	outboxMessageLogic logic.OutboxMessageLogic
+	cronConfig         configs.Cron
+}
+
+// GetSchedule implements RelayAllUnsentOutboxMessageJob.
+func (r *relayAllUnsentOutboxMessageJob) GetSchedule() string {
+	return r.cronConfig.RelayAllUnsentOutboxMessage.Schedule
+}
+
+// Run implements RelayAllUnsentOutboxMessageJob.
+func (r *relayAllUnsentOutboxMessageJob) Run(ctx context.Context) error {
+	return r.outboxMessageLogic.RelayAllUnsentOutboxMessage(ctx)
+}
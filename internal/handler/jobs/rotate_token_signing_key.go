@@ -0,0 +1,42 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/logic"
+)
+
+type RotateTokenSigningKeyJob interface {
+	Run(ctx context.Context) error
+	GetSchedule() string
+}
+
+func NewRotateTokenSigningKeyJob(
+	tokenSigningKeyLogic logic.TokenSigningKeyLogic,
+	cronConfig configs.Cron,
+) RotateTokenSigningKeyJob {
+	return &rotateTokenSigningKeyJob{
+		tokenSigningKeyLogic: tokenSigningKeyLogic,
+		cronConfig:           cronConfig,
+	}
+}
+
+type rotateTokenSigningKeyJob struct {
+	tokenSigningKeyLogic logic.TokenSigningKeyLogic
+	cronConfig           configs.Cron
+}
+
+// GetSchedule implements RotateTokenSigningKeyJob.
+func (r *rotateTokenSigningKeyJob) GetSchedule() string {
+	return r.cronConfig.RotateTokenSigningKey.Schedule
+}
+
+// Run implements RotateTokenSigningKeyJob.
+func (r *rotateTokenSigningKeyJob) Run(ctx context.Context) error {
+	if err := r.tokenSigningKeyLogic.RotateSigningKey(ctx); err != nil {
+		return err
+	}
+
+	return r.tokenSigningKeyLogic.PruneRetiredSigningKey(ctx)
+}
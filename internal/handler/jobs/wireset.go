@@ -5,5 +5,9 @@ import "github.com/google/wire"
 var WireSet = wire.NewSet(
 	NewExecuteAllPendingDownloadTaskJob,
 	NewUpdateFailedDownloadTaskStatusToPendingJob,
+	NewMoveDownloadTaskFileToColdTierJob,
+	NewRotateTokenSigningKeyJob,
+	NewDeliverAllPendingWebhookDeliveryJob,
+	NewRelayAllUnsentOutboxMessageJob,
 	NewCron,
 )
@@ -2,19 +2,40 @@ package logic
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/maxuanquang/idm/internal/configs"
 	"github.com/maxuanquang/idm/internal/dataaccess/cache"
 	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
 	"github.com/maxuanquang/idm/internal/utils"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	sessionIDSizeInBytes          = 16
+	refreshTokenSizeInBytes       = 32
+	totpLoginChallengeSizeInBytes = 32
+	dummyPasswordSizeInBytes      = 16
+	maxSessionUserAgentLength     = 512
+)
+
+var (
+	errRefreshTokenReused  = errors.New("refresh token has already been used")
+	errRefreshTokenInvalid = errors.New("refresh token is invalid")
+)
+
 type CreateAccountInput struct {
 	AccountName string
 	Password    string
@@ -28,62 +49,161 @@ type CreateAccountOutput struct {
 type CreateSessionInput struct {
 	AccountName string
 	Password    string
+	UserAgent   string
+	IPAddress   string
+}
+
+type CreateSessionOfAccountInput struct {
+	AccountID uint64
+	UserAgent string
+	IPAddress string
 }
 
 type CreateSessionOutput struct {
-	Token       string
-	ExpiresAt   time.Time
-	AccountID   uint64
-	AccountName string
+	// TOTPChallenge is set instead of the other fields if the account has TOTP activated.
+	TOTPChallenge         string
+	Token                 string
+	ExpiresAt             time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+	AccountID             uint64
+	AccountName           string
+}
+
+type CompleteTOTPLoginInput struct {
+	TOTPChallenge string
+	Code          string
+}
+
+type RefreshSessionInput struct {
+	RefreshToken string
+}
+
+type RefreshSessionOutput struct {
+	Token                 string
+	ExpiresAt             time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
 }
 
 type DeleteSessionInput struct {
 	Token string
+	// SessionID is the session to revoke, the session of Token is revoked if it is empty.
+	SessionID   string
+	AllSessions bool
 }
 
 type DeleteSessionOutput struct {
 	ExpiredToken string
 }
 
+type AuthorizeAccountInput struct {
+	Token string
+	Role  idm.Role
+}
+
+type ListSessionsInput struct {
+	Token string
+}
+
+type ListSessionsOutput struct {
+	SessionList []*idm.Session
+}
+
 type AccountLogic interface {
 	CreateAccount(ctx context.Context, in CreateAccountInput) (CreateAccountOutput, error)
 	CreateSession(ctx context.Context, in CreateSessionInput) (CreateSessionOutput, error)
+	// CreateSessionOfAccount creates a session without checking a password, the caller must have
+	// authenticated the account by other means such as an external identity provider. Like CreateSession, it
+	// returns a TOTP challenge instead if the account has TOTP activated.
+	CreateSessionOfAccount(ctx context.Context, in CreateSessionOfAccountInput) (CreateSessionOutput, error)
+	// CompleteTOTPLogin creates the session of a TOTP challenge returned by CreateSession or
+	// CreateSessionOfAccount. A challenge is dropped once it is completed or after too many wrong codes.
+	CompleteTOTPLogin(ctx context.Context, in CompleteTOTPLoginInput) (CreateSessionOutput, error)
+	// RefreshSession exchanges a refresh token for a new access token and a new refresh token. A refresh token
+	// can only be used once, using it again revokes its session as the token is assumed to be stolen.
+	RefreshSession(ctx context.Context, in RefreshSessionInput) (RefreshSessionOutput, error)
 	DeleteSession(ctx context.Context, in DeleteSessionInput) error
+	ListSessions(ctx context.Context, in ListSessionsInput) (ListSessionsOutput, error)
+	// AuthorizeAccount returns the ID of the account of a session token if the account is enabled and has
+	// the role. Admins have every role.
+	AuthorizeAccount(ctx context.Context, in AuthorizeAccountInput) (uint64, error)
 }
 
 func NewAccountLogic(
 	database database.Database,
 	accountDataAccessor database.AccountDataAccessor,
 	passwordDataAccessor database.AccountPasswordDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
+	refreshTokenDataAccessor database.RefreshTokenDataAccessor,
 	hashLogic HashLogic,
+	passwordLogic PasswordLogic,
 	tokenLogic TokenLogic,
+	totpLogic TOTPLogic,
+	workspaceLogic WorkspaceLogic,
 	takenAccountNameCache cache.TakenAccountName,
+	loginThrottleLogic LoginThrottleLogic,
+	totpLoginChallengeCache cache.TOTPLoginChallenge,
 	logger *zap.Logger,
-) AccountLogic {
-	return &accountLogic{
-		database:              database,
-		accountDataAccessor:   accountDataAccessor,
-		passwordDataAccessor:  passwordDataAccessor,
-		hashLogic:             hashLogic,
-		tokenLogic:            tokenLogic,
-		takenAccountNameCache: takenAccountNameCache,
-		logger:                logger,
+	authConfig configs.Auth,
+) (AccountLogic, error) {
+	dummyPassword := make([]byte, dummyPasswordSizeInBytes)
+	if _, err := rand.Read(dummyPassword); err != nil {
+		return nil, err
 	}
+
+	dummyHashedPassword, err := hashLogic.HashPassword(context.Background(), base64.RawURLEncoding.EncodeToString(dummyPassword))
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountLogic{
+		database:                 database,
+		accountDataAccessor:      accountDataAccessor,
+		passwordDataAccessor:     passwordDataAccessor,
+		sessionDataAccessor:      sessionDataAccessor,
+		refreshTokenDataAccessor: refreshTokenDataAccessor,
+		hashLogic:                hashLogic,
+		passwordLogic:            passwordLogic,
+		tokenLogic:               tokenLogic,
+		totpLogic:                totpLogic,
+		workspaceLogic:           workspaceLogic,
+		takenAccountNameCache:    takenAccountNameCache,
+		loginThrottleLogic:       loginThrottleLogic,
+		totpLoginChallengeCache:  totpLoginChallengeCache,
+		logger:                   logger,
+		authConfig:               authConfig,
+		dummyHashedPassword:      dummyHashedPassword,
+	}, nil
 }
 
 type accountLogic struct {
-	database              database.Database
-	accountDataAccessor   database.AccountDataAccessor
-	passwordDataAccessor  database.AccountPasswordDataAccessor
-	hashLogic             HashLogic
-	tokenLogic            TokenLogic
-	takenAccountNameCache cache.TakenAccountName
-	logger                *zap.Logger
+	database                 database.Database
+	accountDataAccessor      database.AccountDataAccessor
+	passwordDataAccessor     database.AccountPasswordDataAccessor
+	sessionDataAccessor      database.SessionDataAccessor
+	refreshTokenDataAccessor database.RefreshTokenDataAccessor
+	hashLogic                HashLogic
+	passwordLogic            PasswordLogic
+	tokenLogic               TokenLogic
+	totpLogic                TOTPLogic
+	workspaceLogic           WorkspaceLogic
+	takenAccountNameCache    cache.TakenAccountName
+	loginThrottleLogic       LoginThrottleLogic
+	totpLoginChallengeCache  cache.TOTPLoginChallenge
+	logger                   *zap.Logger
+	authConfig               configs.Auth
+	// dummyHashedPassword is compared against when logging in to accounts without a password.
+	dummyHashedPassword string
 }
 
 // CreateAccount implements Account.
 func (a *accountLogic) CreateAccount(ctx context.Context, in CreateAccountInput) (CreateAccountOutput, error) {
-	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("create_account_input", in))
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("account_name", in.AccountName))
+
+	if err := a.passwordLogic.CheckPasswordPolicy(ctx, in.Password); err != nil {
+		return CreateAccountOutput{}, err
+	}
 
 	taken, err := a.isAccountNameTaken(ctx, in.AccountName)
 	if err != nil {
@@ -118,11 +238,20 @@ func (a *accountLogic) CreateAccount(ctx context.Context, in CreateAccountInput)
 			return fmt.Errorf("error creating password: %w", err)
 		}
 
+		if err = a.workspaceLogic.WithDatabaseTransaction(tx).CreatePersonalWorkspace(ctx, createdAccount); err != nil {
+			return fmt.Errorf("error creating personal workspace: %w", err)
+		}
+
 		createAccountOutput.ID = createdAccount.AccountID
 		createAccountOutput.AccountName = createdAccount.AccountName
 		return nil
 	})
 	if txErr != nil {
+		// The name can be taken by another account created since it was checked.
+		if errors.Is(txErr, database.ErrAccountAlreadyExists) {
+			return CreateAccountOutput{}, status.Error(codes.AlreadyExists, "account name already exists")
+		}
+
 		logger.With(zap.Error(txErr)).Error("create account transaction failed")
 		return CreateAccountOutput{}, status.Error(codes.Internal, txErr.Error())
 	}
@@ -138,59 +267,476 @@ func (a *accountLogic) CreateAccount(ctx context.Context, in CreateAccountInput)
 
 // CreateSession implements Account.
 func (a *accountLogic) CreateSession(ctx context.Context, in CreateSessionInput) (CreateSessionOutput, error) {
-	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("create_session_input", in))
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("account_name", in.AccountName))
+
+	if err := a.loginThrottleLogic.CheckLoginAllowed(ctx, in.AccountName, in.IPAddress); err != nil {
+		return CreateSessionOutput{}, err
+	}
 
 	foundAccount, err := a.accountDataAccessor.GetAccountByName(ctx, in.AccountName)
 	if err != nil {
 		logger.Error("failed to get account by name", zap.Error(err))
 		return CreateSessionOutput{}, status.Error(codes.Internal, "error getting account")
 	}
-	if foundAccount.AccountID == 0 {
-		return CreateSessionOutput{}, status.Error(codes.NotFound, "wrong account name or password")
-	}
 
-	foundPassword, err := a.passwordDataAccessor.GetPassword(ctx, foundAccount.AccountID)
-	if err != nil {
-		logger.Error("failed to get account password", zap.Error(err))
-		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to get account password")
+	// A password is compared even if the account or its password does not exist, so that the response time
+	// does not tell which accounts exist.
+	hashedPassword, hasPassword := a.dummyHashedPassword, false
+	if foundAccount.AccountID != 0 {
+		foundPassword, err := a.passwordDataAccessor.GetPassword(ctx, foundAccount.AccountID)
+		if err != nil && !errors.Is(err, database.ErrAccountPasswordNotFound) {
+			logger.Error("failed to get account password", zap.Error(err))
+			return CreateSessionOutput{}, status.Error(codes.Internal, "failed to get account password")
+		}
+
+		if err == nil {
+			hashedPassword, hasPassword = foundPassword.Hashed, true
+		}
 	}
 
-	matched, err := a.hashLogic.IsHashEqual(ctx, in.Password, foundPassword.Hashed)
+	matched, err := a.hashLogic.IsHashEqual(ctx, in.Password, hashedPassword)
 	if err != nil {
 		logger.Error("failed comparing password", zap.Error(err))
 		return CreateSessionOutput{}, status.Error(codes.Internal, "failed comparing password")
 	}
-	if !matched {
+	if !matched || !hasPassword {
+		a.loginThrottleLogic.RecordFailedLogin(ctx, in.AccountName, in.IPAddress)
 		return CreateSessionOutput{}, status.Error(codes.NotFound, "wrong account name or password")
 	}
 
-	stringToken, expiresAt, err := a.tokenLogic.CreateTokenString(ctx, foundAccount.AccountID)
+	if foundAccount.IsDisabled {
+		return CreateSessionOutput{}, status.Error(codes.PermissionDenied, "account is disabled")
+	}
+
+	totpActivated, err := a.totpLogic.IsTOTPActivated(ctx, foundAccount.AccountID)
+	if err != nil {
+		logger.Error("failed to check if totp is activated", zap.Error(err))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create session")
+	}
+	// Failed logins are only forgotten once the second factor is also correct, as the password may have
+	// been guessed already.
+	if totpActivated {
+		return a.createTOTPLoginChallenge(ctx, foundAccount, in.UserAgent, in.IPAddress)
+	}
+
+	a.loginThrottleLogic.RecordSuccessfulLogin(ctx, in.AccountName, in.IPAddress)
+	return a.createSession(ctx, foundAccount, in.UserAgent, in.IPAddress)
+}
+
+// CreateSessionOfAccount implements AccountLogic.
+func (a *accountLogic) CreateSessionOfAccount(ctx context.Context, in CreateSessionOfAccountInput) (CreateSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", in.AccountID))
+
+	foundAccount, err := a.accountDataAccessor.GetAccountByID(ctx, in.AccountID)
+	if err != nil {
+		logger.Error("failed to get account by id", zap.Error(err))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "error getting account")
+	}
+	if foundAccount.AccountID == 0 {
+		return CreateSessionOutput{}, status.Error(codes.NotFound, "account not found")
+	}
+
+	if foundAccount.IsDisabled {
+		return CreateSessionOutput{}, status.Error(codes.PermissionDenied, "account is disabled")
+	}
+
+	// The caller only checked the first factor, so accounts with TOTP activated still need a code.
+	totpActivated, err := a.totpLogic.IsTOTPActivated(ctx, foundAccount.AccountID)
+	if err != nil {
+		logger.Error("failed to check if totp is activated", zap.Error(err))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create session")
+	}
+	if totpActivated {
+		return a.createTOTPLoginChallenge(ctx, foundAccount, in.UserAgent, in.IPAddress)
+	}
+
+	return a.createSession(ctx, foundAccount, in.UserAgent, in.IPAddress)
+}
+
+// CompleteTOTPLogin implements AccountLogic.
+func (a *accountLogic) CompleteTOTPLogin(ctx context.Context, in CompleteTOTPLoginInput) (CreateSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	challenge, err := a.totpLoginChallengeCache.Get(ctx, in.TOTPChallenge)
+	if err != nil && !errors.Is(err, cache.ErrCacheMissed) {
+		logger.Error("failed to get totp login challenge", zap.Error(err))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to get totp login challenge")
+	}
+	if err != nil || !time.Now().Before(challenge.ExpireTime) {
+		return CreateSessionOutput{}, status.Error(codes.Unauthenticated, "totp challenge is invalid or expired")
+	}
+
+	logger = logger.With(zap.Uint64("account_id", challenge.AccountID))
+
+	account, err := a.accountDataAccessor.GetAccountByID(ctx, challenge.AccountID)
+	if err != nil {
+		return CreateSessionOutput{}, status.Error(codes.Internal, "error getting account")
+	}
+	if account.AccountID == 0 {
+		return CreateSessionOutput{}, status.Error(codes.NotFound, "account not found")
+	}
+
+	// A challenge only allows a few codes, but a new one is created with every correct password.
+	if err = a.loginThrottleLogic.CheckLoginAllowed(ctx, account.AccountName, challenge.IPAddress); err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	// Every code is counted before it is checked, so that concurrent requests can not check more codes than
+	// the challenge allows.
+	attemptCount, err := a.totpLoginChallengeCache.IncrementAttemptCount(ctx, in.TOTPChallenge, time.Until(challenge.ExpireTime))
+	if err != nil {
+		logger.Error("failed to increment totp login challenge attempt count", zap.Error(err))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to verify totp code")
+	}
+	if attemptCount > a.authConfig.TOTP.MaxLoginChallengeAttempts {
+		logger.Warn("too many wrong totp codes, dropping totp login challenge")
+		if err = a.totpLoginChallengeCache.Delete(ctx, in.TOTPChallenge); err != nil {
+			logger.Error("failed to delete totp login challenge", zap.Error(err))
+		}
+
+		return CreateSessionOutput{}, status.Error(codes.Unauthenticated, "totp challenge is invalid or expired")
+	}
+
+	err = a.totpLogic.VerifyCode(ctx, challenge.AccountID, in.Code)
+	if err != nil {
+		if !errors.Is(err, ErrTOTPCodeInvalid) {
+			logger.Error("failed to verify totp code", zap.Error(err))
+			return CreateSessionOutput{}, status.Error(codes.Internal, "failed to verify totp code")
+		}
+
+		a.loginThrottleLogic.RecordFailedLogin(ctx, account.AccountName, challenge.IPAddress)
+
+		if attemptCount == a.authConfig.TOTP.MaxLoginChallengeAttempts {
+			logger.Warn("too many wrong totp codes, dropping totp login challenge")
+			if err = a.totpLoginChallengeCache.Delete(ctx, in.TOTPChallenge); err != nil {
+				logger.Error("failed to delete totp login challenge", zap.Error(err))
+			}
+		}
+
+		return CreateSessionOutput{}, status.Error(codes.Unauthenticated, "totp code is wrong")
+	}
+
+	if err = a.totpLoginChallengeCache.Delete(ctx, in.TOTPChallenge); err != nil {
+		logger.Error("failed to delete totp login challenge", zap.Error(err))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create session")
+	}
+
+	if account.IsDisabled {
+		return CreateSessionOutput{}, status.Error(codes.PermissionDenied, "account is disabled")
+	}
+
+	a.loginThrottleLogic.RecordSuccessfulLogin(ctx, account.AccountName, challenge.IPAddress)
+	return a.createSession(ctx, account, challenge.UserAgent, challenge.IPAddress)
+}
+
+// createTOTPLoginChallenge keeps a login whose password was checked until a TOTP code completes it.
+func (a *accountLogic) createTOTPLoginChallenge(
+	ctx context.Context,
+	account database.Account,
+	userAgent string,
+	ipAddress string,
+) (CreateSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", account.AccountID))
+
+	challenge, err := generateTOTPLoginChallenge()
+	if err != nil {
+		logger.Error("failed to generate totp login challenge", zap.Error(err))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create session")
+	}
+
+	duration := a.authConfig.TOTP.GetLoginChallengeDuration()
+	err = a.totpLoginChallengeCache.Set(ctx, challenge, cache.TOTPLoginChallengeValue{
+		AccountID:  account.AccountID,
+		UserAgent:  truncateString(userAgent, maxSessionUserAgentLength),
+		IPAddress:  ipAddress,
+		ExpireTime: time.Now().Add(duration),
+	}, duration)
+	if err != nil {
+		logger.Error("failed to set totp login challenge", zap.Error(err))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create session")
+	}
+
+	return CreateSessionOutput{
+		TOTPChallenge: challenge,
+	}, nil
+}
+
+func (a *accountLogic) createSession(
+	ctx context.Context,
+	account database.Account,
+	userAgent string,
+	ipAddress string,
+) (CreateSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", account.AccountID))
+
+	sessionID, err := generateSessionID()
+	if err != nil {
+		logger.Error("failed to generate session id", zap.Error(err))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create session")
+	}
+
+	refreshToken, hashedRefreshToken, err := generateRefreshToken()
+	if err != nil {
+		logger.Error("failed to generate refresh token", zap.Error(err))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create session")
+	}
+
+	// The session lives as long as its latest refresh token.
+	now := time.Now()
+	refreshTokenExpiresAt := now.Add(a.authConfig.Token.GetRefreshTokenDuration())
+	txErr := a.database.Transaction(func(tx *gorm.DB) error {
+		err := a.sessionDataAccessor.WithDatabaseTransaction(tx).CreateSession(ctx, database.Session{
+			SessionID:    sessionID,
+			OfAccountID:  account.AccountID,
+			UserAgent:    truncateString(userAgent, maxSessionUserAgentLength),
+			IPAddress:    ipAddress,
+			CreateTime:   now,
+			LastSeenTime: now,
+			ExpireTime:   refreshTokenExpiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating session: %w", err)
+		}
+
+		err = a.refreshTokenDataAccessor.WithDatabaseTransaction(tx).CreateRefreshToken(ctx, database.RefreshToken{
+			OfSessionID: sessionID,
+			HashedToken: hashedRefreshToken,
+			ExpireTime:  refreshTokenExpiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating refresh token: %w", err)
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		logger.Error("create session transaction failed", zap.Error(txErr))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create session")
+	}
+
+	stringToken, expiresAt, err := a.tokenLogic.CreateTokenString(ctx, account.AccountID, sessionID)
 	if err != nil {
 		logger.Error("failed to create token", zap.Error(err))
 		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create token")
 	}
 
 	return CreateSessionOutput{
-		Token:       stringToken,
-		ExpiresAt:   expiresAt,
-		AccountID:   foundAccount.AccountID,
-		AccountName: foundAccount.AccountName,
+		Token:                 stringToken,
+		ExpiresAt:             expiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshTokenExpiresAt,
+		AccountID:             account.AccountID,
+		AccountName:           account.AccountName,
+	}, nil
+}
+
+// RefreshSession implements AccountLogic.
+func (a *accountLogic) RefreshSession(ctx context.Context, in RefreshSessionInput) (RefreshSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	if in.RefreshToken == "" {
+		return RefreshSessionOutput{}, status.Error(codes.Unauthenticated, "refresh token is missing")
+	}
+
+	newRefreshToken, newHashedRefreshToken, err := generateRefreshToken()
+	if err != nil {
+		logger.Error("failed to generate refresh token", zap.Error(err))
+		return RefreshSessionOutput{}, status.Error(codes.Internal, "failed to refresh session")
+	}
+
+	var (
+		now                      = time.Now()
+		newRefreshTokenExpiresAt = now.Add(a.authConfig.Token.GetRefreshTokenDuration())
+		session                  database.Session
+	)
+	txErr := a.database.Transaction(func(tx *gorm.DB) error {
+		refreshToken, err := a.refreshTokenDataAccessor.WithDatabaseTransaction(tx).
+			GetRefreshTokenByHashedTokenForUpdate(ctx, hashRefreshToken(in.RefreshToken))
+		if err != nil {
+			if errors.Is(err, database.ErrRefreshTokenNotFound) {
+				return errRefreshTokenInvalid
+			}
+
+			return err
+		}
+
+		session, err = a.sessionDataAccessor.WithDatabaseTransaction(tx).GetSession(ctx, refreshToken.OfSessionID)
+		if err != nil {
+			return err
+		}
+
+		if refreshToken.IsUsed {
+			return errRefreshTokenReused
+		}
+
+		if session.IsRevoked || !now.Before(refreshToken.ExpireTime) {
+			return errRefreshTokenInvalid
+		}
+
+		if err = a.refreshTokenDataAccessor.WithDatabaseTransaction(tx).MarkRefreshTokenUsed(ctx, refreshToken.RefreshTokenID); err != nil {
+			return err
+		}
+
+		err = a.refreshTokenDataAccessor.WithDatabaseTransaction(tx).CreateRefreshToken(ctx, database.RefreshToken{
+			OfSessionID: session.SessionID,
+			HashedToken: newHashedRefreshToken,
+			ExpireTime:  newRefreshTokenExpiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = a.sessionDataAccessor.WithDatabaseTransaction(tx).UpdateSessionExpireTime(ctx, session.SessionID, newRefreshTokenExpiresAt); err != nil {
+			return err
+		}
+
+		session.ExpireTime = newRefreshTokenExpiresAt
+		return nil
+	})
+	if txErr != nil {
+		switch {
+		case errors.Is(txErr, errRefreshTokenReused):
+			logger.With(zap.String("session_id", session.SessionID)).Warn("refresh token reused, revoking session")
+			if err = a.tokenLogic.RevokeSession(ctx, session); err != nil {
+				logger.With(zap.Error(err)).Error("failed to revoke session")
+			}
+
+			return RefreshSessionOutput{}, status.Error(codes.Unauthenticated, "refresh token is invalid")
+		case errors.Is(txErr, errRefreshTokenInvalid):
+			return RefreshSessionOutput{}, status.Error(codes.Unauthenticated, "refresh token is invalid")
+		default:
+			logger.With(zap.Error(txErr)).Error("refresh session transaction failed")
+			return RefreshSessionOutput{}, status.Error(codes.Internal, "failed to refresh session")
+		}
+	}
+
+	stringToken, expiresAt, err := a.tokenLogic.CreateTokenString(ctx, session.OfAccountID, session.SessionID)
+	if err != nil {
+		logger.Error("failed to create token", zap.Error(err))
+		return RefreshSessionOutput{}, status.Error(codes.Internal, "failed to create token")
+	}
+
+	return RefreshSessionOutput{
+		Token:                 stringToken,
+		ExpiresAt:             expiresAt,
+		RefreshToken:          newRefreshToken,
+		RefreshTokenExpiresAt: newRefreshTokenExpiresAt,
 	}, nil
 }
 
 // DeleteSession implements AccountLogic.
 func (a *accountLogic) DeleteSession(ctx context.Context, in DeleteSessionInput) error {
-	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("delete_session_input", in))
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("session_id", in.SessionID)).With(zap.Bool("all_sessions", in.AllSessions))
 
-	_, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	accountID, currentSessionID, err := a.tokenLogic.GetAccountIDAndSessionID(ctx, in.Token)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to find account from token")
-		return status.Error(codes.NotFound, "account not found")
+		return status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	var sessions []database.Session
+	switch {
+	case in.AllSessions:
+		sessions, err = a.sessionDataAccessor.GetActiveSessionListOfAccount(ctx, accountID)
+		if err != nil {
+			return status.Error(codes.Internal, "failed to get session list")
+		}
+	default:
+		sessionID := in.SessionID
+		if sessionID == "" {
+			sessionID = currentSessionID
+		}
+
+		session, err := a.sessionDataAccessor.GetSession(ctx, sessionID)
+		if err != nil {
+			if errors.Is(err, database.ErrSessionNotFound) {
+				return status.Error(codes.NotFound, "session not found")
+			}
+
+			return status.Error(codes.Internal, "failed to get session")
+		}
+
+		if session.OfAccountID != accountID {
+			return status.Error(codes.NotFound, "session not found")
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	for _, session := range sessions {
+		if err = a.tokenLogic.RevokeSession(ctx, session); err != nil {
+			logger.With(zap.Error(err)).Error("failed to revoke session")
+			return status.Error(codes.Internal, "failed to revoke session")
+		}
 	}
 
 	return nil
 }
 
+// ListSessions implements AccountLogic.
+func (a *accountLogic) ListSessions(ctx context.Context, in ListSessionsInput) (ListSessionsOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	accountID, currentSessionID, err := a.tokenLogic.GetAccountIDAndSessionID(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to find account from token")
+		return ListSessionsOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	sessions, err := a.sessionDataAccessor.GetActiveSessionListOfAccount(ctx, accountID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get session list")
+		return ListSessionsOutput{}, status.Error(codes.Internal, "failed to get session list")
+	}
+
+	sessionList := make([]*idm.Session, 0, len(sessions))
+	for _, session := range sessions {
+		sessionList = append(sessionList, &idm.Session{
+			Id:           session.SessionID,
+			UserAgent:    session.UserAgent,
+			IpAddress:    session.IPAddress,
+			CreateTime:   timestamppb.New(session.CreateTime),
+			LastSeenTime: timestamppb.New(session.LastSeenTime),
+			ExpireTime:   timestamppb.New(session.ExpireTime),
+			Current:      session.SessionID == currentSessionID,
+		})
+	}
+
+	return ListSessionsOutput{
+		SessionList: sessionList,
+	}, nil
+}
+
+// AuthorizeAccount implements AccountLogic.
+func (a *accountLogic) AuthorizeAccount(ctx context.Context, in AuthorizeAccountInput) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("role", in.Role.String()))
+
+	accountID, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to find account from token")
+		return 0, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	account, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error getting account")
+	}
+	if account.AccountID == 0 {
+		return 0, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	if account.IsDisabled {
+		return 0, status.Error(codes.PermissionDenied, "account is disabled")
+	}
+
+	if idm.Role(account.Role) != in.Role && idm.Role(account.Role) != idm.Role_Admin {
+		logger.With(zap.Uint64("account_id", accountID)).Warn("account does not have the required role")
+		return 0, ErrPermissionDenied
+	}
+
+	return accountID, nil
+}
+
 func (a *accountLogic) isAccountNameTaken(ctx context.Context, accountName string) (bool, error) {
 	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("account_name", accountName))
 
@@ -221,3 +767,45 @@ func (a *accountLogic) isAccountNameTaken(ctx context.Context, accountName strin
 
 	return true, nil
 }
+
+func generateSessionID() (string, error) {
+	sessionID := make([]byte, sessionIDSizeInBytes)
+	if _, err := rand.Read(sessionID); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(sessionID), nil
+}
+
+// generateRefreshToken returns a random refresh token and its hash, only the hash is stored.
+func generateRefreshToken() (string, string, error) {
+	refreshToken := make([]byte, refreshTokenSizeInBytes)
+	if _, err := rand.Read(refreshToken); err != nil {
+		return "", "", err
+	}
+
+	encodedRefreshToken := base64.RawURLEncoding.EncodeToString(refreshToken)
+	return encodedRefreshToken, hashRefreshToken(encodedRefreshToken), nil
+}
+
+func hashRefreshToken(refreshToken string) string {
+	hashedRefreshToken := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(hashedRefreshToken[:])
+}
+
+func generateTOTPLoginChallenge() (string, error) {
+	challenge := make([]byte, totpLoginChallengeSizeInBytes)
+	if _, err := rand.Read(challenge); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(challenge), nil
+}
+
+func truncateString(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+
+	return s[:maxLength]
+}
@@ -0,0 +1,453 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type GetAccountListInput struct {
+	Offset uint64
+	Limit  uint64
+}
+
+type GetAccountListOutput struct {
+	AccountList       []*idm.Account
+	TotalAccountCount uint64
+}
+
+type UpdateAccountRoleInput struct {
+	// AdminAccountID is the account making the change, admins can not change their own role.
+	AdminAccountID uint64
+	AccountID      uint64
+	Role           idm.Role
+}
+
+type DisableAccountInput struct {
+	// AdminAccountID is the account making the change, admins can not disable their own account.
+	AdminAccountID uint64
+	AccountID      uint64
+}
+
+type EnableAccountInput struct {
+	AccountID uint64
+}
+
+type ResetAccountPasswordInput struct {
+	AccountID uint64
+	Password  string
+}
+
+type CreatePasswordResetTokenInput struct {
+	AccountID uint64
+}
+
+type DisableAccountTOTPInput struct {
+	AccountID uint64
+}
+
+type GetAllDownloadTaskListInput struct {
+	// OfAccountID is 0 to list the download tasks of every account.
+	OfAccountID uint64
+	Offset      uint64
+	Limit       uint64
+}
+
+type GetAllDownloadTaskListOutput struct {
+	DownloadTaskList       []*idm.DownloadTask
+	TotalDownloadTaskCount uint64
+}
+
+type CancelDownloadTaskInput struct {
+	// AdminAccountID is the account cancelling the download task, it is recorded in the download task history.
+	AdminAccountID uint64
+	DownloadTaskID uint64
+}
+
+type CancelDownloadTaskOutput struct {
+	DownloadTask *idm.DownloadTask
+}
+
+type GetSystemStatsOutput struct {
+	TotalAccountCount       uint64
+	DisabledAccountCount    uint64
+	ActiveSessionCount      uint64
+	TotalDownloadTaskCount  uint64
+	DownloadStatusCountList []*idm.DownloadStatusCount
+}
+
+// AdminLogic manages every account and download task. It does not authenticate its callers, the caller
+// must have checked that they are admins with AccountLogic.AuthorizeAccount.
+type AdminLogic interface {
+	GetAccountList(ctx context.Context, in GetAccountListInput) (GetAccountListOutput, error)
+	UpdateAccountRole(ctx context.Context, in UpdateAccountRoleInput) error
+	// DisableAccount revokes every session of the account and rejects its API keys until it is enabled again.
+	DisableAccount(ctx context.Context, in DisableAccountInput) error
+	EnableAccount(ctx context.Context, in EnableAccountInput) error
+	// ResetAccountPassword revokes every session of the account, accounts without a password are given one.
+	ResetAccountPassword(ctx context.Context, in ResetAccountPasswordInput) error
+	// CreatePasswordResetToken creates a single use token the owner of the account can reset its password with.
+	CreatePasswordResetToken(ctx context.Context, in CreatePasswordResetTokenInput) (CreatePasswordResetTokenOutput, error)
+	// DisableAccountTOTP lets the account log in with its password alone.
+	DisableAccountTOTP(ctx context.Context, in DisableAccountTOTPInput) error
+	GetAllDownloadTaskList(ctx context.Context, in GetAllDownloadTaskListInput) (GetAllDownloadTaskListOutput, error)
+	CancelDownloadTask(ctx context.Context, in CancelDownloadTaskInput) (CancelDownloadTaskOutput, error)
+	GetSystemStats(ctx context.Context) (GetSystemStatsOutput, error)
+}
+
+func NewAdminLogic(
+	database database.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	downloadTaskEventDataAccessor database.DownloadTaskEventDataAccessor,
+	tagDataAccessor database.TagDataAccessor,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	passwordLogic PasswordLogic,
+	tokenLogic TokenLogic,
+	totpLogic TOTPLogic,
+	logger *zap.Logger,
+) AdminLogic {
+	return &adminLogic{
+		database:                      database,
+		accountDataAccessor:           accountDataAccessor,
+		sessionDataAccessor:           sessionDataAccessor,
+		downloadTaskDataAccessor:      downloadTaskDataAccessor,
+		downloadTaskEventDataAccessor: downloadTaskEventDataAccessor,
+		tagDataAccessor:               tagDataAccessor,
+		outboxMessageDataAccessor:     outboxMessageDataAccessor,
+		passwordLogic:                 passwordLogic,
+		tokenLogic:                    tokenLogic,
+		totpLogic:                     totpLogic,
+		logger:                        logger,
+	}
+}
+
+type adminLogic struct {
+	database                      database.Database
+	accountDataAccessor           database.AccountDataAccessor
+	sessionDataAccessor           database.SessionDataAccessor
+	downloadTaskDataAccessor      database.DownloadTaskDataAccessor
+	downloadTaskEventDataAccessor database.DownloadTaskEventDataAccessor
+	tagDataAccessor               database.TagDataAccessor
+	outboxMessageDataAccessor     database.OutboxMessageDataAccessor
+	passwordLogic                 PasswordLogic
+	tokenLogic                    TokenLogic
+	totpLogic                     TOTPLogic
+	logger                        *zap.Logger
+}
+
+// GetAccountList implements AdminLogic.
+func (a *adminLogic) GetAccountList(ctx context.Context, in GetAccountListInput) (GetAccountListOutput, error) {
+	accounts, err := a.accountDataAccessor.GetAccountList(ctx, in.Offset, in.Limit)
+	if err != nil {
+		return GetAccountListOutput{}, status.Error(codes.Internal, "failed to get account list")
+	}
+
+	totalAccountCount, err := a.accountDataAccessor.GetAccountCount(ctx)
+	if err != nil {
+		return GetAccountListOutput{}, status.Error(codes.Internal, "failed to get account count")
+	}
+
+	accountList := make([]*idm.Account, 0, len(accounts))
+	for _, account := range accounts {
+		accountList = append(accountList, databaseAccountToProtoAccount(account))
+	}
+
+	return GetAccountListOutput{
+		AccountList:       accountList,
+		TotalAccountCount: totalAccountCount,
+	}, nil
+}
+
+// UpdateAccountRole implements AdminLogic.
+func (a *adminLogic) UpdateAccountRole(ctx context.Context, in UpdateAccountRoleInput) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Uint64("admin_account_id", in.AdminAccountID)).
+		With(zap.Uint64("account_id", in.AccountID)).
+		With(zap.String("role", in.Role.String()))
+
+	if in.AccountID == in.AdminAccountID {
+		return status.Error(codes.FailedPrecondition, "can not change the role of your own account")
+	}
+
+	if _, err := a.getAccount(ctx, in.AccountID); err != nil {
+		return err
+	}
+
+	if err := a.accountDataAccessor.UpdateAccountRole(ctx, in.AccountID, uint16(in.Role)); err != nil {
+		return status.Error(codes.Internal, "failed to update account role")
+	}
+
+	logger.Info("account role updated")
+	return nil
+}
+
+// DisableAccount implements AdminLogic.
+func (a *adminLogic) DisableAccount(ctx context.Context, in DisableAccountInput) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Uint64("admin_account_id", in.AdminAccountID)).
+		With(zap.Uint64("account_id", in.AccountID))
+
+	if in.AccountID == in.AdminAccountID {
+		return status.Error(codes.FailedPrecondition, "can not disable your own account")
+	}
+
+	if _, err := a.getAccount(ctx, in.AccountID); err != nil {
+		return err
+	}
+
+	if err := a.accountDataAccessor.UpdateAccountIsDisabled(ctx, in.AccountID, true); err != nil {
+		return status.Error(codes.Internal, "failed to disable account")
+	}
+
+	if err := a.tokenLogic.RevokeSessionListOfAccount(ctx, in.AccountID, ""); err != nil {
+		logger.With(zap.Error(err)).Error("failed to revoke sessions of disabled account")
+		return status.Error(codes.Internal, "failed to revoke sessions of account")
+	}
+
+	logger.Info("account disabled")
+	return nil
+}
+
+// EnableAccount implements AdminLogic.
+func (a *adminLogic) EnableAccount(ctx context.Context, in EnableAccountInput) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", in.AccountID))
+
+	if _, err := a.getAccount(ctx, in.AccountID); err != nil {
+		return err
+	}
+
+	if err := a.accountDataAccessor.UpdateAccountIsDisabled(ctx, in.AccountID, false); err != nil {
+		return status.Error(codes.Internal, "failed to enable account")
+	}
+
+	logger.Info("account enabled")
+	return nil
+}
+
+// ResetAccountPassword implements AdminLogic.
+func (a *adminLogic) ResetAccountPassword(ctx context.Context, in ResetAccountPasswordInput) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", in.AccountID))
+
+	if _, err := a.getAccount(ctx, in.AccountID); err != nil {
+		return err
+	}
+
+	if err := a.passwordLogic.SetPassword(ctx, in.AccountID, in.Password); err != nil {
+		return err
+	}
+
+	if err := a.tokenLogic.RevokeSessionListOfAccount(ctx, in.AccountID, ""); err != nil {
+		logger.With(zap.Error(err)).Error("failed to revoke sessions after password reset")
+		return status.Error(codes.Internal, "failed to revoke sessions of account")
+	}
+
+	logger.Info("account password reset")
+	return nil
+}
+
+// CreatePasswordResetToken implements AdminLogic.
+func (a *adminLogic) CreatePasswordResetToken(ctx context.Context, in CreatePasswordResetTokenInput) (CreatePasswordResetTokenOutput, error) {
+	return a.passwordLogic.CreatePasswordResetToken(ctx, in.AccountID)
+}
+
+// DisableAccountTOTP implements AdminLogic.
+func (a *adminLogic) DisableAccountTOTP(ctx context.Context, in DisableAccountTOTPInput) error {
+	if _, err := a.getAccount(ctx, in.AccountID); err != nil {
+		return err
+	}
+
+	return a.totpLogic.DisableTOTPOfAccount(ctx, in.AccountID)
+}
+
+// GetAllDownloadTaskList implements AdminLogic.
+func (a *adminLogic) GetAllDownloadTaskList(ctx context.Context, in GetAllDownloadTaskListInput) (GetAllDownloadTaskListOutput, error) {
+	var (
+		downloadTasks          []database.DownloadTask
+		totalDownloadTaskCount uint64
+		err                    error
+	)
+	if in.OfAccountID != 0 {
+		downloadTasks, err = a.downloadTaskDataAccessor.GetDownloadTaskListOfAccount(ctx, in.OfAccountID, in.Offset, in.Limit)
+		if err == nil {
+			totalDownloadTaskCount, err = a.downloadTaskDataAccessor.GetDownloadTaskCountOfAccount(ctx, in.OfAccountID)
+		}
+	} else {
+		downloadTasks, err = a.downloadTaskDataAccessor.GetDownloadTaskList(ctx, in.Offset, in.Limit)
+		if err == nil {
+			totalDownloadTaskCount, err = a.downloadTaskDataAccessor.GetDownloadTaskCount(ctx)
+		}
+	}
+	if err != nil {
+		return GetAllDownloadTaskListOutput{}, status.Error(codes.Internal, "failed to get download task list")
+	}
+
+	downloadTaskList, err := databaseDownloadTaskListToProtoDownloadTaskList(ctx, a.accountDataAccessor, a.tagDataAccessor, downloadTasks)
+	if err != nil {
+		return GetAllDownloadTaskListOutput{}, status.Error(codes.Internal, "failed to get account")
+	}
+
+	return GetAllDownloadTaskListOutput{
+		DownloadTaskList:       downloadTaskList,
+		TotalDownloadTaskCount: totalDownloadTaskCount,
+	}, nil
+}
+
+// CancelDownloadTask implements AdminLogic.
+func (a *adminLogic) CancelDownloadTask(ctx context.Context, in CancelDownloadTaskInput) (CancelDownloadTaskOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("download_task_id", in.DownloadTaskID))
+
+	var downloadTask database.DownloadTask
+	txErr := a.database.Transaction(func(tx *gorm.DB) error {
+		var err error
+
+		downloadTask, err = a.downloadTaskDataAccessor.WithDatabaseTransaction(tx).GetDownloadTaskForUpdate(ctx, in.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		downloadTaskEventList, err := updateDownloadTaskWithEvent(
+			ctx,
+			a.downloadTaskDataAccessor.WithDatabaseTransaction(tx),
+			a.downloadTaskEventDataAccessor.WithDatabaseTransaction(tx),
+			downloadTask,
+			uint16(idm.DownloadStatus_Cancelled),
+			DownloadStatusTriggerAdmin,
+			"",
+			fmt.Sprintf("cancelled by admin account %d", in.AdminAccountID),
+		)
+		if err != nil {
+			return err
+		}
+
+		downloadTask, err = a.downloadTaskDataAccessor.WithDatabaseTransaction(tx).GetDownloadTaskForUpdate(ctx, in.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		lifecycleEventList, err := newDownloadTaskLifecycleEventList(
+			ctx,
+			a.accountDataAccessor.WithDatabaseTransaction(tx),
+			a.downloadTaskDataAccessor.WithDatabaseTransaction(tx),
+			a.tagDataAccessor.WithDatabaseTransaction(tx),
+			downloadTaskEventList,
+		)
+		if err != nil {
+			return err
+		}
+
+		return createDownloadTaskLifecycleEventOutboxMessageList(
+			ctx,
+			a.outboxMessageDataAccessor.WithDatabaseTransaction(tx),
+			lifecycleEventList,
+		)
+	})
+	if txErr != nil {
+		switch {
+		case errors.Is(txErr, database.ErrDownloadTaskNotFound):
+			return CancelDownloadTaskOutput{}, status.Error(codes.NotFound, "download task not found")
+		case errors.Is(txErr, errIllegalDownloadStatusTransition):
+			return CancelDownloadTaskOutput{}, status.Error(codes.FailedPrecondition, txErr.Error())
+		default:
+			logger.With(zap.Error(txErr)).Error("cancel download task transaction failed")
+			return CancelDownloadTaskOutput{}, status.Error(codes.Internal, "failed to cancel download task")
+		}
+	}
+
+	downloadTaskList, err := databaseDownloadTaskListToProtoDownloadTaskList(
+		ctx,
+		a.accountDataAccessor,
+		a.tagDataAccessor,
+		[]database.DownloadTask{downloadTask},
+	)
+	if err != nil {
+		return CancelDownloadTaskOutput{}, status.Error(codes.Internal, "failed to get account")
+	}
+
+	logger.Info("download task cancelled")
+	return CancelDownloadTaskOutput{
+		DownloadTask: downloadTaskList[0],
+	}, nil
+}
+
+// GetSystemStats implements AdminLogic.
+func (a *adminLogic) GetSystemStats(ctx context.Context) (GetSystemStatsOutput, error) {
+	totalAccountCount, err := a.accountDataAccessor.GetAccountCount(ctx)
+	if err != nil {
+		return GetSystemStatsOutput{}, status.Error(codes.Internal, "failed to get account count")
+	}
+
+	disabledAccountCount, err := a.accountDataAccessor.GetDisabledAccountCount(ctx)
+	if err != nil {
+		return GetSystemStatsOutput{}, status.Error(codes.Internal, "failed to get disabled account count")
+	}
+
+	activeSessionCount, err := a.sessionDataAccessor.GetActiveSessionCount(ctx)
+	if err != nil {
+		return GetSystemStatsOutput{}, status.Error(codes.Internal, "failed to get active session count")
+	}
+
+	countPerStatus, err := a.downloadTaskDataAccessor.GetDownloadTaskCountPerStatus(ctx)
+	if err != nil {
+		return GetSystemStatsOutput{}, status.Error(codes.Internal, "failed to get download task count")
+	}
+
+	var (
+		totalDownloadTaskCount  uint64
+		downloadStatusCountList = make([]*idm.DownloadStatusCount, 0, len(idm.DownloadStatus_name))
+	)
+	for downloadStatus := range idm.DownloadStatus_name {
+		count := countPerStatus[uint16(downloadStatus)]
+		totalDownloadTaskCount += count
+		if downloadStatus == int32(idm.DownloadStatus_UndefinedStatus) {
+			continue
+		}
+
+		downloadStatusCountList = append(downloadStatusCountList, &idm.DownloadStatusCount{
+			DownloadStatus: idm.DownloadStatus(downloadStatus),
+			Count:          count,
+		})
+	}
+	slices.SortFunc(downloadStatusCountList, func(x, y *idm.DownloadStatusCount) int {
+		return int(x.DownloadStatus) - int(y.DownloadStatus)
+	})
+
+	return GetSystemStatsOutput{
+		TotalAccountCount:       totalAccountCount,
+		DisabledAccountCount:    disabledAccountCount,
+		ActiveSessionCount:      activeSessionCount,
+		TotalDownloadTaskCount:  totalDownloadTaskCount,
+		DownloadStatusCountList: downloadStatusCountList,
+	}, nil
+}
+
+func (a *adminLogic) getAccount(ctx context.Context, accountID uint64) (database.Account, error) {
+	account, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return database.Account{}, status.Error(codes.Internal, "failed to get account")
+	}
+	if account.AccountID == 0 {
+		return database.Account{}, status.Error(codes.NotFound, "account not found")
+	}
+
+	return account, nil
+}
+
+func databaseAccountToProtoAccount(account database.Account) *idm.Account {
+	return &idm.Account{
+		Id:          account.AccountID,
+		AccountName: account.AccountName,
+		Role:        idm.Role(account.Role),
+		Disabled:    account.IsDisabled,
+	}
+}
@@ -0,0 +1,215 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	APIKeyScopeTasksRead  = "tasks:read"
+	APIKeyScopeTasksWrite = "tasks:write"
+	APIKeyScopeFilesRead  = "files:read"
+
+	// apiKeyPrefix tells API keys apart from session tokens.
+	apiKeyPrefix              = "idm_"
+	apiKeySizeInBytes         = 32
+	apiKeyDisplayPrefixLength = len(apiKeyPrefix) + 8
+)
+
+type CreateAPIKeyInput struct {
+	Token  string
+	Name   string
+	Scopes []string
+	// ExpireTime is nil for keys that never expire.
+	ExpireTime *time.Time
+}
+
+type CreateAPIKeyOutput struct {
+	APIKey *idm.APIKey
+	// Key is only known when it is created, only its hash is stored.
+	Key string
+}
+
+type GetAPIKeyListInput struct {
+	Token string
+}
+
+type GetAPIKeyListOutput struct {
+	APIKeyList []*idm.APIKey
+}
+
+type DeleteAPIKeyInput struct {
+	Token    string
+	APIKeyID uint64
+}
+
+// APIKeyLogic manages long-lived API keys used by scripts in place of session tokens. API keys can not
+// manage API keys or sessions, only session tokens can.
+type APIKeyLogic interface {
+	CreateAPIKey(ctx context.Context, in CreateAPIKeyInput) (CreateAPIKeyOutput, error)
+	GetAPIKeyList(ctx context.Context, in GetAPIKeyListInput) (GetAPIKeyListOutput, error)
+	DeleteAPIKey(ctx context.Context, in DeleteAPIKeyInput) error
+}
+
+func NewAPIKeyLogic(
+	tokenLogic TokenLogic,
+	apiKeyDataAccessor database.APIKeyDataAccessor,
+	logger *zap.Logger,
+) APIKeyLogic {
+	return &apiKeyLogic{
+		tokenLogic:         tokenLogic,
+		apiKeyDataAccessor: apiKeyDataAccessor,
+		logger:             logger,
+	}
+}
+
+type apiKeyLogic struct {
+	tokenLogic         TokenLogic
+	apiKeyDataAccessor database.APIKeyDataAccessor
+	logger             *zap.Logger
+}
+
+// CreateAPIKey implements APIKeyLogic.
+func (a *apiKeyLogic) CreateAPIKey(ctx context.Context, in CreateAPIKeyInput) (CreateAPIKeyOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("name", in.Name))
+
+	accountID, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
+		return CreateAPIKeyOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	if in.ExpireTime != nil && !in.ExpireTime.After(time.Now()) {
+		return CreateAPIKeyOutput{}, status.Error(codes.InvalidArgument, "expire time must be in the future")
+	}
+
+	key, err := generateAPIKey()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate api key")
+		return CreateAPIKeyOutput{}, status.Error(codes.Internal, "failed to create api key")
+	}
+
+	apiKey := database.APIKey{
+		OfAccountID: accountID,
+		Name:        in.Name,
+		KeyPrefix:   key[:apiKeyDisplayPrefixLength],
+		HashedKey:   hashAPIKey(key),
+		Scopes:      strings.Join(in.Scopes, " "),
+		CreateTime:  time.Now(),
+		ExpireTime:  in.ExpireTime,
+	}
+	apiKey.APIKeyID, err = a.apiKeyDataAccessor.CreateAPIKey(ctx, apiKey)
+	if err != nil {
+		return CreateAPIKeyOutput{}, status.Error(codes.Internal, "failed to create api key")
+	}
+
+	return CreateAPIKeyOutput{
+		APIKey: a.databaseAPIKeyToProtoAPIKey(apiKey),
+		Key:    key,
+	}, nil
+}
+
+// GetAPIKeyList implements APIKeyLogic.
+func (a *apiKeyLogic) GetAPIKeyList(ctx context.Context, in GetAPIKeyListInput) (GetAPIKeyListOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	accountID, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
+		return GetAPIKeyListOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	apiKeys, err := a.apiKeyDataAccessor.GetAPIKeyListOfAccount(ctx, accountID)
+	if err != nil {
+		return GetAPIKeyListOutput{}, status.Error(codes.Internal, "failed to get api key list")
+	}
+
+	apiKeyList := make([]*idm.APIKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		apiKeyList = append(apiKeyList, a.databaseAPIKeyToProtoAPIKey(apiKey))
+	}
+
+	return GetAPIKeyListOutput{
+		APIKeyList: apiKeyList,
+	}, nil
+}
+
+// DeleteAPIKey implements APIKeyLogic.
+func (a *apiKeyLogic) DeleteAPIKey(ctx context.Context, in DeleteAPIKeyInput) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("api_key_id", in.APIKeyID))
+
+	accountID, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
+		return status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	apiKey, err := a.apiKeyDataAccessor.GetAPIKey(ctx, in.APIKeyID)
+	if err != nil {
+		if errors.Is(err, database.ErrAPIKeyNotFound) {
+			return status.Error(codes.NotFound, "api key not found")
+		}
+
+		return status.Error(codes.Internal, "failed to get api key")
+	}
+
+	if apiKey.OfAccountID != accountID || apiKey.IsRevoked {
+		return status.Error(codes.NotFound, "api key not found")
+	}
+
+	if err = a.apiKeyDataAccessor.RevokeAPIKey(ctx, apiKey.APIKeyID); err != nil {
+		return status.Error(codes.Internal, "failed to revoke api key")
+	}
+
+	return nil
+}
+
+func (a *apiKeyLogic) databaseAPIKeyToProtoAPIKey(apiKey database.APIKey) *idm.APIKey {
+	protoAPIKey := &idm.APIKey{
+		Id:         apiKey.APIKeyID,
+		Name:       apiKey.Name,
+		KeyPrefix:  apiKey.KeyPrefix,
+		Scopes:     strings.Fields(apiKey.Scopes),
+		CreateTime: timestamppb.New(apiKey.CreateTime),
+	}
+	if apiKey.LastUsedTime != nil {
+		protoAPIKey.LastUsedTime = timestamppb.New(*apiKey.LastUsedTime)
+	}
+	if apiKey.ExpireTime != nil {
+		protoAPIKey.ExpireTime = timestamppb.New(*apiKey.ExpireTime)
+	}
+
+	return protoAPIKey
+}
+
+func isAPIKey(token string) bool {
+	return strings.HasPrefix(token, apiKeyPrefix)
+}
+
+func generateAPIKey() (string, error) {
+	key := make([]byte, apiKeySizeInBytes)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(key), nil
+}
+
+func hashAPIKey(key string) string {
+	hashedKey := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hashedKey[:])
+}
@@ -0,0 +1,349 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"mime"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/dataaccess/file"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type CreateCategoryInput struct {
+	Token string
+	// WorkspaceID is 0 for the personal workspace of the account.
+	WorkspaceID uint64
+	Category    *idm.Category
+}
+
+type GetCategoryListInput struct {
+	Token string
+	// WorkspaceID is 0 for the personal workspace of the account.
+	WorkspaceID uint64
+}
+
+type GetCategoryListOutput struct {
+	CategoryList []*idm.Category
+}
+
+type UpdateCategoryInput struct {
+	Token      string
+	CategoryID uint64
+	Category   *idm.Category
+}
+
+type CategoryOutput struct {
+	Category *idm.Category
+}
+
+type DeleteCategoryInput struct {
+	Token      string
+	CategoryID uint64
+}
+
+// CategoryLogic manages the categories of workspaces, the managers of a workspace set the defaults of its
+// categories and its members read them.
+type CategoryLogic interface {
+	CreateCategory(ctx context.Context, in CreateCategoryInput) (CategoryOutput, error)
+	GetCategoryList(ctx context.Context, in GetCategoryListInput) (GetCategoryListOutput, error)
+	UpdateCategory(ctx context.Context, in UpdateCategoryInput) (CategoryOutput, error)
+	DeleteCategory(ctx context.Context, in DeleteCategoryInput) error
+}
+
+func NewCategoryLogic(
+	tokenLogic TokenLogic,
+	workspaceLogic WorkspaceLogic,
+	categoryDataAccessor database.CategoryDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	database database.Database,
+	logger *zap.Logger,
+) CategoryLogic {
+	return &categoryLogic{
+		tokenLogic:               tokenLogic,
+		workspaceLogic:           workspaceLogic,
+		categoryDataAccessor:     categoryDataAccessor,
+		downloadTaskDataAccessor: downloadTaskDataAccessor,
+		database:                 database,
+		logger:                   logger,
+	}
+}
+
+type categoryLogic struct {
+	tokenLogic               TokenLogic
+	workspaceLogic           WorkspaceLogic
+	categoryDataAccessor     database.CategoryDataAccessor
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor
+	database                 database.Database
+	logger                   *zap.Logger
+}
+
+// CreateCategory implements CategoryLogic.
+func (c *categoryLogic) CreateCategory(ctx context.Context, in CreateCategoryInput) (CategoryOutput, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("name", in.Category.GetName()))
+
+	accountID, err := getAccountIDWithScope(ctx, c.tokenLogic, c.logger, in.Token, APIKeyScopeTasksWrite)
+	if err != nil {
+		return CategoryOutput{}, err
+	}
+
+	workspaceID, err := c.workspaceLogic.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: in.WorkspaceID,
+		Role:        idm.WorkspaceRole_Manager,
+	})
+	if err != nil {
+		return CategoryOutput{}, err
+	}
+
+	category, err := protoCategoryToDatabaseCategory(in.Category)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to convert category")
+		return CategoryOutput{}, status.Error(codes.Internal, "failed to create category")
+	}
+
+	category.OfWorkspaceID = workspaceID
+	createdCategory, err := c.categoryDataAccessor.CreateCategory(ctx, category)
+	if err != nil {
+		if errors.Is(err, database.ErrCategoryAlreadyExists) {
+			return CategoryOutput{}, status.Error(codes.AlreadyExists, "category name already exists in the workspace")
+		}
+
+		logger.With(zap.Error(err)).Error("failed to create category")
+		return CategoryOutput{}, status.Error(codes.Internal, "failed to create category")
+	}
+
+	return CategoryOutput{Category: databaseCategoryToProtoCategory(createdCategory)}, nil
+}
+
+// GetCategoryList implements CategoryLogic.
+func (c *categoryLogic) GetCategoryList(ctx context.Context, in GetCategoryListInput) (GetCategoryListOutput, error) {
+	accountID, err := getAccountIDWithScope(ctx, c.tokenLogic, c.logger, in.Token, APIKeyScopeTasksRead)
+	if err != nil {
+		return GetCategoryListOutput{}, err
+	}
+
+	workspaceID, err := c.workspaceLogic.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: in.WorkspaceID,
+		Role:        idm.WorkspaceRole_Viewer,
+	})
+	if err != nil {
+		return GetCategoryListOutput{}, err
+	}
+
+	categories, err := c.categoryDataAccessor.GetCategoryListOfWorkspace(ctx, workspaceID)
+	if err != nil {
+		return GetCategoryListOutput{}, status.Error(codes.Internal, "failed to get category list")
+	}
+
+	categoryList := make([]*idm.Category, 0, len(categories))
+	for _, category := range categories {
+		categoryList = append(categoryList, databaseCategoryToProtoCategory(category))
+	}
+
+	return GetCategoryListOutput{CategoryList: categoryList}, nil
+}
+
+// UpdateCategory implements CategoryLogic.
+func (c *categoryLogic) UpdateCategory(ctx context.Context, in UpdateCategoryInput) (CategoryOutput, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("category_id", in.CategoryID))
+
+	managedCategory, err := c.getManagedCategory(ctx, in.Token, in.CategoryID)
+	if err != nil {
+		return CategoryOutput{}, err
+	}
+
+	category, err := protoCategoryToDatabaseCategory(in.Category)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to convert category")
+		return CategoryOutput{}, status.Error(codes.Internal, "failed to update category")
+	}
+
+	category.CategoryID = managedCategory.CategoryID
+	category.OfWorkspaceID = managedCategory.OfWorkspaceID
+	category.CreateTime = managedCategory.CreateTime
+	err = c.database.Transaction(func(tx *gorm.DB) error {
+		if err := c.categoryDataAccessor.WithDatabaseTransaction(tx).UpdateCategory(ctx, category); err != nil {
+			return err
+		}
+
+		// The download tasks of the category follow its priority, as if they were given it again.
+		if category.Priority == managedCategory.Priority {
+			return nil
+		}
+
+		return c.downloadTaskDataAccessor.WithDatabaseTransaction(tx).UpdateDownloadTaskPriorityOfCategory(
+			ctx,
+			category.CategoryID,
+			category.Priority,
+		)
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrCategoryAlreadyExists) {
+			return CategoryOutput{}, status.Error(codes.AlreadyExists, "category name already exists in the workspace")
+		}
+
+		logger.With(zap.Error(err)).Error("failed to update category")
+		return CategoryOutput{}, status.Error(codes.Internal, "failed to update category")
+	}
+
+	return CategoryOutput{Category: databaseCategoryToProtoCategory(category)}, nil
+}
+
+// DeleteCategory implements CategoryLogic.
+func (c *categoryLogic) DeleteCategory(ctx context.Context, in DeleteCategoryInput) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("category_id", in.CategoryID))
+
+	category, err := c.getManagedCategory(ctx, in.Token, in.CategoryID)
+	if err != nil {
+		return err
+	}
+
+	err = c.database.Transaction(func(tx *gorm.DB) error {
+		// The download tasks left without category get back the default priority.
+		err := c.downloadTaskDataAccessor.WithDatabaseTransaction(tx).UpdateDownloadTaskPriorityOfCategory(ctx, category.CategoryID, 0)
+		if err != nil {
+			return err
+		}
+
+		return c.categoryDataAccessor.WithDatabaseTransaction(tx).DeleteCategory(ctx, category.CategoryID)
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete category")
+		return status.Error(codes.Internal, "failed to delete category")
+	}
+
+	return nil
+}
+
+// getManagedCategory returns the category if the account of the token manages its workspace.
+func (c *categoryLogic) getManagedCategory(ctx context.Context, token string, categoryID uint64) (database.Category, error) {
+	accountID, err := getAccountIDWithScope(ctx, c.tokenLogic, c.logger, token, APIKeyScopeTasksWrite)
+	if err != nil {
+		return database.Category{}, err
+	}
+
+	category, err := c.categoryDataAccessor.GetCategory(ctx, categoryID)
+	if err != nil {
+		if errors.Is(err, database.ErrCategoryNotFound) {
+			return database.Category{}, status.Error(codes.NotFound, "category not found")
+		}
+
+		return database.Category{}, status.Error(codes.Internal, "failed to get category")
+	}
+
+	_, err = c.workspaceLogic.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: category.OfWorkspaceID,
+		Role:        idm.WorkspaceRole_Manager,
+	})
+	if err != nil {
+		return database.Category{}, err
+	}
+
+	return category, nil
+}
+
+func protoCategoryToDatabaseCategory(category *idm.Category) (database.Category, error) {
+	contentTypeList, err := json.Marshal(append([]string{}, category.GetContentTypeList()...))
+	if err != nil {
+		return database.Category{}, err
+	}
+
+	fileExtensionList, err := json.Marshal(append([]string{}, category.GetFileExtensionList()...))
+	if err != nil {
+		return database.Category{}, err
+	}
+
+	return database.Category{
+		Name:              category.GetName(),
+		StoragePrefix:     category.GetStoragePrefix(),
+		Compression:       uint16(category.GetCompression()),
+		Priority:          category.GetPriority(),
+		ContentTypeList:   string(contentTypeList),
+		FileExtensionList: string(fileExtensionList),
+	}, nil
+}
+
+func databaseCategoryToProtoCategory(category database.Category) *idm.Category {
+	return &idm.Category{
+		Id:                category.CategoryID,
+		OfWorkspaceId:     category.OfWorkspaceID,
+		Name:              category.Name,
+		StoragePrefix:     category.StoragePrefix,
+		Compression:       idm.CategoryCompression(category.Compression),
+		Priority:          category.Priority,
+		ContentTypeList:   parseCategoryStringList(category.ContentTypeList),
+		FileExtensionList: parseCategoryStringList(category.FileExtensionList),
+	}
+}
+
+// parseCategoryStringList returns nothing for lists that can not be parsed, so that they match no file.
+func parseCategoryStringList(stringList string) []string {
+	var parsedStringList []string
+	if err := json.Unmarshal([]byte(stringList), &parsedStringList); err != nil {
+		return nil
+	}
+
+	return parsedStringList
+}
+
+// matchCategory returns the first category whose content types or file extensions match a downloaded file.
+func matchCategory(categories []database.Category, contentType, fileName string) (database.Category, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = ""
+	}
+
+	fileExtension := strings.ToLower(strings.TrimPrefix(path.Ext(fileName), "."))
+	for _, category := range categories {
+		if fileExtension != "" && slices.Contains(parseCategoryStringList(category.FileExtensionList), fileExtension) {
+			return category, true
+		}
+
+		if mediaType == "" {
+			continue
+		}
+
+		for _, categoryContentType := range parseCategoryStringList(category.ContentTypeList) {
+			if categoryContentType == mediaType {
+				return category, true
+			}
+
+			if prefix, ok := strings.CutSuffix(categoryContentType, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+				return category, true
+			}
+		}
+	}
+
+	return database.Category{}, false
+}
+
+// getCategoryCodec returns the codec a downloaded file of the category is compressed with, files without
+// category follow the compression rules of the server.
+func getCategoryCodec(category *database.Category, codecSelector file.CodecSelector, contentType string) file.Codec {
+	if category == nil {
+		return codecSelector.GetCodec(contentType)
+	}
+
+	switch idm.CategoryCompression(category.Compression) {
+	case idm.CategoryCompression_CategoryCompressionNone:
+		return file.CodecNone
+	case idm.CategoryCompression_CategoryCompressionGzip:
+		return file.CodecGzip
+	case idm.CategoryCompression_CategoryCompressionZstd:
+		return file.CodecZstd
+	default:
+		return codecSelector.GetCodec(contentType)
+	}
+}
@@ -0,0 +1,47 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+)
+
+func TestMatchCategory(t *testing.T) {
+	categories := []database.Category{
+		{CategoryID: 1, ContentTypeList: `["application/pdf"]`, FileExtensionList: `["epub"]`},
+		{CategoryID: 2, ContentTypeList: `["video/*"]`, FileExtensionList: `[]`},
+		{CategoryID: 3, ContentTypeList: `not json`, FileExtensionList: `["mkv","zip"]`},
+	}
+
+	testCases := []struct {
+		name               string
+		contentType        string
+		fileName           string
+		expectedCategoryID uint64
+		expectMatch        bool
+	}{
+		{name: "content type", contentType: "application/pdf", fileName: "file", expectedCategoryID: 1, expectMatch: true},
+		{name: "content type with parameters", contentType: "Application/PDF; charset=binary", fileName: "file", expectedCategoryID: 1, expectMatch: true},
+		{name: "content type wildcard", contentType: "video/mp4", fileName: "file", expectedCategoryID: 2, expectMatch: true},
+		{name: "wildcard only matches its type", contentType: "videos/mp4", fileName: "file", expectMatch: false},
+		{name: "file extension", contentType: "", fileName: "book.EPUB", expectedCategoryID: 1, expectMatch: true},
+		{name: "first matching category", contentType: "video/x-matroska", fileName: "movie.mkv", expectedCategoryID: 2, expectMatch: true},
+		{name: "invalid content type list", contentType: "application/zip", fileName: "file", expectMatch: false},
+		{name: "file extension of invalid content type list", contentType: "application/zip", fileName: "archive.zip", expectedCategoryID: 3, expectMatch: true},
+		{name: "invalid content type", contentType: "not a content type", fileName: "file", expectMatch: false},
+		{name: "no match", contentType: "text/plain", fileName: "notes.txt", expectMatch: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			category, ok := matchCategory(categories, testCase.contentType, testCase.fileName)
+			if ok != testCase.expectMatch {
+				t.Fatalf("expected match %t, got %t", testCase.expectMatch, ok)
+			}
+
+			if category.CategoryID != testCase.expectedCategoryID {
+				t.Errorf("expected category %d, got %d", testCase.expectedCategoryID, category.CategoryID)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+)
+
+// DownloadStatusTrigger is who moves a download task from one status to another.
+type DownloadStatusTrigger uint8
+
+const (
+	// DownloadStatusTriggerUser is an account updating or cancelling one of its download tasks.
+	DownloadStatusTriggerUser DownloadStatusTrigger = iota
+	// DownloadStatusTriggerWorker is the worker executing a download task.
+	DownloadStatusTriggerWorker
+	// DownloadStatusTriggerSystem is the server creating download tasks and its cron jobs.
+	DownloadStatusTriggerSystem
+	// DownloadStatusTriggerAdmin is an admin account cancelling any download task, downloading ones included.
+	DownloadStatusTriggerAdmin
+)
+
+var (
+	errIllegalDownloadStatusTransition = errors.New("illegal download status transition")
+)
+
+type downloadStatusTransition struct {
+	from idm.DownloadStatus
+	to   idm.DownloadStatus
+}
+
+// downloadStatusTransitionTriggers lists every allowed change of the status of a download task and who may
+// make it. Downloading tasks can only be finished by their worker or cancelled by an admin, whose cancellation the
+// worker notices at its next progress update. Successful ones are never changed again.
+var downloadStatusTransitionTriggers = map[downloadStatusTransition][]DownloadStatusTrigger{
+	{idm.DownloadStatus_UndefinedStatus, idm.DownloadStatus_Pending}: {DownloadStatusTriggerSystem},
+	{idm.DownloadStatus_Pending, idm.DownloadStatus_Downloading}:     {DownloadStatusTriggerWorker},
+	{idm.DownloadStatus_Pending, idm.DownloadStatus_Cancelled}:       {DownloadStatusTriggerUser, DownloadStatusTriggerAdmin},
+	{idm.DownloadStatus_Downloading, idm.DownloadStatus_Success}:     {DownloadStatusTriggerWorker},
+	{idm.DownloadStatus_Downloading, idm.DownloadStatus_Failed}:      {DownloadStatusTriggerWorker},
+	{idm.DownloadStatus_Downloading, idm.DownloadStatus_Cancelled}:   {DownloadStatusTriggerAdmin},
+	{idm.DownloadStatus_Failed, idm.DownloadStatus_Pending}:          {DownloadStatusTriggerUser, DownloadStatusTriggerSystem},
+	{idm.DownloadStatus_Failed, idm.DownloadStatus_Cancelled}:        {DownloadStatusTriggerUser, DownloadStatusTriggerAdmin},
+	{idm.DownloadStatus_Cancelled, idm.DownloadStatus_Pending}:       {DownloadStatusTriggerUser},
+}
+
+// checkDownloadStatusTransition returns an error wrapping errIllegalDownloadStatusTransition if trigger may not
+// move a download task from one status to the other.
+func checkDownloadStatusTransition(from, to uint16, trigger DownloadStatusTrigger) error {
+	triggers := downloadStatusTransitionTriggers[downloadStatusTransition{
+		from: idm.DownloadStatus(from),
+		to:   idm.DownloadStatus(to),
+	}]
+	if !slices.Contains(triggers, trigger) {
+		return fmt.Errorf(
+			"%w: download task can not move from %s to %s",
+			errIllegalDownloadStatusTransition,
+			idm.DownloadStatus(from),
+			idm.DownloadStatus(to),
+		)
+	}
+
+	return nil
+}
+
+// getDownloadStatusListTransitionableTo returns the statuses trigger may move download tasks from to the given
+// status, sorted.
+func getDownloadStatusListTransitionableTo(to uint16, trigger DownloadStatusTrigger) []uint16 {
+	var downloadStatusList []uint16
+	for transition, triggers := range downloadStatusTransitionTriggers {
+		if transition.to == idm.DownloadStatus(to) && slices.Contains(triggers, trigger) {
+			downloadStatusList = append(downloadStatusList, uint16(transition.from))
+		}
+	}
+
+	slices.Sort(downloadStatusList)
+	return downloadStatusList
+}
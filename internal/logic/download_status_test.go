@@ -0,0 +1,95 @@
+package logic
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+)
+
+func TestCheckDownloadStatusTransition(t *testing.T) {
+	testCases := []struct {
+		from      idm.DownloadStatus
+		to        idm.DownloadStatus
+		trigger   DownloadStatusTrigger
+		expectErr bool
+	}{
+		{from: idm.DownloadStatus_UndefinedStatus, to: idm.DownloadStatus_Pending, trigger: DownloadStatusTriggerSystem},
+		{from: idm.DownloadStatus_UndefinedStatus, to: idm.DownloadStatus_Pending, trigger: DownloadStatusTriggerUser, expectErr: true},
+		{from: idm.DownloadStatus_Pending, to: idm.DownloadStatus_Downloading, trigger: DownloadStatusTriggerWorker},
+		{from: idm.DownloadStatus_Pending, to: idm.DownloadStatus_Downloading, trigger: DownloadStatusTriggerUser, expectErr: true},
+		{from: idm.DownloadStatus_Pending, to: idm.DownloadStatus_Cancelled, trigger: DownloadStatusTriggerUser},
+		{from: idm.DownloadStatus_Downloading, to: idm.DownloadStatus_Success, trigger: DownloadStatusTriggerWorker},
+		{from: idm.DownloadStatus_Downloading, to: idm.DownloadStatus_Failed, trigger: DownloadStatusTriggerWorker},
+		{from: idm.DownloadStatus_Downloading, to: idm.DownloadStatus_Success, trigger: DownloadStatusTriggerUser, expectErr: true},
+		{from: idm.DownloadStatus_Downloading, to: idm.DownloadStatus_Cancelled, trigger: DownloadStatusTriggerUser, expectErr: true},
+		{from: idm.DownloadStatus_Downloading, to: idm.DownloadStatus_Cancelled, trigger: DownloadStatusTriggerAdmin},
+		{from: idm.DownloadStatus_Downloading, to: idm.DownloadStatus_Success, trigger: DownloadStatusTriggerAdmin, expectErr: true},
+		{from: idm.DownloadStatus_Downloading, to: idm.DownloadStatus_Pending, trigger: DownloadStatusTriggerSystem, expectErr: true},
+		{from: idm.DownloadStatus_Failed, to: idm.DownloadStatus_Pending, trigger: DownloadStatusTriggerUser},
+		{from: idm.DownloadStatus_Failed, to: idm.DownloadStatus_Pending, trigger: DownloadStatusTriggerSystem},
+		{from: idm.DownloadStatus_Failed, to: idm.DownloadStatus_Cancelled, trigger: DownloadStatusTriggerUser},
+		{from: idm.DownloadStatus_Cancelled, to: idm.DownloadStatus_Pending, trigger: DownloadStatusTriggerUser},
+		{from: idm.DownloadStatus_Cancelled, to: idm.DownloadStatus_Pending, trigger: DownloadStatusTriggerSystem, expectErr: true},
+		{from: idm.DownloadStatus_Success, to: idm.DownloadStatus_Pending, trigger: DownloadStatusTriggerUser, expectErr: true},
+		{from: idm.DownloadStatus_Success, to: idm.DownloadStatus_Cancelled, trigger: DownloadStatusTriggerUser, expectErr: true},
+		{from: idm.DownloadStatus_Success, to: idm.DownloadStatus_Cancelled, trigger: DownloadStatusTriggerAdmin, expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.from.String()+"_to_"+testCase.to.String(), func(t *testing.T) {
+			err := checkDownloadStatusTransition(uint16(testCase.from), uint16(testCase.to), testCase.trigger)
+			if (err != nil) != testCase.expectErr {
+				t.Fatalf("expected error %t, got %v", testCase.expectErr, err)
+			}
+
+			if err != nil && !errors.Is(err, errIllegalDownloadStatusTransition) {
+				t.Errorf("expected %v, got %v", errIllegalDownloadStatusTransition, err)
+			}
+		})
+	}
+}
+
+func TestGetDownloadStatusListTransitionableTo(t *testing.T) {
+	testCases := []struct {
+		name     string
+		to       idm.DownloadStatus
+		trigger  DownloadStatusTrigger
+		expected []uint16
+	}{
+		{
+			name:     "retried by system",
+			to:       idm.DownloadStatus_Pending,
+			trigger:  DownloadStatusTriggerSystem,
+			expected: []uint16{uint16(idm.DownloadStatus_UndefinedStatus), uint16(idm.DownloadStatus_Failed)},
+		},
+		{
+			name:     "retried by user",
+			to:       idm.DownloadStatus_Pending,
+			trigger:  DownloadStatusTriggerUser,
+			expected: []uint16{uint16(idm.DownloadStatus_Failed), uint16(idm.DownloadStatus_Cancelled)},
+		},
+		{
+			name:     "cancelled by admin",
+			to:       idm.DownloadStatus_Cancelled,
+			trigger:  DownloadStatusTriggerAdmin,
+			expected: []uint16{uint16(idm.DownloadStatus_Pending), uint16(idm.DownloadStatus_Downloading), uint16(idm.DownloadStatus_Failed)},
+		},
+		{
+			name:     "succeeded by user",
+			to:       idm.DownloadStatus_Success,
+			trigger:  DownloadStatusTriggerUser,
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			downloadStatusList := getDownloadStatusListTransitionableTo(uint16(testCase.to), testCase.trigger)
+			if !slices.Equal(downloadStatusList, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, downloadStatusList)
+			}
+		})
+	}
+}
@@ -1,10 +1,22 @@
 package logic
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/url"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gammazero/workerpool"
 	"github.com/maxuanquang/idm/internal/configs"
@@ -16,48 +28,91 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
 const (
-	DownloadTaskMetadataKeyFileName = "file-name"
+	DownloadTaskMetadataKeyStorageTier = "storage-tier"
+
+	downloadTaskEventReasonMaxLength = 1024
+	// downloadProgressUpdateInterval is how often the downloaded size of a downloading task is saved.
+	downloadProgressUpdateInterval = 5 * time.Second
 )
 
 var (
 	ErrPermissionDenied         = status.Error(codes.PermissionDenied, "permission denied")
 	ErrDownloadTaskNotCompleted = status.Error(codes.FailedPrecondition, "download task not completed")
+
+	errTagNotInWorkspace      = errors.New("tag not found in the workspace of the download task")
+	errCategoryNotInWorkspace = errors.New("category not found in the workspace of the download task")
+
+	errDownloadTaskNoLongerDownloading = errors.New("download task is no longer downloading")
 )
 
 type CreateDownloadTaskInput struct {
 	Token string
-	Type  idm.DownloadType
-	URL   string
+	// WorkspaceID is 0 for the personal workspace of the account.
+	WorkspaceID uint64
+	Type        idm.DownloadType
+	URL         string
+	TagIDList   []uint64
+	// CategoryID is 0 to leave the download task without category.
+	CategoryID uint64
 }
 
 type CreateDownloadTaskOutput struct {
-	DownloadTask idm.DownloadTask
+	DownloadTask *idm.DownloadTask
 }
 
 type GetDownloadTaskListInput struct {
-	Token  string
-	Offset uint64
-	Limit  uint64
+	Token string
+	// WorkspaceID is 0 for the personal workspace of the account.
+	WorkspaceID uint64
+	Offset      uint64
+	Limit       uint64
+	// The fields below narrow down the list, their zero values do not filter.
+	DownloadStatusList []idm.DownloadStatus
+	DownloadTypeList   []idm.DownloadType
+	URLSubstring       string
+	URLHost            string
+	CreateTimeFrom     time.Time
+	CreateTimeTo       time.Time
+	UpdateTimeFrom     time.Time
+	UpdateTimeTo       time.Time
+	SearchQuery        string
+	SortField          idm.DownloadTaskListSortField
+	SortDescending     bool
+	// PageToken is the NextPageToken of the previous page, the offset is then counted from it.
+	PageToken                  string
+	SkipTotalDownloadTaskCount bool
+	TagIDList                  []uint64
+	CategoryIDList             []uint64
 }
 
 type GetDownloadTaskListOutput struct {
 	DownloadTaskList       []*idm.DownloadTask
 	TotalDownloadTaskCount uint64
+	// NextPageToken is empty on the last page.
+	NextPageToken string
 }
 
 type UpdateDownloadTaskInput struct {
 	Token          string
 	DownloadTaskID uint64
 	DownloadStatus uint16
-	Metadata       string
+	// SetLabels are added or overwritten before DeleteLabelKeys are removed.
+	SetLabels       map[string]string
+	DeleteLabelKeys []string
+	// AddTagIDList are set before RemoveTagIDList are unset.
+	AddTagIDList    []uint64
+	RemoveTagIDList []uint64
+	// CategoryID is nil to keep the category, 0 to unset it.
+	CategoryID *uint64
 }
 
 type UpdateDownloadTaskOutput struct {
-	DownloadTask idm.DownloadTask
+	DownloadTask *idm.DownloadTask
 }
 
 type DeleteDownloadTaskInput struct {
@@ -65,6 +120,16 @@ type DeleteDownloadTaskInput struct {
 	DownloadTaskID uint64
 }
 
+type GetDownloadTaskHistoryInput struct {
+	Token          string
+	DownloadTaskID uint64
+}
+
+type GetDownloadTaskHistoryOutput struct {
+	// DownloadTaskEventList is ordered oldest first.
+	DownloadTaskEventList []*idm.DownloadTaskEvent
+}
+
 type ExecuteDownloadTaskInput struct {
 	DownloadTaskID uint64
 }
@@ -76,6 +141,34 @@ type GetDownloadTaskFileInput struct {
 
 type GetDownloadTaskFileOutput struct {
 	Reader io.ReadCloser
+	// ContentEncoding is the content coding Reader is compressed with, empty if it is decompressed.
+	ContentEncoding string
+}
+
+type GetDownloadTaskFileInfoInput struct {
+	Token          string
+	DownloadTaskID uint64
+	// AcceptedEncodings lists the content codings the client can decode, the file is served
+	// as stored if it is compressed with one of them.
+	AcceptedEncodings []string
+}
+
+type GetDownloadTaskFileInfoOutput struct {
+	FileName    string
+	ContentType string
+	// ContentEncoding is the content coding the file is served with, empty if it is served decompressed.
+	ContentEncoding string
+	// Size is the size of the file as served, that is compressed if ContentEncoding is set and decompressed
+	// otherwise.
+	Size    int64
+	ModTime time.Time
+}
+
+type OpenDownloadTaskFileInput struct {
+	DownloadTaskID uint64
+	// AcceptedEncodings has to be the same as the one of GetDownloadTaskFileInfoInput for the file to be
+	// served with the content coding and size returned by GetDownloadTaskFileInfo.
+	AcceptedEncodings []string
 }
 
 type DownloadTaskLogic interface {
@@ -84,54 +177,81 @@ type DownloadTaskLogic interface {
 	UpdateDownloadTask(ctx context.Context, in UpdateDownloadTaskInput) (UpdateDownloadTaskOutput, error)
 	UpdateFailedDownloadTaskStatusToPending(ctx context.Context) error
 	DeleteDownloadTask(ctx context.Context, in DeleteDownloadTaskInput) error
+	// GetDownloadTaskHistory returns the changes of the status of a download task.
+	GetDownloadTaskHistory(ctx context.Context, in GetDownloadTaskHistoryInput) (GetDownloadTaskHistoryOutput, error)
 
 	ExecuteDownloadTask(ctx context.Context, in ExecuteDownloadTaskInput) error
 	ExecuteAllPendingDownloadTask(ctx context.Context) error
+	MoveAllDownloadTaskFileToColdTier(ctx context.Context) error
+	RewrapAllDownloadTaskFileKey(ctx context.Context) error
 
 	GetDownloadTaskFile(ctx context.Context, in GetDownloadTaskFileInput) (GetDownloadTaskFileOutput, error)
+	GetDownloadTaskFileInfo(ctx context.Context, in GetDownloadTaskFileInfoInput) (GetDownloadTaskFileInfoOutput, error)
+	// OpenDownloadTaskFile reads the file of a completed download task without checking who owns it.
+	OpenDownloadTaskFile(ctx context.Context, in OpenDownloadTaskFileInput) (GetDownloadTaskFileOutput, error)
 }
 
 func NewDownloadTaskLogic(
 	tokenLogic TokenLogic,
+	workspaceLogic WorkspaceLogic,
 	accountDataAccessor database.AccountDataAccessor,
 	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
-	downloadTaskCreatedProducer producer.DownloadTaskCreatedProducer,
-	fileClient file.Client,
+	downloadTaskEventDataAccessor database.DownloadTaskEventDataAccessor,
+	tagDataAccessor database.TagDataAccessor,
+	categoryDataAccessor database.CategoryDataAccessor,
+	webhookDataAccessor database.WebhookDataAccessor,
+	webhookDeliveryDataAccessor database.WebhookDeliveryDataAccessor,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	fileClient file.TieredClient,
+	codecSelector file.CodecSelector,
 	database database.Database,
 	logger *zap.Logger,
 	cronConfig configs.Cron,
 ) (DownloadTaskLogic, error) {
 	return &downloadTaskLogic{
-		tokenLogic:                  tokenLogic,
-		accountDataAccessor:         accountDataAccessor,
-		downloadTaskDataAccessor:    downloadTaskDataAccessor,
-		downloadTaskCreatedProducer: downloadTaskCreatedProducer,
-		fileClient:                  fileClient,
-		database:                    database,
-		logger:                      logger,
-		cronConfig:                  cronConfig,
+		tokenLogic:                    tokenLogic,
+		workspaceLogic:                workspaceLogic,
+		accountDataAccessor:           accountDataAccessor,
+		downloadTaskDataAccessor:      downloadTaskDataAccessor,
+		downloadTaskEventDataAccessor: downloadTaskEventDataAccessor,
+		tagDataAccessor:               tagDataAccessor,
+		categoryDataAccessor:          categoryDataAccessor,
+		webhookDataAccessor:           webhookDataAccessor,
+		webhookDeliveryDataAccessor:   webhookDeliveryDataAccessor,
+		outboxMessageDataAccessor:     outboxMessageDataAccessor,
+		fileClient:                    fileClient,
+		codecSelector:                 codecSelector,
+		database:                      database,
+		logger:                        logger,
+		cronConfig:                    cronConfig,
 	}, nil
 }
 
 type downloadTaskLogic struct {
-	tokenLogic                  TokenLogic
-	accountDataAccessor         database.AccountDataAccessor
-	downloadTaskDataAccessor    database.DownloadTaskDataAccessor
-	downloadTaskCreatedProducer producer.DownloadTaskCreatedProducer
-	fileClient                  file.Client
-	database                    database.Database
-	logger                      *zap.Logger
-	cronConfig                  configs.Cron
+	tokenLogic                    TokenLogic
+	workspaceLogic                WorkspaceLogic
+	accountDataAccessor           database.AccountDataAccessor
+	downloadTaskDataAccessor      database.DownloadTaskDataAccessor
+	downloadTaskEventDataAccessor database.DownloadTaskEventDataAccessor
+	tagDataAccessor               database.TagDataAccessor
+	categoryDataAccessor          database.CategoryDataAccessor
+	webhookDataAccessor           database.WebhookDataAccessor
+	webhookDeliveryDataAccessor   database.WebhookDeliveryDataAccessor
+	outboxMessageDataAccessor     database.OutboxMessageDataAccessor
+	fileClient                    file.TieredClient
+	codecSelector                 file.CodecSelector
+	database                      database.Database
+	logger                        *zap.Logger
+	cronConfig                    configs.Cron
 }
 
 // CreateDownloadTask implements DownloadTaskLogic.
 func (d *downloadTaskLogic) CreateDownloadTask(ctx context.Context, in CreateDownloadTaskInput) (CreateDownloadTaskOutput, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("create_download_task_input", in))
 
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	accountID, err := d.getAccountIDWithScope(ctx, in.Token, APIKeyScopeTasksWrite)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
-		return CreateDownloadTaskOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+		return CreateDownloadTaskOutput{}, err
 	}
 
 	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
@@ -140,25 +260,93 @@ func (d *downloadTaskLogic) CreateDownloadTask(ctx context.Context, in CreateDow
 		return CreateDownloadTaskOutput{}, status.Error(codes.NotFound, "account not found")
 	}
 
+	workspaceID, err := d.workspaceLogic.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: in.WorkspaceID,
+		Role:        idm.WorkspaceRole_Downloader,
+	})
+	if err != nil {
+		return CreateDownloadTaskOutput{}, err
+	}
+
+	downloadTask := database.DownloadTask{
+		OfAccountID:    accountID,
+		OfWorkspaceID:  workspaceID,
+		DownloadType:   uint16(in.Type),
+		DownloadURL:    in.URL,
+		DownloadStatus: uint16(idm.DownloadStatus_Pending),
+		Metadata:       "{}",
+		Labels:         "{}",
+	}
+	if in.CategoryID != 0 {
+		category, err := d.getCategoryOfWorkspace(ctx, workspaceID, in.CategoryID)
+		if err != nil {
+			return CreateDownloadTaskOutput{}, downloadTaskTagOrCategoryError(err)
+		}
+
+		downloadTask.OfCategoryID = &category.CategoryID
+		downloadTask.Priority = category.Priority
+	}
+
+	if err = d.checkTagListOfWorkspace(ctx, workspaceID, in.TagIDList); err != nil {
+		return CreateDownloadTaskOutput{}, downloadTaskTagOrCategoryError(err)
+	}
+
+	tagIDList := slices.Clone(in.TagIDList)
+	slices.Sort(tagIDList)
+	tagIDList = slices.Compact(tagIDList)
+
 	var createdDownloadTask database.DownloadTask
 	txErr := d.database.Transaction(func(tx *gorm.DB) error {
 		var err error
 
-		createdDownloadTask, err = d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).CreateDownloadTask(ctx, database.DownloadTask{
-			OfAccountID:    accountID,
-			DownloadType:   uint16(in.Type),
-			DownloadURL:    in.URL,
-			DownloadStatus: uint16(idm.DownloadStatus_Pending),
-			Metadata:       "{}",
-		})
+		createdDownloadTask, err = d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).CreateDownloadTask(ctx, downloadTask)
 		if err != nil {
 			logger.With(zap.Error(err)).Error("failed to create download task")
 			return err
 		}
 
-		err = d.downloadTaskCreatedProducer.Produce(ctx, createdDownloadTask.DownloadTaskID)
+		err = d.tagDataAccessor.WithDatabaseTransaction(tx).AddDownloadTaskTagList(ctx, createdDownloadTask.DownloadTaskID, tagIDList)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to set download task tags")
+			return err
+		}
+
+		downloadTaskEventList := []database.DownloadTaskEvent{
+			newDownloadTaskEvent(
+				createdDownloadTask.DownloadTaskID,
+				uint16(idm.DownloadStatus_UndefinedStatus),
+				createdDownloadTask.DownloadStatus,
+				fmt.Sprintf("created by account %d", accountID),
+			),
+		}
+		err = d.downloadTaskEventDataAccessor.WithDatabaseTransaction(tx).CreateDownloadTaskEventList(ctx, downloadTaskEventList)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to create download task event")
+			return err
+		}
+
+		err = d.createDownloadTaskLifecycleEventList(ctx, tx, downloadTaskEventList)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to create download task lifecycle event")
+			return err
+		}
+
+		payload, err := producer.NewDownloadTaskCreatedPayload(createdDownloadTask.DownloadTaskID)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to marshal event download task created")
+			return err
+		}
+
+		err = createOutboxMessage(
+			ctx,
+			d.outboxMessageDataAccessor.WithDatabaseTransaction(tx),
+			producer.MessageQueueDownloadTaskCreated,
+			producer.GetDownloadTaskMessageKey(createdDownloadTask.DownloadTaskID),
+			payload,
+		)
 		if err != nil {
-			logger.With(zap.Error(err)).Error("failed to produce message download task created")
+			logger.With(zap.Error(err)).Error("failed to create outbox message download task created")
 			return err
 		}
 
@@ -169,17 +357,7 @@ func (d *downloadTaskLogic) CreateDownloadTask(ctx context.Context, in CreateDow
 	}
 
 	return CreateDownloadTaskOutput{
-		DownloadTask: idm.DownloadTask{
-			Id: createdDownloadTask.DownloadTaskID,
-			OfAccount: &idm.Account{
-				Id:          account.AccountID,
-				AccountName: account.AccountName,
-			},
-			DownloadType:   idm.DownloadType(createdDownloadTask.DownloadType),
-			Url:            createdDownloadTask.DownloadURL,
-			DownloadStatus: idm.DownloadStatus(createdDownloadTask.DownloadStatus),
-			Metadata:       createdDownloadTask.Metadata,
-		},
+		DownloadTask: databaseDownloadTaskToProtoDownloadTask(createdDownloadTask, account, tagIDList),
 	}, nil
 }
 
@@ -187,50 +365,81 @@ func (d *downloadTaskLogic) CreateDownloadTask(ctx context.Context, in CreateDow
 func (d *downloadTaskLogic) GetDownloadTaskList(ctx context.Context, in GetDownloadTaskListInput) (GetDownloadTaskListOutput, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("get_download_task_list_input", in))
 
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	accountID, err := d.getAccountIDWithScope(ctx, in.Token, APIKeyScopeTasksRead)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
-		return GetDownloadTaskListOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+		return GetDownloadTaskListOutput{}, err
 	}
 
-	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
+	workspaceID, err := d.workspaceLogic.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: in.WorkspaceID,
+		Role:        idm.WorkspaceRole_Viewer,
+	})
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get account from database")
-		return GetDownloadTaskListOutput{}, status.Error(codes.NotFound, "account not found")
+		return GetDownloadTaskListOutput{}, err
+	}
+
+	sort, err := getDownloadTaskListSort(in.SortField, in.SortDescending)
+	if err != nil {
+		return GetDownloadTaskListOutput{}, err
+	}
+
+	var after *database.DownloadTaskListCursor
+	if in.PageToken != "" {
+		cursor, err := decodeDownloadTaskListPageToken(in.PageToken, in.SortField, in.SortDescending)
+		if err != nil {
+			return GetDownloadTaskListOutput{}, err
+		}
+
+		after = &cursor
 	}
 
-	// Get the list of download tasks for the account from the data accessor.
-	downloadTasks, err := d.downloadTaskDataAccessor.GetDownloadTaskListOfAccount(ctx, accountID, in.Offset, in.Limit)
+	filter := getDownloadTaskListFilter(in)
+
+	// Get the list of download tasks of the workspace from the data accessor, with one more download task
+	// telling whether there is a next page.
+	downloadTasks, err := d.downloadTaskDataAccessor.GetDownloadTaskListOfWorkspace(ctx, workspaceID, filter, sort, after, in.Offset, in.Limit+1)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get download task list from data accessor")
 		return GetDownloadTaskListOutput{}, status.Error(codes.Internal, "failed to get download task list")
 	}
 
-	// Get the total count of download tasks for the account from the data accessor.
-	totalDownloadTaskCount, err := d.downloadTaskDataAccessor.GetDownloadTaskCountOfAccount(ctx, accountID)
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get total download task count from data accessor")
-		return GetDownloadTaskListOutput{}, status.Error(codes.Internal, "failed to get total download task count")
+	var nextPageToken string
+	if uint64(len(downloadTasks)) > in.Limit {
+		downloadTasks = downloadTasks[:in.Limit]
+		if len(downloadTasks) > 0 {
+			nextPageToken, err = encodeDownloadTaskListPageToken(
+				database.NewDownloadTaskListCursor(downloadTasks[len(downloadTasks)-1]),
+				in.SortField,
+				in.SortDescending,
+			)
+			if err != nil {
+				logger.With(zap.Error(err)).Error("failed to encode next page token")
+				return GetDownloadTaskListOutput{}, status.Error(codes.Internal, "failed to get download task list")
+			}
+		}
 	}
 
-	// Construct the output with the retrieved download tasks and total count.
-	var outTaskList []*idm.DownloadTask
-	for _, task := range downloadTasks {
-		outTaskList = append(outTaskList, &idm.DownloadTask{
-			Id: task.DownloadTaskID,
-			OfAccount: &idm.Account{
-				Id:          account.AccountID,
-				AccountName: account.AccountName,
-			},
-			DownloadType:   idm.DownloadType(task.DownloadType),
-			Url:            task.DownloadURL,
-			DownloadStatus: idm.DownloadStatus(task.DownloadStatus),
-			Metadata:       task.Metadata,
-		})
+	// Get the total count of download tasks of the workspace from the data accessor.
+	var totalDownloadTaskCount uint64
+	if !in.SkipTotalDownloadTaskCount {
+		totalDownloadTaskCount, err = d.downloadTaskDataAccessor.GetDownloadTaskCountOfWorkspace(ctx, workspaceID, filter)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to get total download task count from data accessor")
+			return GetDownloadTaskListOutput{}, status.Error(codes.Internal, "failed to get total download task count")
+		}
+	}
+
+	outTaskList, err := databaseDownloadTaskListToProtoDownloadTaskList(ctx, d.accountDataAccessor, d.tagDataAccessor, downloadTasks)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get accounts of download tasks")
+		return GetDownloadTaskListOutput{}, status.Error(codes.Internal, "failed to get download task list")
 	}
+
 	output := GetDownloadTaskListOutput{
 		DownloadTaskList:       outTaskList,
 		TotalDownloadTaskCount: totalDownloadTaskCount,
+		NextPageToken:          nextPageToken,
 	}
 
 	return output, nil
@@ -240,16 +449,9 @@ func (d *downloadTaskLogic) GetDownloadTaskList(ctx context.Context, in GetDownl
 func (d *downloadTaskLogic) UpdateDownloadTask(ctx context.Context, in UpdateDownloadTaskInput) (UpdateDownloadTaskOutput, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("update_download_task_input", in))
 
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
-		return UpdateDownloadTaskOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
-	}
-
-	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
+	accountID, err := d.getAccountIDWithScope(ctx, in.Token, APIKeyScopeTasksWrite)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get account from database")
-		return UpdateDownloadTaskOutput{}, status.Error(codes.NotFound, "account not found")
+		return UpdateDownloadTaskOutput{}, err
 	}
 
 	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, in.DownloadTaskID)
@@ -258,60 +460,165 @@ func (d *downloadTaskLogic) UpdateDownloadTask(ctx context.Context, in UpdateDow
 		return UpdateDownloadTaskOutput{}, status.Error(codes.NotFound, "download task not found")
 	}
 
-	if accountID != downloadTask.OfAccountID {
-		return UpdateDownloadTaskOutput{}, status.Error(codes.PermissionDenied, "user do not have permission to update download task")
+	if err = d.checkDownloadTaskWorkspaceRole(ctx, accountID, downloadTask, idm.WorkspaceRole_Manager); err != nil {
+		return UpdateDownloadTaskOutput{}, err
+	}
+
+	account, err := d.accountDataAccessor.GetAccountByID(ctx, downloadTask.OfAccountID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account from database")
+		return UpdateDownloadTaskOutput{}, status.Error(codes.NotFound, "account not found")
 	}
 
 	// Implement the logic to update the download task based on the input parameters
-	var updatedTask database.DownloadTask
+	var (
+		updatedTask          database.DownloadTask
+		updatedTaskTagIDList []uint64
+	)
 	txErr := d.database.Transaction(func(tx *gorm.DB) error {
-		err = d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).UpdateDownloadTask(ctx, in.DownloadTaskID, in.DownloadStatus, in.Metadata)
+		lockedDownloadTask, err := d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).GetDownloadTaskForUpdate(ctx, in.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		downloadTaskEventList, err := updateDownloadTaskWithEvent(
+			ctx,
+			d.downloadTaskDataAccessor.WithDatabaseTransaction(tx),
+			d.downloadTaskEventDataAccessor.WithDatabaseTransaction(tx),
+			lockedDownloadTask,
+			in.DownloadStatus,
+			DownloadStatusTriggerUser,
+			"",
+			fmt.Sprintf("updated by account %d", accountID),
+		)
 		if err != nil {
 			logger.With(zap.Error(err)).Error("failed to update download task")
 			return err
 		}
 
-		updatedTask, err = d.downloadTaskDataAccessor.GetDownloadTaskForUpdate(ctx, in.DownloadTaskID)
+		if len(in.SetLabels) > 0 || len(in.DeleteLabelKeys) > 0 {
+			labels, err := getUpdatedDownloadTaskLabels(lockedDownloadTask.Labels, in.SetLabels, in.DeleteLabelKeys)
+			if err != nil {
+				return err
+			}
+
+			err = d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).UpdateDownloadTaskLabels(ctx, in.DownloadTaskID, labels)
+			if err != nil {
+				logger.With(zap.Error(err)).Error("failed to update download task labels")
+				return err
+			}
+		}
+
+		if in.CategoryID != nil {
+			var (
+				categoryID *uint64
+				priority   int32
+			)
+			if *in.CategoryID != 0 {
+				category, err := d.getCategoryOfWorkspace(ctx, lockedDownloadTask.OfWorkspaceID, *in.CategoryID)
+				if err != nil {
+					return err
+				}
+
+				categoryID = &category.CategoryID
+				priority = category.Priority
+			}
+
+			err = d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).UpdateDownloadTaskCategory(ctx, in.DownloadTaskID, categoryID, priority)
+			if err != nil {
+				logger.With(zap.Error(err)).Error("failed to update download task category")
+				return err
+			}
+		}
+
+		if err = d.checkTagListOfWorkspace(ctx, lockedDownloadTask.OfWorkspaceID, in.AddTagIDList); err != nil {
+			return err
+		}
+
+		err = d.tagDataAccessor.WithDatabaseTransaction(tx).AddDownloadTaskTagList(ctx, in.DownloadTaskID, in.AddTagIDList)
 		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to set download task tags")
 			return err
 		}
 
-		return nil
+		err = d.tagDataAccessor.WithDatabaseTransaction(tx).RemoveDownloadTaskTagList(ctx, in.DownloadTaskID, in.RemoveTagIDList)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to unset download task tags")
+			return err
+		}
+
+		updatedTask, err = d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).GetDownloadTaskForUpdate(ctx, in.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		tagIDListPerDownloadTask, err := d.tagDataAccessor.WithDatabaseTransaction(tx).GetTagIDListOfDownloadTaskList(ctx, []uint64{in.DownloadTaskID})
+		if err != nil {
+			return err
+		}
+
+		updatedTaskTagIDList = tagIDListPerDownloadTask[in.DownloadTaskID]
+
+		return d.createDownloadTaskLifecycleEventList(ctx, tx, downloadTaskEventList)
 	})
 	if txErr != nil {
+		if errors.Is(txErr, errTooManyDownloadTaskLabels) ||
+			errors.Is(txErr, errTagNotInWorkspace) ||
+			errors.Is(txErr, errCategoryNotInWorkspace) {
+			return UpdateDownloadTaskOutput{}, status.Error(codes.InvalidArgument, txErr.Error())
+		}
+
+		if errors.Is(txErr, errIllegalDownloadStatusTransition) {
+			return UpdateDownloadTaskOutput{}, status.Error(codes.FailedPrecondition, txErr.Error())
+		}
+
 		logger.With(zap.Error(txErr)).Error("transaction failed")
 		return UpdateDownloadTaskOutput{}, status.Error(codes.Internal, txErr.Error())
 	}
 
-	// Return the updated download task in the output.
 	return UpdateDownloadTaskOutput{
-		DownloadTask: idm.DownloadTask{
-			Id: updatedTask.DownloadTaskID,
-			OfAccount: &idm.Account{
-				Id:          account.AccountID,
-				AccountName: account.AccountName,
-			},
-			DownloadType:   idm.DownloadType(updatedTask.DownloadType),
-			Url:            updatedTask.DownloadURL,
-			DownloadStatus: idm.DownloadStatus(updatedTask.DownloadStatus),
-			Metadata:       updatedTask.Metadata,
-		},
+		DownloadTask: databaseDownloadTaskToProtoDownloadTask(updatedTask, account, updatedTaskTagIDList),
 	}, nil
 }
 
 // UpdateFailedDownloadTaskStatusToPending implements DownloadTaskLogic.
 func (d *downloadTaskLogic) UpdateFailedDownloadTaskStatusToPending(ctx context.Context) error {
-	return d.downloadTaskDataAccessor.UpdateFailedDownloadTaskStatusToPending(ctx)
+	return d.database.Transaction(func(tx *gorm.DB) error {
+		downloadTaskList, err := d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).UpdateDownloadTaskStatusInBulk(
+			ctx,
+			getDownloadStatusListTransitionableTo(uint16(idm.DownloadStatus_Pending), DownloadStatusTriggerSystem),
+			uint16(idm.DownloadStatus_Pending),
+		)
+		if err != nil {
+			return err
+		}
+
+		downloadTaskEventList := make([]database.DownloadTaskEvent, 0, len(downloadTaskList))
+		for _, downloadTask := range downloadTaskList {
+			downloadTaskEventList = append(downloadTaskEventList, newDownloadTaskEvent(
+				downloadTask.DownloadTaskID,
+				downloadTask.DownloadStatus,
+				uint16(idm.DownloadStatus_Pending),
+				"retrying failed download task",
+			))
+		}
+
+		err = d.downloadTaskEventDataAccessor.WithDatabaseTransaction(tx).CreateDownloadTaskEventList(ctx, downloadTaskEventList)
+		if err != nil {
+			return err
+		}
+
+		return d.createDownloadTaskLifecycleEventList(ctx, tx, downloadTaskEventList)
+	})
 }
 
 // DeleteDownloadTask implements DownloadTaskLogic.
 func (d *downloadTaskLogic) DeleteDownloadTask(ctx context.Context, in DeleteDownloadTaskInput) error {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("delete_download_task_input", in))
 
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	accountID, err := d.getAccountIDWithScope(ctx, in.Token, APIKeyScopeTasksWrite)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
-		return status.Error(codes.Unauthenticated, "authentication token is invalid")
+		return err
 	}
 
 	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, in.DownloadTaskID)
@@ -320,8 +627,8 @@ func (d *downloadTaskLogic) DeleteDownloadTask(ctx context.Context, in DeleteDow
 		return status.Error(codes.NotFound, "download task not found")
 	}
 
-	if accountID != downloadTask.OfAccountID {
-		return status.Error(codes.PermissionDenied, "user do not have permission to delete download task")
+	if err = d.checkDownloadTaskWorkspaceRole(ctx, accountID, downloadTask, idm.WorkspaceRole_Manager); err != nil {
+		return err
 	}
 
 	err = d.downloadTaskDataAccessor.DeleteDownloadTask(ctx, in.DownloadTaskID)
@@ -333,16 +640,72 @@ func (d *downloadTaskLogic) DeleteDownloadTask(ctx context.Context, in DeleteDow
 	return nil
 }
 
+// GetDownloadTaskHistory implements DownloadTaskLogic.
+func (d *downloadTaskLogic) GetDownloadTaskHistory(ctx context.Context, in GetDownloadTaskHistoryInput) (GetDownloadTaskHistoryOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", in.DownloadTaskID))
+
+	accountID, err := d.getAccountIDWithScope(ctx, in.Token, APIKeyScopeTasksRead)
+	if err != nil {
+		return GetDownloadTaskHistoryOutput{}, err
+	}
+
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, in.DownloadTaskID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task from database")
+		return GetDownloadTaskHistoryOutput{}, status.Error(codes.NotFound, "download task not found")
+	}
+
+	if err = d.checkDownloadTaskWorkspaceRole(ctx, accountID, downloadTask, idm.WorkspaceRole_Viewer); err != nil {
+		return GetDownloadTaskHistoryOutput{}, err
+	}
+
+	downloadTaskEventList, err := d.downloadTaskEventDataAccessor.GetDownloadTaskEventListOfDownloadTask(ctx, in.DownloadTaskID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task event list")
+		return GetDownloadTaskHistoryOutput{}, status.Error(codes.Internal, "failed to get download task history")
+	}
+
+	output := GetDownloadTaskHistoryOutput{
+		DownloadTaskEventList: make([]*idm.DownloadTaskEvent, 0, len(downloadTaskEventList)),
+	}
+	for _, downloadTaskEvent := range downloadTaskEventList {
+		output.DownloadTaskEventList = append(
+			output.DownloadTaskEventList,
+			databaseDownloadTaskEventToProtoDownloadTaskEvent(downloadTaskEvent),
+		)
+	}
+
+	return output, nil
+}
+
 // ExecuteDownloadTask implements DownloadTaskLogic.
-func (d *downloadTaskLogic) ExecuteDownloadTask(ctx context.Context, in ExecuteDownloadTaskInput) error {
+func (d *downloadTaskLogic) ExecuteDownloadTask(ctx context.Context, in ExecuteDownloadTaskInput) (err error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("execute_download_task_input", in))
 
 	downloadTask, err := d.updateDownloadTaskStatusFromPendingToDownloading(ctx, in.DownloadTaskID)
 	if err != nil {
+		if errors.Is(err, errIllegalDownloadStatusTransition) {
+			// The task was already executed, for a previous delivery of the same message for example.
+			logger.With(zap.Error(err)).Warn("download task is not pending, skipping")
+			return nil
+		}
+
+		if errors.Is(err, database.ErrDownloadTaskNotFound) {
+			// The task was deleted before being executed, there is nothing left to do.
+			logger.With(zap.Error(err)).Warn("download task not found, skipping")
+			return nil
+		}
+
 		logger.With(zap.Error(err)).Error("can not update task status from pending to downloading")
 		return err
 	}
 
+	defer func() {
+		if err != nil {
+			d.updateDownloadTaskStatusToFailed(ctx, downloadTask.DownloadTaskID, err)
+		}
+	}()
+
 	// Create downloader
 	var downloader Downloader
 
@@ -354,33 +717,167 @@ func (d *downloadTaskLogic) ExecuteDownloadTask(ctx context.Context, in ExecuteD
 			return err
 		}
 	default:
+		err = fmt.Errorf("download type %d not supported", downloadTask.DownloadType)
 		logger.With(zap.Error(err)).Error("download type not supported")
 		return err
 	}
 
-	fileName := fmt.Sprintf("%d", downloadTask.DownloadTaskID)
-	fileWriteCloser, err := d.fileClient.Write(ctx, fileName)
+	hotTier := d.fileClient.GetHotTier()
+	hotTierClient, err := d.fileClient.GetClient(hotTier)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("can not create file writer")
+		logger.With(zap.Error(err)).Error("can not get hot tier file client")
 		return err
 	}
-	defer fileWriteCloser.Close()
 
-	metadata, err := downloader.Download(ctx, fileWriteCloser)
+	// category is the category of the download task, or the one it gets once the content type of its file
+	// is known.
+	var category *database.Category
+	if downloadTask.OfCategoryID != nil {
+		downloadTaskCategory, err := d.categoryDataAccessor.GetCategory(ctx, *downloadTask.OfCategoryID)
+		switch {
+		case err == nil:
+			category = &downloadTaskCategory
+		case !errors.Is(err, database.ErrCategoryNotFound):
+			logger.With(zap.Error(err)).Error("can not get category of download task")
+			return err
+		}
+	}
+
+	// downloadCtx is cancelled with errDownloadTaskNoLongerDownloading once a progress update finds the download
+	// task cancelled by an admin.
+	downloadCtx, cancelDownload := context.WithCancelCause(ctx)
+	defer cancelDownload(nil)
+
+	var (
+		fileName         = fmt.Sprintf("%d", downloadTask.DownloadTaskID)
+		fileWriteCloser  io.WriteCloser
+		codec            file.Codec
+		fileHash         = sha256.New()
+		downloadMetadata = DownloadTaskMetadata{
+			StorageFileName: fileName,
+			StorageTier:     string(hotTier),
+			FileName:        d.getDownloadTaskFileDisplayName(downloadTask),
+		}
+		progressWriter = &downloadProgressWriter{
+			updateInterval: downloadProgressUpdateInterval,
+			lastUpdateTime: time.Now(),
+			update: func(downloadedSize int64) {
+				downloadMetadata.DownloadedSize = downloadedSize
+				if !d.updateDownloadingTaskMetadata(ctx, downloadTask.DownloadTaskID, downloadMetadata) {
+					cancelDownload(errDownloadTaskNoLongerDownloading)
+				}
+			},
+		}
+	)
+	if previousDownloadMetadata, err := parseDownloadTaskMetadata(downloadTask.Metadata); err == nil {
+		downloadMetadata.unknownFields = previousDownloadMetadata.unknownFields
+	}
+
+	_, err = downloader.Download(downloadCtx, func(metadata map[string]any) (io.Writer, error) {
+		downloadMetadata.ContentType, _ = metadata[HTTPMetadataKeyContentType].(string)
+		if contentLength, ok := metadata[HTTPMetadataKeyContentLength].(int64); ok && contentLength > 0 {
+			downloadMetadata.TotalSize = contentLength
+		}
+
+		if category == nil {
+			category = d.matchDownloadTaskCategory(ctx, downloadTask, downloadMetadata.ContentType, downloadMetadata.FileName)
+		}
+
+		if category != nil {
+			fileName = category.StoragePrefix + fileName
+			downloadMetadata.StorageFileName = fileName
+		}
+
+		codec = getCategoryCodec(category, d.codecSelector, downloadMetadata.ContentType)
+
+		writeCloser, err := hotTierClient.Write(ctx, fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		fileWriteCloser, err = file.NewCompressionWriteCloser(writeCloser, codec)
+		if err != nil {
+			writeCloser.Close()
+			return nil, err
+		}
+
+		return io.MultiWriter(fileWriteCloser, fileHash, progressWriter), nil
+	})
 	if err != nil {
+		if fileWriteCloser != nil {
+			fileWriteCloser.Close()
+		}
+
+		if errors.Is(context.Cause(downloadCtx), errDownloadTaskNoLongerDownloading) {
+			logger.Info("download task is no longer downloading, stopping its download")
+			d.deletePartiallyDownloadedFile(ctx, hotTierClient, fileName)
+			return nil
+		}
+
 		logger.With(zap.Error(err)).Error("filed to download file")
 		return err
 	}
 
+	if err = fileWriteCloser.Close(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to close file writer")
+		return err
+	}
+
 	// Update donwloadTask in database
-	metadata[DownloadTaskMetadataKeyFileName] = fileName
-	jsonMetadata, err := json.Marshal(metadata)
+	downloadMetadata.FileSize = &progressWriter.downloadedSize
+	downloadMetadata.DownloadedSize = progressWriter.downloadedSize
+	downloadMetadata.ChecksumSHA256 = hex.EncodeToString(fileHash.Sum(nil))
+	if codec != file.CodecNone {
+		downloadMetadata.CompressionCodec = string(codec)
+	}
+	jsonMetadata, err := downloadMetadata.marshal()
 	if err != nil {
 		logger.With(zap.Error(err)).Error("can not marshal metadata")
 		return err
 	}
-	err = d.downloadTaskDataAccessor.UpdateDownloadTask(ctx, downloadTask.DownloadTaskID, uint16(idm.DownloadStatus_Success), string(jsonMetadata))
+	err = d.database.Transaction(func(tx *gorm.DB) error {
+		lockedDownloadTask, err := d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).GetDownloadTaskForUpdate(ctx, downloadTask.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		// Keep the category matching the file unless the download task was put in another one meanwhile.
+		if category != nil && downloadTask.OfCategoryID == nil && lockedDownloadTask.OfCategoryID == nil {
+			err = d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).UpdateDownloadTaskCategory(
+				ctx,
+				downloadTask.DownloadTaskID,
+				&category.CategoryID,
+				category.Priority,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		downloadTaskEventList, err := updateDownloadTaskWithEvent(
+			ctx,
+			d.downloadTaskDataAccessor.WithDatabaseTransaction(tx),
+			d.downloadTaskEventDataAccessor.WithDatabaseTransaction(tx),
+			lockedDownloadTask,
+			uint16(idm.DownloadStatus_Success),
+			DownloadStatusTriggerWorker,
+			jsonMetadata,
+			"download finished",
+		)
+		if err != nil {
+			return err
+		}
+
+		return d.createDownloadTaskLifecycleEventList(ctx, tx, downloadTaskEventList)
+	})
 	if err != nil {
+		if errors.Is(err, errIllegalDownloadStatusTransition) {
+			// The download task was cancelled after its last progress update.
+			logger.With(zap.Error(err)).Info("download task is no longer downloading, dropping its file")
+			d.deletePartiallyDownloadedFile(ctx, hotTierClient, fileName)
+			return nil
+		}
+
 		logger.With(zap.Error(err)).Error("failed to update download task status to success")
 		return err
 	}
@@ -425,82 +922,1059 @@ func (d *downloadTaskLogic) ExecuteAllPendingDownloadTask(ctx context.Context) e
 	return nil
 }
 
-func (d *downloadTaskLogic) updateDownloadTaskStatusFromPendingToDownloading(ctx context.Context, downloadTaskID uint64) (database.DownloadTask, error) {
-
-	var (
-		downloadTask database.DownloadTask
-		txErr        error
-		err          error
-	)
+// updateDownloadTaskStatusToFailed only logs its errors, the download task is then left downloading.
+func (d *downloadTaskLogic) updateDownloadTaskStatusToFailed(ctx context.Context, downloadTaskID uint64, downloadErr error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTaskID))
 
-	txErr = d.database.Transaction(func(tx *gorm.DB) error {
-		downloadTask, err = d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).GetDownloadTaskForUpdate(ctx, downloadTaskID)
+	txErr := d.database.Transaction(func(tx *gorm.DB) error {
+		downloadTask, err := d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).GetDownloadTaskForUpdate(ctx, downloadTaskID)
 		if err != nil {
-			d.logger.With(zap.Error(err)).Error("failed to get download task")
 			return err
 		}
 
-		if downloadTask.DownloadStatus != uint16(idm.DownloadStatus_Pending) {
-			d.logger.Error("download task not in pending status to download")
-			return fmt.Errorf("download task not in pending status to download")
+		if downloadTask.DownloadStatus != uint16(idm.DownloadStatus_Downloading) {
+			return nil
+		}
+
+		// The progress of the failed download is kept, unless clients broke the metadata before it was typed.
+		downloadTaskMetadata, err := parseDownloadTaskMetadata(downloadTask.Metadata)
+		if err != nil {
+			downloadTaskMetadata = DownloadTaskMetadata{}
 		}
 
-		err = d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).UpdateDownloadTask(ctx, downloadTaskID, uint16(idm.DownloadStatus_Downloading), "")
+		downloadTaskMetadata.Error = downloadErr.Error()
+		jsonMetadata, err := downloadTaskMetadata.marshal()
 		if err != nil {
 			return err
 		}
 
-		return nil
+		downloadTaskEventList, err := updateDownloadTaskWithEvent(
+			ctx,
+			d.downloadTaskDataAccessor.WithDatabaseTransaction(tx),
+			d.downloadTaskEventDataAccessor.WithDatabaseTransaction(tx),
+			downloadTask,
+			uint16(idm.DownloadStatus_Failed),
+			DownloadStatusTriggerWorker,
+			jsonMetadata,
+			downloadErr.Error(),
+		)
+		if err != nil {
+			return err
+		}
+
+		return d.createDownloadTaskLifecycleEventList(ctx, tx, downloadTaskEventList)
 	})
 	if txErr != nil {
-		return database.DownloadTask{}, txErr
+		logger.With(zap.Error(txErr)).Error("failed to update download task status to failed")
 	}
+}
 
-	return downloadTask, nil
+// deletePartiallyDownloadedFile only logs its errors, the file is then left in storage.
+func (d *downloadTaskLogic) deletePartiallyDownloadedFile(ctx context.Context, fileClient file.Client, fileName string) {
+	if err := fileClient.Delete(ctx, fileName); err != nil {
+		utils.LoggerWithContext(ctx, d.logger).
+			With(zap.String("file_name", fileName)).
+			With(zap.Error(err)).
+			Warn("failed to delete partially downloaded file")
+	}
 }
 
-// GetDownloadTaskFile implements DownloadTaskLogic.
-func (d *downloadTaskLogic) GetDownloadTaskFile(ctx context.Context, in GetDownloadTaskFileInput) (GetDownloadTaskFileOutput, error) {
-	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("get_download_task_file_input", in))
+// updateDownloadingTaskMetadata saves the metadata of a download task while it downloads and returns false
+// once the download task is no longer downloading. It only logs its errors since the download goes on regardless.
+func (d *downloadTaskLogic) updateDownloadingTaskMetadata(ctx context.Context, downloadTaskID uint64, downloadTaskMetadata DownloadTaskMetadata) bool {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTaskID))
 
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	jsonMetadata, err := downloadTaskMetadata.marshal()
 	if err != nil {
-		return GetDownloadTaskFileOutput{}, err
+		logger.With(zap.Error(err)).Error("can not marshal metadata")
+		return true
 	}
 
-	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, in.DownloadTaskID)
-	if err != nil {
-		return GetDownloadTaskFileOutput{}, err
-	}
+	isDownloading := true
+	txErr := d.database.Transaction(func(tx *gorm.DB) error {
+		downloadTask, err := d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).GetDownloadTaskForUpdate(ctx, downloadTaskID)
+		if err != nil {
+			return err
+		}
 
-	if accountID != downloadTask.OfAccountID {
-		return GetDownloadTaskFileOutput{}, ErrPermissionDenied
-	}
+		if downloadTask.DownloadStatus != uint16(idm.DownloadStatus_Downloading) {
+			isDownloading = false
+			return nil
+		}
 
-	if downloadTask.DownloadStatus != uint16(idm.DownloadStatus_Success) {
-		return GetDownloadTaskFileOutput{}, ErrDownloadTaskNotCompleted
+		return d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).UpdateDownloadTask(
+			ctx,
+			downloadTaskID,
+			downloadTask.DownloadStatus,
+			0,
+			jsonMetadata,
+		)
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Warn("failed to update download progress")
 	}
 
-	var downloadTaskMetadata map[string]any
-	err = json.Unmarshal([]byte(downloadTask.Metadata), &downloadTaskMetadata)
-	if err != nil {
+	return isDownloading
+}
+
+func (d *downloadTaskLogic) updateDownloadTaskStatusFromPendingToDownloading(ctx context.Context, downloadTaskID uint64) (database.DownloadTask, error) {
+
+	var (
+		downloadTask database.DownloadTask
+		txErr        error
+		err          error
+	)
+
+	txErr = d.database.Transaction(func(tx *gorm.DB) error {
+		downloadTask, err = d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).GetDownloadTaskForUpdate(ctx, downloadTaskID)
+		if err != nil {
+			d.logger.With(zap.Error(err)).Error("failed to get download task")
+			return err
+		}
+
+		// Messages of created download tasks may be received more than once, a task already downloading is
+		// not started again.
+		err = checkDownloadStatusTransition(downloadTask.DownloadStatus, uint16(idm.DownloadStatus_Downloading), DownloadStatusTriggerWorker)
+		if err != nil {
+			return err
+		}
+
+		downloadTaskEventList, err := updateDownloadTaskWithEvent(
+			ctx,
+			d.downloadTaskDataAccessor.WithDatabaseTransaction(tx),
+			d.downloadTaskEventDataAccessor.WithDatabaseTransaction(tx),
+			downloadTask,
+			uint16(idm.DownloadStatus_Downloading),
+			DownloadStatusTriggerWorker,
+			"",
+			"download started",
+		)
+		if err != nil {
+			d.logger.With(zap.Error(err)).Error("failed to update download task status to downloading")
+			return err
+		}
+
+		err = d.createDownloadTaskLifecycleEventList(ctx, tx, downloadTaskEventList)
+		if err != nil {
+			d.logger.With(zap.Error(err)).Error("failed to create download task lifecycle event")
+			return err
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return database.DownloadTask{}, txErr
+	}
+
+	return downloadTask, nil
+}
+
+// GetDownloadTaskFile implements DownloadTaskLogic.
+func (d *downloadTaskLogic) GetDownloadTaskFile(ctx context.Context, in GetDownloadTaskFileInput) (GetDownloadTaskFileOutput, error) {
+	accountID, err := d.getAccountIDWithScope(ctx, in.Token, APIKeyScopeFilesRead)
+	if err != nil {
+		return GetDownloadTaskFileOutput{}, err
+	}
+
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, in.DownloadTaskID)
+	if err != nil {
+		return GetDownloadTaskFileOutput{}, err
+	}
+
+	if err = d.checkDownloadTaskWorkspaceRole(ctx, accountID, downloadTask, idm.WorkspaceRole_Downloader); err != nil {
+		return GetDownloadTaskFileOutput{}, err
+	}
+
+	// gRPC streams have no content coding, the file is always sent decompressed.
+	return d.openDownloadTaskFile(ctx, downloadTask, nil)
+}
+
+// GetDownloadTaskFileInfo implements DownloadTaskLogic.
+func (d *downloadTaskLogic) GetDownloadTaskFileInfo(ctx context.Context, in GetDownloadTaskFileInfoInput) (GetDownloadTaskFileInfoOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", in.DownloadTaskID))
+
+	accountID, err := d.getAccountIDWithScope(ctx, in.Token, APIKeyScopeFilesRead)
+	if err != nil {
+		return GetDownloadTaskFileInfoOutput{}, err
+	}
+
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, in.DownloadTaskID)
+	if err != nil {
+		if errors.Is(err, database.ErrDownloadTaskNotFound) {
+			return GetDownloadTaskFileInfoOutput{}, status.Error(codes.NotFound, "download task not found")
+		}
+
+		return GetDownloadTaskFileInfoOutput{}, status.Error(codes.Internal, "failed to get download task")
+	}
+
+	if err = d.checkDownloadTaskWorkspaceRole(ctx, accountID, downloadTask, idm.WorkspaceRole_Downloader); err != nil {
+		return GetDownloadTaskFileInfoOutput{}, err
+	}
+
+	if downloadTask.DownloadStatus != uint16(idm.DownloadStatus_Success) {
+		return GetDownloadTaskFileInfoOutput{}, ErrDownloadTaskNotCompleted
+	}
+
+	fileName, fileClient, err := d.getDownloadTaskFileClient(downloadTask)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get file location of download task")
+		return GetDownloadTaskFileInfoOutput{}, ErrDownloadTaskNotCompleted
+	}
+
+	fileInfo, err := fileClient.Stat(ctx, fileName)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get file info")
+		return GetDownloadTaskFileInfoOutput{}, status.Error(codes.Internal, "failed to get file info")
+	}
+
+	downloadTaskMetadata, err := parseDownloadTaskMetadata(downloadTask.Metadata)
+	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to unmarshal metadata")
+		return GetDownloadTaskFileInfoOutput{}, status.Error(codes.Internal, "failed to get file info")
+	}
+
+	contentEncoding := d.getDownloadTaskFileContentEncoding(file.Codec(downloadTaskMetadata.CompressionCodec), in.AcceptedEncodings)
+
+	// Files downloaded before the original file size was recorded are never compressed,
+	// the stored size is their size.
+	size := fileInfo.Size
+	if downloadTaskMetadata.FileSize != nil && contentEncoding == "" {
+		size = *downloadTaskMetadata.FileSize
+	}
+
+	return GetDownloadTaskFileInfoOutput{
+		FileName:        d.getDownloadTaskFileDisplayName(downloadTask),
+		ContentType:     downloadTaskMetadata.ContentType,
+		ContentEncoding: contentEncoding,
+		Size:            size,
+		ModTime:         fileInfo.ModTime,
+	}, nil
+}
+
+// OpenDownloadTaskFile implements DownloadTaskLogic.
+func (d *downloadTaskLogic) OpenDownloadTaskFile(ctx context.Context, in OpenDownloadTaskFileInput) (GetDownloadTaskFileOutput, error) {
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, in.DownloadTaskID)
+	if err != nil {
 		return GetDownloadTaskFileOutput{}, err
 	}
 
-	fileName, ok := downloadTaskMetadata[DownloadTaskMetadataKeyFileName]
-	if !ok {
-		logger.Error("file name not found in metadata")
+	return d.openDownloadTaskFile(ctx, downloadTask, in.AcceptedEncodings)
+}
+
+func (d *downloadTaskLogic) openDownloadTaskFile(
+	ctx context.Context,
+	downloadTask database.DownloadTask,
+	acceptedEncodings []string,
+) (GetDownloadTaskFileOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTask.DownloadTaskID))
+
+	if downloadTask.DownloadStatus != uint16(idm.DownloadStatus_Success) {
 		return GetDownloadTaskFileOutput{}, ErrDownloadTaskNotCompleted
 	}
 
-	readCloser, err := d.fileClient.Read(ctx, fileName.(string))
+	fileName, fileClient, err := d.getDownloadTaskFileClient(downloadTask)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get file location of download task")
+		return GetDownloadTaskFileOutput{}, ErrDownloadTaskNotCompleted
+	}
+
+	readCloser, err := fileClient.Read(ctx, fileName)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to read file")
 		return GetDownloadTaskFileOutput{}, err
 	}
 
+	codec, err := d.getDownloadTaskFileCodec(downloadTask)
+	if err != nil {
+		readCloser.Close()
+		logger.With(zap.Error(err)).Error("failed to get compression codec of download task")
+		return GetDownloadTaskFileOutput{}, err
+	}
+
+	if contentEncoding := d.getDownloadTaskFileContentEncoding(codec, acceptedEncodings); contentEncoding != "" {
+		return GetDownloadTaskFileOutput{
+			Reader:          readCloser,
+			ContentEncoding: contentEncoding,
+		}, nil
+	}
+
+	decompressedReadCloser, err := file.NewDecompressionReadCloser(readCloser, codec)
+	if err != nil {
+		readCloser.Close()
+		logger.With(zap.Error(err)).Error("failed to decompress file")
+		return GetDownloadTaskFileOutput{}, err
+	}
+
 	return GetDownloadTaskFileOutput{
-		Reader: readCloser,
+		Reader: decompressedReadCloser,
 	}, nil
 }
+
+// MoveAllDownloadTaskFileToColdTier implements DownloadTaskLogic.
+func (d *downloadTaskLogic) MoveAllDownloadTaskFileToColdTier(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	coldTier, ok := d.fileClient.GetColdTier()
+	if !ok {
+		logger.Debug("no cold storage tier configured")
+		return nil
+	}
+	hotTier := d.fileClient.GetHotTier()
+
+	downloadTaskIDList, err := d.downloadTaskDataAccessor.GetDownloadTaskIDListWithMetadataValue(
+		ctx,
+		uint16(idm.DownloadStatus_Success),
+		DownloadTaskMetadataKeyStorageTier,
+		string(hotTier),
+	)
+	if err != nil {
+		return err
+	}
+	if len(downloadTaskIDList) == 0 {
+		logger.Info("no download task file found in hot tier")
+		return nil
+	}
+
+	logger.
+		With(zap.Int("len(download_task_id_list)", len(downloadTaskIDList))).
+		Info("download task file found in hot tier")
+
+	workerPool := workerpool.New(d.cronConfig.MoveDownloadTaskFileToColdTier.ConcurrencyLimit)
+	for _, id := range downloadTaskIDList {
+		workerPool.Submit(func() {
+			if moveErr := d.moveDownloadTaskFileToColdTier(ctx, id, hotTier, coldTier); moveErr != nil {
+				logger.
+					With(zap.Uint64("download_task_id", id)).
+					With(zap.Error(moveErr)).
+					Error("failed to move download task file to cold tier")
+			}
+		})
+	}
+
+	workerPool.StopWait()
+	return nil
+}
+
+// moveDownloadTaskFileToColdTier copies the file of a download task from the hot tier to the cold tier,
+// verifies the copy by its checksum, switches the storage tier in the task's metadata and finally
+// removes the file from the hot tier.
+func (d *downloadTaskLogic) moveDownloadTaskFileToColdTier(ctx context.Context, downloadTaskID uint64, hotTier, coldTier file.Tier) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTaskID))
+
+	hotTierClient, err := d.fileClient.GetClient(hotTier)
+	if err != nil {
+		return err
+	}
+
+	coldTierClient, err := d.fileClient.GetClient(coldTier)
+	if err != nil {
+		return err
+	}
+
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, downloadTaskID)
+	if err != nil {
+		return err
+	}
+
+	fileName, _, err := d.getDownloadTaskFileLocation(downloadTask)
+	if err != nil {
+		return err
+	}
+
+	hotTierChecksum, err := d.copyFile(ctx, hotTierClient, coldTierClient, fileName)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to copy file to cold tier")
+		return err
+	}
+
+	coldTierChecksum, err := d.getFileChecksum(ctx, coldTierClient, fileName)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to read back file from cold tier")
+		return err
+	}
+
+	if !bytes.Equal(hotTierChecksum, coldTierChecksum) {
+		logger.Error("checksum of file in cold tier does not match")
+		if deleteErr := coldTierClient.Delete(ctx, fileName); deleteErr != nil {
+			logger.With(zap.Error(deleteErr)).Warn("failed to delete corrupted file from cold tier")
+		}
+		return fmt.Errorf("checksum of file in cold tier does not match")
+	}
+
+	txErr := d.database.Transaction(func(tx *gorm.DB) error {
+		lockedDownloadTask, err := d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).GetDownloadTaskForUpdate(ctx, downloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		downloadTaskMetadata, err := parseDownloadTaskMetadata(lockedDownloadTask.Metadata)
+		if err != nil {
+			return err
+		}
+
+		if lockedDownloadTask.DownloadStatus != uint16(idm.DownloadStatus_Success) ||
+			downloadTaskMetadata.StorageTier != string(hotTier) {
+			return fmt.Errorf("download task changed while its file was being moved")
+		}
+
+		downloadTaskMetadata.StorageTier = string(coldTier)
+		jsonMetadata, err := downloadTaskMetadata.marshal()
+		if err != nil {
+			return err
+		}
+
+		return d.downloadTaskDataAccessor.WithDatabaseTransaction(tx).UpdateDownloadTask(
+			ctx,
+			downloadTaskID,
+			lockedDownloadTask.DownloadStatus,
+			0,
+			jsonMetadata,
+		)
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Error("failed to switch storage tier of download task")
+		if deleteErr := coldTierClient.Delete(ctx, fileName); deleteErr != nil {
+			logger.With(zap.Error(deleteErr)).Warn("failed to delete file copy from cold tier")
+		}
+		return txErr
+	}
+
+	if err = hotTierClient.Delete(ctx, fileName); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to delete file from hot tier after moving it to cold tier")
+	}
+
+	logger.Info("download task file moved to cold tier")
+	return nil
+}
+
+// RewrapAllDownloadTaskFileKey implements DownloadTaskLogic.
+func (d *downloadTaskLogic) RewrapAllDownloadTaskFileKey(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	downloadTaskIDList, err := d.downloadTaskDataAccessor.GetDownloadTaskIDListWithStatus(ctx, uint16(idm.DownloadStatus_Success))
+	if err != nil {
+		return err
+	}
+
+	var rewrappedCount, failedCount int
+	for _, id := range downloadTaskIDList {
+		rewrapped, err := d.rewrapDownloadTaskFileKey(ctx, id)
+		if err != nil {
+			logger.
+				With(zap.Uint64("download_task_id", id)).
+				With(zap.Error(err)).
+				Error("failed to rewrap download task file key")
+			failedCount++
+			continue
+		}
+
+		if rewrapped {
+			rewrappedCount++
+		}
+	}
+
+	logger.
+		With(zap.Int("rewrapped_count", rewrappedCount)).
+		With(zap.Int("failed_count", failedCount)).
+		Info("finished rewrapping download task file keys")
+
+	if failedCount > 0 {
+		return fmt.Errorf("failed to rewrap %d download task file keys", failedCount)
+	}
+
+	return nil
+}
+
+func (d *downloadTaskLogic) rewrapDownloadTaskFileKey(ctx context.Context, downloadTaskID uint64) (bool, error) {
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, downloadTaskID)
+	if err != nil {
+		return false, err
+	}
+
+	fileName, fileClient, err := d.getDownloadTaskFileClient(downloadTask)
+	if err != nil {
+		return false, err
+	}
+
+	encryptingClient, ok := fileClient.(file.EncryptingClient)
+	if !ok {
+		return false, fmt.Errorf("encryption at rest is not enabled")
+	}
+
+	rewrapped, err := encryptingClient.RewrapDataKey(ctx, fileName)
+	if err != nil {
+		// Files downloaded before encryption was enabled have no data key to rewrap.
+		if errors.Is(err, file.ErrFileNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return rewrapped, nil
+}
+
+func (d *downloadTaskLogic) getAccountIDWithScope(ctx context.Context, token string, scope string) (uint64, error) {
+	return getAccountIDWithScope(ctx, d.tokenLogic, d.logger, token, scope)
+}
+
+// getAccountIDWithScope authenticates a session token or an API key granted scope.
+func getAccountIDWithScope(ctx context.Context, tokenLogic TokenLogic, logger *zap.Logger, token string, scope string) (uint64, error) {
+	logger = utils.LoggerWithContext(ctx, logger).With(zap.String("scope", scope))
+
+	accountID, err := tokenLogic.GetAccountIDWithScope(ctx, token, scope)
+	if err != nil {
+		if errors.Is(err, ErrAPIKeyScopeMissing) {
+			return 0, status.Errorf(codes.PermissionDenied, "api key is missing the %s scope", scope)
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get account id from token")
+		return 0, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	return accountID, nil
+}
+
+// checkDownloadTaskWorkspaceRole checks that the account has at least the role in the workspace of the
+// download task. The account that created a download task only needs to be a downloader to manage it.
+func (d *downloadTaskLogic) checkDownloadTaskWorkspaceRole(
+	ctx context.Context,
+	accountID uint64,
+	downloadTask database.DownloadTask,
+	role idm.WorkspaceRole,
+) error {
+	if downloadTask.OfAccountID == accountID && role > idm.WorkspaceRole_Downloader {
+		role = idm.WorkspaceRole_Downloader
+	}
+
+	_, err := d.workspaceLogic.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: downloadTask.OfWorkspaceID,
+		Role:        role,
+	})
+	return err
+}
+
+// matchDownloadTaskCategory returns the category of the workspace of a download task matching its file, if
+// any. It only logs its errors, the file is then downloaded without category.
+func (d *downloadTaskLogic) matchDownloadTaskCategory(
+	ctx context.Context,
+	downloadTask database.DownloadTask,
+	contentType string,
+	fileName string,
+) *database.Category {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTask.DownloadTaskID))
+
+	categories, err := d.categoryDataAccessor.GetCategoryListOfWorkspace(ctx, downloadTask.OfWorkspaceID)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to get categories to match download task with")
+		return nil
+	}
+
+	category, ok := matchCategory(categories, contentType, fileName)
+	if !ok {
+		return nil
+	}
+
+	return &category
+}
+
+// checkTagListOfWorkspace returns errTagNotInWorkspace unless every tag belongs to the workspace.
+func (d *downloadTaskLogic) checkTagListOfWorkspace(ctx context.Context, workspaceID uint64, tagIDList []uint64) error {
+	tags, err := d.tagDataAccessor.GetTagList(ctx, tagIDList)
+	if err != nil {
+		return err
+	}
+
+	for _, tagID := range tagIDList {
+		if !slices.ContainsFunc(tags, func(tag database.Tag) bool {
+			return tag.TagID == tagID && tag.OfWorkspaceID == workspaceID
+		}) {
+			return fmt.Errorf("%w: %d", errTagNotInWorkspace, tagID)
+		}
+	}
+
+	return nil
+}
+
+// getCategoryOfWorkspace returns errCategoryNotInWorkspace if the category does not belong to the workspace.
+func (d *downloadTaskLogic) getCategoryOfWorkspace(ctx context.Context, workspaceID, categoryID uint64) (database.Category, error) {
+	category, err := d.categoryDataAccessor.GetCategory(ctx, categoryID)
+	if err != nil {
+		if errors.Is(err, database.ErrCategoryNotFound) {
+			return database.Category{}, fmt.Errorf("%w: %d", errCategoryNotInWorkspace, categoryID)
+		}
+
+		return database.Category{}, err
+	}
+
+	if category.OfWorkspaceID != workspaceID {
+		return database.Category{}, fmt.Errorf("%w: %d", errCategoryNotInWorkspace, categoryID)
+	}
+
+	return category, nil
+}
+
+// createDownloadTaskLifecycleEventList saves the lifecycle events of the changes of status saved by the
+// transaction to the outbox and queues their webhook deliveries in it, so that they are only sent once it is
+// committed.
+func (d *downloadTaskLogic) createDownloadTaskLifecycleEventList(
+	ctx context.Context,
+	tx *gorm.DB,
+	downloadTaskEventList []database.DownloadTaskEvent,
+) error {
+	lifecycleEventList, err := newDownloadTaskLifecycleEventList(
+		ctx,
+		d.accountDataAccessor.WithDatabaseTransaction(tx),
+		d.downloadTaskDataAccessor.WithDatabaseTransaction(tx),
+		d.tagDataAccessor.WithDatabaseTransaction(tx),
+		downloadTaskEventList,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, lifecycleEvent := range lifecycleEventList {
+		if err = d.createDownloadTaskWebhookDeliveryList(ctx, tx, lifecycleEvent); err != nil {
+			return err
+		}
+	}
+
+	return createDownloadTaskLifecycleEventOutboxMessageList(
+		ctx,
+		d.outboxMessageDataAccessor.WithDatabaseTransaction(tx),
+		lifecycleEventList,
+	)
+}
+
+// createDownloadTaskWebhookDeliveryList queues the lifecycle event for the webhooks of the account of its
+// download task subscribed to it.
+func (d *downloadTaskLogic) createDownloadTaskWebhookDeliveryList(
+	ctx context.Context,
+	tx *gorm.DB,
+	lifecycleEvent *idm.DownloadTaskLifecycleEvent,
+) error {
+	eventType, ok := getWebhookEventType(lifecycleEvent.GetType())
+	if !ok {
+		return nil
+	}
+
+	webhooks, err := getSubscribedWebhookList(
+		ctx,
+		d.webhookDataAccessor.WithDatabaseTransaction(tx),
+		lifecycleEvent.GetDownloadTask().GetOfAccount().GetId(),
+		eventType,
+	)
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	return createWebhookDeliveryList(
+		ctx,
+		d.webhookDeliveryDataAccessor.WithDatabaseTransaction(tx),
+		webhooks,
+		lifecycleEvent.GetDownloadTask(),
+		eventType,
+	)
+}
+
+// getDownloadTaskFileLocation returns the name of a download task's file and the storage tier holding it.
+func (d *downloadTaskLogic) getDownloadTaskFileLocation(downloadTask database.DownloadTask) (string, file.Tier, error) {
+	downloadTaskMetadata, err := parseDownloadTaskMetadata(downloadTask.Metadata)
+	if err != nil {
+		return "", "", err
+	}
+
+	if downloadTaskMetadata.StorageFileName == "" {
+		return "", "", fmt.Errorf("file name not found in metadata")
+	}
+
+	// Tasks downloaded before tiered storage was introduced have no tier in their metadata,
+	// their files are in the hot tier.
+	tier := d.fileClient.GetHotTier()
+	if downloadTaskMetadata.StorageTier != "" {
+		tier = file.Tier(downloadTaskMetadata.StorageTier)
+	}
+
+	return downloadTaskMetadata.StorageFileName, tier, nil
+}
+
+// getDownloadTaskFileDisplayName returns the name users see when saving the file of a download task, which is
+// the last segment of the download URL for tasks downloaded before it was recorded in their metadata.
+func (d *downloadTaskLogic) getDownloadTaskFileDisplayName(downloadTask database.DownloadTask) string {
+	if downloadTaskMetadata, err := parseDownloadTaskMetadata(downloadTask.Metadata); err == nil && downloadTaskMetadata.FileName != "" {
+		return downloadTaskMetadata.FileName
+	}
+
+	defaultDisplayName := fmt.Sprintf("download-task-%d", downloadTask.DownloadTaskID)
+
+	downloadURL, err := url.Parse(downloadTask.DownloadURL)
+	if err != nil {
+		return defaultDisplayName
+	}
+
+	displayName := path.Base(downloadURL.Path)
+	if displayName == "/" || displayName == "." {
+		return defaultDisplayName
+	}
+
+	return displayName
+}
+
+func (d *downloadTaskLogic) getDownloadTaskFileCodec(downloadTask database.DownloadTask) (file.Codec, error) {
+	downloadTaskMetadata, err := parseDownloadTaskMetadata(downloadTask.Metadata)
+	if err != nil {
+		return file.CodecNone, err
+	}
+
+	return file.Codec(downloadTaskMetadata.CompressionCodec), nil
+}
+
+// getDownloadTaskFileContentEncoding returns the content coding a file compressed with codec is served with,
+// empty if it has to be decompressed.
+func (d *downloadTaskLogic) getDownloadTaskFileContentEncoding(codec file.Codec, acceptedEncodings []string) string {
+	if codec == file.CodecNone || !d.codecSelector.IsServingCompressed() || !slices.Contains(acceptedEncodings, string(codec)) {
+		return ""
+	}
+
+	return string(codec)
+}
+
+func (d *downloadTaskLogic) getDownloadTaskFileClient(downloadTask database.DownloadTask) (string, file.Client, error) {
+	fileName, tier, err := d.getDownloadTaskFileLocation(downloadTask)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fileClient, err := d.fileClient.GetClient(tier)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return fileName, fileClient, nil
+}
+
+// copyFile copies a file between two file clients and returns the SHA-256 checksum of the copied content.
+func (d *downloadTaskLogic) copyFile(ctx context.Context, source, destination file.Client, fileName string) ([]byte, error) {
+	readCloser, err := source.Read(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer readCloser.Close()
+
+	writeCloser, err := destination.Write(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(writeCloser, hash), readCloser); err != nil {
+		writeCloser.Close()
+		return nil, err
+	}
+
+	if err = writeCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	return hash.Sum(nil), nil
+}
+
+func (d *downloadTaskLogic) getFileChecksum(ctx context.Context, fileClient file.Client, fileName string) ([]byte, error) {
+	readCloser, err := fileClient.Read(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer readCloser.Close()
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, readCloser); err != nil {
+		return nil, err
+	}
+
+	return hash.Sum(nil), nil
+}
+
+// downloadProgressWriter counts the bytes written to it and reports them at most once per update interval.
+type downloadProgressWriter struct {
+	downloadedSize int64
+	updateInterval time.Duration
+	lastUpdateTime time.Time
+	update         func(downloadedSize int64)
+}
+
+func (w *downloadProgressWriter) Write(p []byte) (int, error) {
+	w.downloadedSize += int64(len(p))
+	if now := time.Now(); now.Sub(w.lastUpdateTime) >= w.updateInterval {
+		w.lastUpdateTime = now
+		w.update(w.downloadedSize)
+	}
+
+	return len(p), nil
+}
+
+func downloadTaskTagOrCategoryError(err error) error {
+	if errors.Is(err, errTagNotInWorkspace) || errors.Is(err, errCategoryNotInWorkspace) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Error(codes.Internal, "failed to get tags and category of download task")
+}
+
+func databaseDownloadTaskToProtoDownloadTask(
+	downloadTask database.DownloadTask,
+	account database.Account,
+	tagIDList []uint64,
+) *idm.DownloadTask {
+	protoDownloadTask := &idm.DownloadTask{
+		Id: downloadTask.DownloadTaskID,
+		OfAccount: &idm.Account{
+			Id:          account.AccountID,
+			AccountName: account.AccountName,
+		},
+		DownloadType:   idm.DownloadType(downloadTask.DownloadType),
+		Url:            downloadTask.DownloadURL,
+		DownloadStatus: idm.DownloadStatus(downloadTask.DownloadStatus),
+		OfWorkspaceId:  downloadTask.OfWorkspaceID,
+		CreateTime:     timestamppb.New(downloadTask.CreateTime),
+		UpdateTime:     timestamppb.New(downloadTask.UpdateTime),
+		StartTime:      timeToTimestamp(downloadTask.StartTime),
+		FinishTime:     timeToTimestamp(downloadTask.FinishTime),
+		Metadata:       getProtoDownloadTaskMetadata(downloadTask),
+		Labels:         getProtoDownloadTaskLabels(downloadTask),
+		TagIdList:      tagIDList,
+		Priority:       downloadTask.Priority,
+	}
+	if downloadTask.OfCategoryID != nil {
+		protoDownloadTask.CategoryId = *downloadTask.OfCategoryID
+	}
+
+	return protoDownloadTask
+}
+
+// databaseDownloadTaskListToProtoDownloadTaskList looks up the account that created each download task and
+// the tags set on it.
+func databaseDownloadTaskListToProtoDownloadTaskList(
+	ctx context.Context,
+	accountDataAccessor database.AccountDataAccessor,
+	tagDataAccessor database.TagDataAccessor,
+	downloadTasks []database.DownloadTask,
+) ([]*idm.DownloadTask, error) {
+	downloadTaskIDList := make([]uint64, 0, len(downloadTasks))
+	for _, downloadTask := range downloadTasks {
+		downloadTaskIDList = append(downloadTaskIDList, downloadTask.DownloadTaskID)
+	}
+
+	tagIDListPerDownloadTask, err := tagDataAccessor.GetTagIDListOfDownloadTaskList(ctx, downloadTaskIDList)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make(map[uint64]database.Account)
+	downloadTaskList := make([]*idm.DownloadTask, 0, len(downloadTasks))
+	for _, downloadTask := range downloadTasks {
+		account, ok := accounts[downloadTask.OfAccountID]
+		if !ok {
+			var err error
+			account, err = accountDataAccessor.GetAccountByID(ctx, downloadTask.OfAccountID)
+			if err != nil {
+				return nil, err
+			}
+
+			accounts[downloadTask.OfAccountID] = account
+		}
+
+		downloadTaskList = append(downloadTaskList, databaseDownloadTaskToProtoDownloadTask(
+			downloadTask,
+			account,
+			tagIDListPerDownloadTask[downloadTask.DownloadTaskID],
+		))
+	}
+
+	return downloadTaskList, nil
+}
+
+func getDownloadTaskListFilter(in GetDownloadTaskListInput) database.DownloadTaskListFilter {
+	filter := database.DownloadTaskListFilter{
+		URLSubstring:   in.URLSubstring,
+		URLHost:        in.URLHost,
+		CreateTimeFrom: in.CreateTimeFrom,
+		CreateTimeTo:   in.CreateTimeTo,
+		UpdateTimeFrom: in.UpdateTimeFrom,
+		UpdateTimeTo:   in.UpdateTimeTo,
+		SearchQuery:    in.SearchQuery,
+		TagIDList:      in.TagIDList,
+		CategoryIDList: in.CategoryIDList,
+	}
+
+	for _, downloadStatus := range in.DownloadStatusList {
+		filter.DownloadStatusList = append(filter.DownloadStatusList, uint16(downloadStatus))
+	}
+
+	for _, downloadType := range in.DownloadTypeList {
+		filter.DownloadTypeList = append(filter.DownloadTypeList, uint16(downloadType))
+	}
+
+	return filter
+}
+
+func getDownloadTaskListSort(sortField idm.DownloadTaskListSortField, descending bool) (database.DownloadTaskListSort, error) {
+	sort := database.DownloadTaskListSort{Descending: descending}
+	switch sortField {
+	case idm.DownloadTaskListSortField_SortByCreateTime:
+		sort.Field = database.DownloadTaskListSortFieldCreateTime
+	case idm.DownloadTaskListSortField_SortByFileSize:
+		sort.Field = database.DownloadTaskListSortFieldFileSize
+	case idm.DownloadTaskListSortField_SortByDownloadStatus:
+		sort.Field = database.DownloadTaskListSortFieldDownloadStatus
+	default:
+		return database.DownloadTaskListSort{}, status.Error(codes.InvalidArgument, "unsupported sort field")
+	}
+
+	return sort, nil
+}
+
+// downloadTaskListPageToken is the content of a page token, it remembers the sort of its list so that it is
+// not used to continue a list in another order.
+type downloadTaskListPageToken struct {
+	SortField      idm.DownloadTaskListSortField `json:"sort_field"`
+	SortDescending bool                          `json:"sort_descending"`
+	DownloadTaskID uint64                        `json:"download_task_id"`
+	CreateTime     time.Time                     `json:"create_time"`
+	FileSize       *uint64                       `json:"file_size,omitempty"`
+	DownloadStatus uint16                        `json:"download_status"`
+}
+
+func encodeDownloadTaskListPageToken(
+	cursor database.DownloadTaskListCursor,
+	sortField idm.DownloadTaskListSortField,
+	sortDescending bool,
+) (string, error) {
+	jsonPageToken, err := json.Marshal(downloadTaskListPageToken{
+		SortField:      sortField,
+		SortDescending: sortDescending,
+		DownloadTaskID: cursor.DownloadTaskID,
+		CreateTime:     cursor.CreateTime,
+		FileSize:       cursor.FileSize,
+		DownloadStatus: cursor.DownloadStatus,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(jsonPageToken), nil
+}
+
+func decodeDownloadTaskListPageToken(
+	pageToken string,
+	sortField idm.DownloadTaskListSortField,
+	sortDescending bool,
+) (database.DownloadTaskListCursor, error) {
+	jsonPageToken, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return database.DownloadTaskListCursor{}, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	var decodedPageToken downloadTaskListPageToken
+	if err = json.Unmarshal(jsonPageToken, &decodedPageToken); err != nil {
+		return database.DownloadTaskListCursor{}, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	if decodedPageToken.SortField != sortField || decodedPageToken.SortDescending != sortDescending {
+		return database.DownloadTaskListCursor{}, status.Error(codes.InvalidArgument, "page token does not match the sort of the list")
+	}
+
+	return database.DownloadTaskListCursor{
+		DownloadTaskID: decodedPageToken.DownloadTaskID,
+		CreateTime:     decodedPageToken.CreateTime,
+		FileSize:       decodedPageToken.FileSize,
+		DownloadStatus: decodedPageToken.DownloadStatus,
+	}, nil
+}
+
+// updateDownloadTaskWithEvent updates a download task locked for update and records the change of its status,
+// if any, as a download task event which is returned. Both data accessors have to use the same transaction. The
+// change of status has to be allowed for trigger, otherwise an error wrapping errIllegalDownloadStatusTransition
+// is returned.
+func updateDownloadTaskWithEvent(
+	ctx context.Context,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	downloadTaskEventDataAccessor database.DownloadTaskEventDataAccessor,
+	downloadTask database.DownloadTask,
+	downloadStatus uint16,
+	trigger DownloadStatusTrigger,
+	metadata string,
+	reason string,
+) ([]database.DownloadTaskEvent, error) {
+	statusChanged := downloadStatus != 0 && downloadStatus != downloadTask.DownloadStatus
+	if statusChanged {
+		if err := checkDownloadStatusTransition(downloadTask.DownloadStatus, downloadStatus, trigger); err != nil {
+			return nil, err
+		}
+	}
+
+	// The status is only changed if it is still the one the transition was checked from, in case the download
+	// task was not locked for update.
+	err := downloadTaskDataAccessor.UpdateDownloadTask(
+		ctx,
+		downloadTask.DownloadTaskID,
+		downloadTask.DownloadStatus,
+		downloadStatus,
+		metadata,
+	)
+	if err != nil {
+		if errors.Is(err, database.ErrDownloadTaskStatusChanged) {
+			return nil, fmt.Errorf("%w: %w", errIllegalDownloadStatusTransition, err)
+		}
+
+		return nil, err
+	}
+
+	if !statusChanged {
+		return nil, nil
+	}
+
+	downloadTaskEventList := []database.DownloadTaskEvent{
+		newDownloadTaskEvent(downloadTask.DownloadTaskID, downloadTask.DownloadStatus, downloadStatus, reason),
+	}
+	if err = downloadTaskEventDataAccessor.CreateDownloadTaskEventList(ctx, downloadTaskEventList); err != nil {
+		return nil, err
+	}
+
+	return downloadTaskEventList, nil
+}
+
+func newDownloadTaskEvent(downloadTaskID uint64, fromDownloadStatus, toDownloadStatus uint16, reason string) database.DownloadTaskEvent {
+	if len(reason) > downloadTaskEventReasonMaxLength {
+		reason = strings.ToValidUTF8(reason[:downloadTaskEventReasonMaxLength], "")
+	}
+
+	return database.DownloadTaskEvent{
+		OfDownloadTaskID:   downloadTaskID,
+		FromDownloadStatus: fromDownloadStatus,
+		ToDownloadStatus:   toDownloadStatus,
+		EventTime:          time.Now(),
+		Node:               getNodeName(),
+		Reason:             reason,
+	}
+}
+
+// getNodeName returns the host name, download task events record it to tell apart the servers and workers.
+var getNodeName = sync.OnceValue(func() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "unknown"
+	}
+
+	return hostname
+})
+
+func timeToTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
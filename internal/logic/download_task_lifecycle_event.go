@@ -0,0 +1,115 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/dataaccess/mq/producer"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newDownloadTaskLifecycleEventList returns the lifecycle events of changes of status with a snapshot of their
+// download task. The data accessors have to use the transaction changing the statuses, so that the snapshots
+// include every other change the transaction makes before.
+func newDownloadTaskLifecycleEventList(
+	ctx context.Context,
+	accountDataAccessor database.AccountDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	tagDataAccessor database.TagDataAccessor,
+	downloadTaskEventList []database.DownloadTaskEvent,
+) ([]*idm.DownloadTaskLifecycleEvent, error) {
+	if len(downloadTaskEventList) == 0 {
+		return nil, nil
+	}
+
+	downloadTasks := make([]database.DownloadTask, 0, len(downloadTaskEventList))
+	for _, downloadTaskEvent := range downloadTaskEventList {
+		downloadTask, err := downloadTaskDataAccessor.GetDownloadTask(ctx, downloadTaskEvent.OfDownloadTaskID)
+		if err != nil {
+			return nil, err
+		}
+
+		downloadTasks = append(downloadTasks, downloadTask)
+	}
+
+	downloadTaskList, err := databaseDownloadTaskListToProtoDownloadTaskList(ctx, accountDataAccessor, tagDataAccessor, downloadTasks)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycleEventList := make([]*idm.DownloadTaskLifecycleEvent, 0, len(downloadTaskEventList))
+	for i, downloadTaskEvent := range downloadTaskEventList {
+		lifecycleEventList = append(lifecycleEventList, &idm.DownloadTaskLifecycleEvent{
+			Version:           producer.DownloadTaskLifecycleEventVersion,
+			Type:              getDownloadTaskLifecycleEventType(downloadTaskEvent.FromDownloadStatus, downloadTaskEvent.ToDownloadStatus),
+			DownloadTaskEvent: databaseDownloadTaskEventToProtoDownloadTaskEvent(downloadTaskEvent),
+			DownloadTask:      downloadTaskList[i],
+		})
+	}
+
+	return lifecycleEventList, nil
+}
+
+// createDownloadTaskLifecycleEventOutboxMessageList saves the lifecycle events to the outbox, the data accessor
+// has to use the transaction changing the statuses so that the events are produced if and only if it is
+// committed. The events of a download task are keyed by its ID, so that they are consumed in order.
+func createDownloadTaskLifecycleEventOutboxMessageList(
+	ctx context.Context,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	lifecycleEventList []*idm.DownloadTaskLifecycleEvent,
+) error {
+	outboxMessageList := make([]database.OutboxMessage, 0, len(lifecycleEventList))
+	for _, lifecycleEvent := range lifecycleEventList {
+		queueName, ok := producer.GetDownloadTaskLifecycleEventQueueName(lifecycleEvent.GetType())
+		if !ok {
+			return fmt.Errorf("download task lifecycle event type %s has no queue", lifecycleEvent.GetType())
+		}
+
+		payload, err := producer.NewDownloadTaskLifecycleEventPayload(lifecycleEvent)
+		if err != nil {
+			return err
+		}
+
+		outboxMessageList = append(outboxMessageList, database.OutboxMessage{
+			QueueName:  queueName,
+			MessageKey: producer.GetDownloadTaskMessageKey(lifecycleEvent.GetDownloadTask().GetId()),
+			Payload:    payload,
+		})
+	}
+
+	return outboxMessageDataAccessor.CreateOutboxMessageList(ctx, outboxMessageList)
+}
+
+func getDownloadTaskLifecycleEventType(fromDownloadStatus, toDownloadStatus uint16) idm.DownloadTaskLifecycleEventType {
+	switch idm.DownloadStatus(toDownloadStatus) {
+	case idm.DownloadStatus_Pending:
+		if idm.DownloadStatus(fromDownloadStatus) == idm.DownloadStatus_UndefinedStatus {
+			return idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventCreated
+		}
+
+		return idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventRequeued
+	case idm.DownloadStatus_Downloading:
+		return idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventStarted
+	case idm.DownloadStatus_Success:
+		return idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventSucceeded
+	case idm.DownloadStatus_Failed:
+		return idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventFailed
+	case idm.DownloadStatus_Cancelled:
+		return idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventCancelled
+	default:
+		return idm.DownloadTaskLifecycleEventType_UndefinedDownloadTaskLifecycleEventType
+	}
+}
+
+func databaseDownloadTaskEventToProtoDownloadTaskEvent(downloadTaskEvent database.DownloadTaskEvent) *idm.DownloadTaskEvent {
+	return &idm.DownloadTaskEvent{
+		Id:                 downloadTaskEvent.DownloadTaskEventID,
+		FromDownloadStatus: idm.DownloadStatus(downloadTaskEvent.FromDownloadStatus),
+		ToDownloadStatus:   idm.DownloadStatus(downloadTaskEvent.ToDownloadStatus),
+		EventTime:          timestamppb.New(downloadTaskEvent.EventTime),
+		Node:               downloadTaskEvent.Node,
+		Reason:             downloadTaskEvent.Reason,
+	}
+}
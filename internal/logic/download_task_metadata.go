@@ -0,0 +1,196 @@
+package logic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+)
+
+const (
+	downloadTaskLabelMaxCount = 64
+)
+
+var (
+	errTooManyDownloadTaskLabels = fmt.Errorf("download tasks can not have more than %d labels", downloadTaskLabelMaxCount)
+
+	// downloadTaskMetadataKeySet has the lower cased JSON keys of DownloadTaskMetadata, encoding/json matches
+	// keys case insensitively when unmarshalling.
+	downloadTaskMetadataKeySet = getLowerCasedJSONKeySet(reflect.TypeOf(DownloadTaskMetadata{}))
+)
+
+// DownloadTaskMetadata is stored as JSON in the metadata of a download task, only the server writes it. Its
+// keys are the ones download tasks were created with before it was typed, the database generates the
+// columns download task lists are searched by from some of them.
+type DownloadTaskMetadata struct {
+	// StorageFileName is the name of the file in its storage tier.
+	StorageFileName  string `json:"file-name,omitempty"`
+	StorageTier      string `json:"storage-tier,omitempty"`
+	CompressionCodec string `json:"compression-codec,omitempty"`
+	// FileName is the name the file is saved with.
+	FileName string `json:"display-file-name,omitempty"`
+	// FileSize is the size of the file once decompressed, files downloaded before it was recorded are never
+	// compressed.
+	FileSize       *int64 `json:"file-size,omitempty"`
+	ContentType    string `json:"Content-Type,omitempty"`
+	ChecksumSHA256 string `json:"checksum-sha256,omitempty"`
+	DownloadedSize int64  `json:"downloaded-size,omitempty"`
+	TotalSize      int64  `json:"total-size,omitempty"`
+	Error          string `json:"error,omitempty"`
+
+	// unknownFields keeps the keys clients wrote before the metadata was typed, so that rewriting the metadata
+	// does not lose them.
+	unknownFields map[string]json.RawMessage
+}
+
+func parseDownloadTaskMetadata(metadata string) (DownloadTaskMetadata, error) {
+	var downloadTaskMetadata DownloadTaskMetadata
+	if err := json.Unmarshal([]byte(metadata), &downloadTaskMetadata); err != nil {
+		return DownloadTaskMetadata{}, fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(metadata), &fields); err != nil {
+		return DownloadTaskMetadata{}, fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+
+	for key, value := range fields {
+		if _, ok := downloadTaskMetadataKeySet[strings.ToLower(key)]; ok {
+			continue
+		}
+
+		if downloadTaskMetadata.unknownFields == nil {
+			downloadTaskMetadata.unknownFields = make(map[string]json.RawMessage)
+		}
+
+		downloadTaskMetadata.unknownFields[key] = value
+	}
+
+	return downloadTaskMetadata, nil
+}
+
+func (m DownloadTaskMetadata) marshal() (string, error) {
+	jsonMetadata, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	if len(m.unknownFields) == 0 {
+		return string(jsonMetadata), nil
+	}
+
+	fields := make(map[string]json.RawMessage, len(m.unknownFields))
+	for key, value := range m.unknownFields {
+		fields[key] = value
+	}
+
+	if err = json.Unmarshal(jsonMetadata, &fields); err != nil {
+		return "", fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	jsonMetadata, err = json.Marshal(fields)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	return string(jsonMetadata), nil
+}
+
+func getLowerCasedJSONKeySet(structType reflect.Type) map[string]struct{} {
+	keySet := make(map[string]struct{})
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if key == "" {
+			key = field.Name
+		}
+
+		keySet[strings.ToLower(key)] = struct{}{}
+	}
+
+	return keySet
+}
+
+func (m DownloadTaskMetadata) toProto() *idm.DownloadTaskMetadata {
+	protoMetadata := &idm.DownloadTaskMetadata{
+		FileName:       m.FileName,
+		ContentType:    m.ContentType,
+		ChecksumSha256: m.ChecksumSHA256,
+		DownloadedSize: uint64(max(m.DownloadedSize, 0)),
+		TotalSize:      uint64(max(m.TotalSize, 0)),
+		Error:          m.Error,
+	}
+	if m.FileSize != nil {
+		protoMetadata.FileSize = uint64(max(*m.FileSize, 0))
+	}
+
+	return protoMetadata
+}
+
+// parseDownloadTaskLabels returns no labels for download tasks created before labels were introduced.
+func parseDownloadTaskLabels(labels string) (map[string]string, error) {
+	downloadTaskLabels := make(map[string]string)
+	if labels == "" {
+		return downloadTaskLabels, nil
+	}
+
+	if err := json.Unmarshal([]byte(labels), &downloadTaskLabels); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal labels: %w", err)
+	}
+
+	return downloadTaskLabels, nil
+}
+
+// getUpdatedDownloadTaskLabels returns the labels as JSON once setLabels are set and deleteLabelKeys deleted.
+func getUpdatedDownloadTaskLabels(labels string, setLabels map[string]string, deleteLabelKeys []string) (string, error) {
+	downloadTaskLabels, err := parseDownloadTaskLabels(labels)
+	if err != nil {
+		return "", err
+	}
+
+	for key, value := range setLabels {
+		downloadTaskLabels[key] = value
+	}
+
+	for _, key := range deleteLabelKeys {
+		delete(downloadTaskLabels, key)
+	}
+
+	if len(downloadTaskLabels) > downloadTaskLabelMaxCount {
+		return "", errTooManyDownloadTaskLabels
+	}
+
+	jsonLabels, err := json.Marshal(downloadTaskLabels)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal labels: %w", err)
+	}
+
+	return string(jsonLabels), nil
+}
+
+// getProtoDownloadTaskMetadata returns empty metadata for download tasks whose metadata clients overwrote
+// before it was typed.
+func getProtoDownloadTaskMetadata(downloadTask database.DownloadTask) *idm.DownloadTaskMetadata {
+	downloadTaskMetadata, err := parseDownloadTaskMetadata(downloadTask.Metadata)
+	if err != nil {
+		return &idm.DownloadTaskMetadata{}
+	}
+
+	return downloadTaskMetadata.toProto()
+}
+
+func getProtoDownloadTaskLabels(downloadTask database.DownloadTask) map[string]string {
+	downloadTaskLabels, err := parseDownloadTaskLabels(downloadTask.Labels)
+	if err != nil {
+		return nil
+	}
+
+	return downloadTaskLabels
+}
@@ -0,0 +1,94 @@
+package logic
+
+import (
+	"testing"
+)
+
+func TestDownloadTaskMetadataRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata string
+		expected string
+	}{
+		{
+			name:     "typed keys",
+			metadata: `{"file-name":"1","storage-tier":"hot","downloaded-size":10}`,
+			expected: `{"file-name":"1","storage-tier":"hot","downloaded-size":10}`,
+		},
+		{
+			name:     "unknown keys",
+			metadata: `{"file-name":"1","source":"rss","tags":["a","b"]}`,
+			expected: `{"file-name":"1","source":"rss","tags":["a","b"]}`,
+		},
+		{
+			name:     "typed key of another case",
+			metadata: `{"content-type":"text/plain","source":"rss"}`,
+			expected: `{"Content-Type":"text/plain","source":"rss"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			downloadTaskMetadata, err := parseDownloadTaskMetadata(testCase.metadata)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			jsonMetadata, err := downloadTaskMetadata.marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if jsonMetadata != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, jsonMetadata)
+			}
+		})
+	}
+}
+
+func TestDownloadTaskMetadataUpdateKeepsUnknownKeys(t *testing.T) {
+	downloadTaskMetadata, err := parseDownloadTaskMetadata(`{"storage-tier":"hot","source":"rss"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	downloadTaskMetadata.StorageTier = "cold"
+	jsonMetadata, err := downloadTaskMetadata.marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const expected = `{"source":"rss","storage-tier":"cold"}`
+	if jsonMetadata != expected {
+		t.Errorf("expected %s, got %s", expected, jsonMetadata)
+	}
+}
+
+func TestGetUpdatedDownloadTaskLabels(t *testing.T) {
+	testCases := []struct {
+		name            string
+		labels          string
+		setLabels       map[string]string
+		deleteLabelKeys []string
+		expected        string
+		expectErr       bool
+	}{
+		{name: "no labels", labels: "", setLabels: map[string]string{"a": "1"}, expected: `{"a":"1"}`},
+		{name: "set and delete", labels: `{"a":"1","b":"2"}`, setLabels: map[string]string{"a": "3"}, deleteLabelKeys: []string{"b"}, expected: `{"a":"3"}`},
+		{name: "delete missing key", labels: `{"a":"1"}`, deleteLabelKeys: []string{"b"}, expected: `{"a":"1"}`},
+		{name: "invalid labels", labels: `not json`, expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			labels, err := getUpdatedDownloadTaskLabels(testCase.labels, testCase.setLabels, testCase.deleteLabelKeys)
+			if (err != nil) != testCase.expectErr {
+				t.Fatalf("expected error %t, got %v", testCase.expectErr, err)
+			}
+
+			if labels != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, labels)
+			}
+		})
+	}
+}
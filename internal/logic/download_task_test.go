@@ -0,0 +1,104 @@
+package logic
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDownloadTaskListPageTokenRoundTrip(t *testing.T) {
+	fileSize := uint64(1024)
+	createTime := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		cursor         database.DownloadTaskListCursor
+		sortField      idm.DownloadTaskListSortField
+		sortDescending bool
+	}{
+		{
+			name:           "create time",
+			cursor:         database.DownloadTaskListCursor{DownloadTaskID: 1, CreateTime: createTime},
+			sortField:      idm.DownloadTaskListSortField_SortByCreateTime,
+			sortDescending: true,
+		},
+		{
+			name:      "file size",
+			cursor:    database.DownloadTaskListCursor{DownloadTaskID: 2, CreateTime: createTime, FileSize: &fileSize},
+			sortField: idm.DownloadTaskListSortField_SortByFileSize,
+		},
+		{
+			name:      "no file size",
+			cursor:    database.DownloadTaskListCursor{DownloadTaskID: 3, CreateTime: createTime},
+			sortField: idm.DownloadTaskListSortField_SortByFileSize,
+		},
+		{
+			name:           "download status",
+			cursor:         database.DownloadTaskListCursor{DownloadTaskID: 4, CreateTime: createTime, DownloadStatus: uint16(idm.DownloadStatus_Failed)},
+			sortField:      idm.DownloadTaskListSortField_SortByDownloadStatus,
+			sortDescending: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pageToken, err := encodeDownloadTaskListPageToken(testCase.cursor, testCase.sortField, testCase.sortDescending)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cursor, err := decodeDownloadTaskListPageToken(pageToken, testCase.sortField, testCase.sortDescending)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if cursor.DownloadTaskID != testCase.cursor.DownloadTaskID ||
+				!cursor.CreateTime.Equal(testCase.cursor.CreateTime) ||
+				cursor.DownloadStatus != testCase.cursor.DownloadStatus {
+				t.Errorf("expected %+v, got %+v", testCase.cursor, cursor)
+			}
+
+			if (cursor.FileSize == nil) != (testCase.cursor.FileSize == nil) ||
+				(cursor.FileSize != nil && *cursor.FileSize != *testCase.cursor.FileSize) {
+				t.Errorf("expected file size %v, got %v", testCase.cursor.FileSize, cursor.FileSize)
+			}
+		})
+	}
+}
+
+func TestDecodeInvalidDownloadTaskListPageToken(t *testing.T) {
+	pageToken, err := encodeDownloadTaskListPageToken(
+		database.DownloadTaskListCursor{DownloadTaskID: 1},
+		idm.DownloadTaskListSortField_SortByCreateTime,
+		true,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name           string
+		pageToken      string
+		sortField      idm.DownloadTaskListSortField
+		sortDescending bool
+	}{
+		{name: "not base64", pageToken: "not base64!", sortField: idm.DownloadTaskListSortField_SortByCreateTime, sortDescending: true},
+		{name: "not json", pageToken: base64.RawURLEncoding.EncodeToString([]byte("not json")), sortField: idm.DownloadTaskListSortField_SortByCreateTime, sortDescending: true},
+		{name: "other sort field", pageToken: pageToken, sortField: idm.DownloadTaskListSortField_SortByFileSize, sortDescending: true},
+		{name: "other sort order", pageToken: pageToken, sortField: idm.DownloadTaskListSortField_SortByCreateTime, sortDescending: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := decodeDownloadTaskListPageToken(testCase.pageToken, testCase.sortField, testCase.sortDescending)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("expected code %s, got %v", codes.InvalidArgument, err)
+			}
+		})
+	}
+}
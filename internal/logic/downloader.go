@@ -10,11 +10,15 @@ import (
 
 const (
 	HTTPMetadataKeyContentType    = "Content-Type"
+	HTTPMetadataKeyContentLength  = "Content-Length"
 	HTTPResponseHeaderContentType = "Content-Type"
 )
 
+// WriterFactory creates the writer of the downloaded content once its metadata, such as its content type, is known.
+type WriterFactory func(metadata map[string]any) (io.Writer, error)
+
 type Downloader interface {
-	Download(ctx context.Context, writer io.Writer) (map[string]any, error)
+	Download(ctx context.Context, writerFactory WriterFactory) (map[string]any, error)
 }
 
 func NewHTTPDownloader(
@@ -37,7 +41,7 @@ type httpDownloader struct {
 }
 
 // Download implements Downloader.
-func (h *httpDownloader) Download(ctx context.Context, writer io.Writer) (map[string]any, error) {
+func (h *httpDownloader) Download(ctx context.Context, writerFactory WriterFactory) (map[string]any, error) {
 	logger := utils.LoggerWithContext(ctx, h.logger)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.url, http.NoBody)
@@ -53,14 +57,22 @@ func (h *httpDownloader) Download(ctx context.Context, writer io.Writer) (map[st
 	}
 	defer resp.Body.Close()
 
-	_, err = io.Copy(writer, resp.Body)
+	// The content length is -1 if the server did not send it.
+	metadata := map[string]any{
+		HTTPMetadataKeyContentType:   resp.Header.Get(HTTPResponseHeaderContentType),
+		HTTPMetadataKeyContentLength: resp.ContentLength,
+	}
+
+	writer, err := writerFactory(metadata)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to copy http response body to file writer")
+		logger.With(zap.Error(err)).Error("can not create file writer")
 		return nil, err
 	}
 
-	metadata := map[string]any{
-		HTTPMetadataKeyContentType: resp.Header.Get(HTTPResponseHeaderContentType),
+	_, err = io.Copy(writer, resp.Body)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to copy http response body to file writer")
+		return nil, err
 	}
 
 	return metadata, nil
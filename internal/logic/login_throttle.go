@@ -0,0 +1,190 @@
+package logic
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/cache"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	auditEventLoginLockout = "login_lockout"
+)
+
+// LoginThrottleLogic delays logins after failed ones and locks them out after too many, per account name and
+// per IP address. Account names are throttled whether an account has them or not, so that throttling does
+// not tell which accounts exist. Logins of an account name are only locked out from the IP addresses failing
+// them and just delayed from the others, so that nobody can lock an account out by failing its logins.
+type LoginThrottleLogic interface {
+	// CheckLoginAllowed returns a ResourceExhausted error while logins of the account name or of the IP address
+	// are delayed or locked out.
+	CheckLoginAllowed(ctx context.Context, accountName string, ipAddress string) error
+	RecordFailedLogin(ctx context.Context, accountName string, ipAddress string)
+	// RecordSuccessfulLogin forgets the failed logins of the account name, but not of the IP address.
+	RecordSuccessfulLogin(ctx context.Context, accountName string, ipAddress string)
+}
+
+func NewLoginThrottleLogic(
+	failedLoginAttemptCache cache.FailedLoginAttempt,
+	logger *zap.Logger,
+	authConfig configs.Auth,
+) LoginThrottleLogic {
+	return &loginThrottleLogic{
+		failedLoginAttemptCache: failedLoginAttemptCache,
+		logger:                  logger,
+		loginThrottleConfig:     authConfig.LoginThrottle,
+	}
+}
+
+type loginThrottleLogic struct {
+	failedLoginAttemptCache cache.FailedLoginAttempt
+	logger                  *zap.Logger
+	loginThrottleConfig     configs.LoginThrottle
+}
+
+type loginThrottleSubject struct {
+	key   string
+	limit configs.LoginThrottleLimit
+}
+
+// CheckLoginAllowed implements LoginThrottleLogic.
+func (l *loginThrottleLogic) CheckLoginAllowed(ctx context.Context, accountName string, ipAddress string) error {
+	if !l.loginThrottleConfig.Enabled {
+		return nil
+	}
+
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	for _, subject := range l.getSubjectList(accountName, ipAddress) {
+		// A cache outage should not stop every login, only the throttling.
+		blockedUntil, err := l.failedLoginAttemptCache.GetBlockedUntil(ctx, subject.key)
+		if err != nil {
+			logger.With(zap.Error(err)).With(zap.String("subject", subject.key)).Error("failed to get login blocked time")
+			continue
+		}
+
+		if wait := time.Until(blockedUntil); wait > 0 {
+			return status.Errorf(
+				codes.ResourceExhausted,
+				"too many failed logins, try again in %d seconds",
+				int64(math.Ceil(wait.Seconds())),
+			)
+		}
+	}
+
+	return nil
+}
+
+// RecordFailedLogin implements LoginThrottleLogic.
+func (l *loginThrottleLogic) RecordFailedLogin(ctx context.Context, accountName string, ipAddress string) {
+	if !l.loginThrottleConfig.Enabled {
+		return
+	}
+
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	for _, subject := range l.getSubjectList(accountName, ipAddress) {
+		subjectLogger := logger.With(zap.String("subject", subject.key))
+
+		count, err := l.failedLoginAttemptCache.Increment(ctx, subject.key, l.loginThrottleConfig.GetFailedAttemptWindow())
+		if err != nil {
+			subjectLogger.With(zap.Error(err)).Error("failed to count failed login")
+			continue
+		}
+
+		delay := l.getDelay(count, subject.limit)
+		if subject.limit.LockoutThreshold > 0 && count >= subject.limit.LockoutThreshold {
+			delay = l.loginThrottleConfig.GetLockoutDuration()
+			subjectLogger.
+				With(zap.String("auditEvent", auditEventLoginLockout)).
+				With(zap.Uint64("failedAttemptCount", count)).
+				With(zap.Duration("lockoutDuration", delay)).
+				Warn("logins locked out after too many failed logins")
+		}
+
+		if delay <= 0 {
+			continue
+		}
+
+		if err = l.failedLoginAttemptCache.SetBlockedUntil(ctx, subject.key, time.Now().Add(delay)); err != nil {
+			subjectLogger.With(zap.Error(err)).Error("failed to set login blocked time")
+		}
+	}
+}
+
+// RecordSuccessfulLogin implements LoginThrottleLogic.
+func (l *loginThrottleLogic) RecordSuccessfulLogin(ctx context.Context, accountName string, ipAddress string) {
+	if !l.loginThrottleConfig.Enabled {
+		return
+	}
+
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	for _, subject := range l.getSubjectList(accountName, ipAddress) {
+		if subject.key == getIPAddressLoginThrottleSubjectKey(ipAddress) {
+			continue
+		}
+
+		if err := l.failedLoginAttemptCache.Reset(ctx, subject.key); err != nil {
+			logger.With(zap.Error(err)).With(zap.String("subject", subject.key)).Error("failed to reset failed logins")
+		}
+	}
+}
+
+func (l *loginThrottleLogic) getSubjectList(accountName string, ipAddress string) []loginThrottleSubject {
+	// Logins of the account name from any IP address are only delayed, never locked out.
+	accountNameLimit := l.loginThrottleConfig.Account
+	accountNameLimit.LockoutThreshold = 0
+
+	subjectList := []loginThrottleSubject{
+		{
+			key:   getAccountNameLoginThrottleSubjectKey(accountName),
+			limit: accountNameLimit,
+		},
+	}
+
+	if ipAddress != "" {
+		subjectList = append(
+			subjectList,
+			loginThrottleSubject{
+				key:   getAccountNameLoginThrottleSubjectKey(accountName) + ":" + getIPAddressLoginThrottleSubjectKey(ipAddress),
+				limit: l.loginThrottleConfig.Account,
+			},
+			loginThrottleSubject{
+				key:   getIPAddressLoginThrottleSubjectKey(ipAddress),
+				limit: l.loginThrottleConfig.IPAddress,
+			},
+		)
+	}
+
+	return subjectList
+}
+
+// getDelay doubles the delay with each failed login after the free ones, up to the max delay.
+func (l *loginThrottleLogic) getDelay(count uint64, limit configs.LoginThrottleLimit) time.Duration {
+	if count <= limit.FreeAttemptCount {
+		return 0
+	}
+
+	maxDelay := l.loginThrottleConfig.GetMaxDelay()
+	delay := l.loginThrottleConfig.GetBaseDelay()
+	for i := limit.FreeAttemptCount + 1; i < count && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
+
+func getAccountNameLoginThrottleSubjectKey(accountName string) string {
+	return "account_name:" + accountName
+}
+
+func getIPAddressLoginThrottleSubjectKey(ipAddress string) string {
+	return "ip_address:" + ipAddress
+}
@@ -0,0 +1,153 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/cache"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testLoginThrottleConfig = configs.LoginThrottle{
+	Enabled:             true,
+	FailedAttemptWindow: 900,
+	BaseDelay:           1,
+	MaxDelay:            60,
+	LockoutDuration:     900,
+	Account:             configs.LoginThrottleLimit{FreeAttemptCount: 2, LockoutThreshold: 5},
+	IPAddress:           configs.LoginThrottleLimit{FreeAttemptCount: 10, LockoutThreshold: 20},
+}
+
+func newTestLoginThrottleLogic(t *testing.T, loginThrottleConfig configs.LoginThrottle) (*loginThrottleLogic, cache.FailedLoginAttempt) {
+	t.Helper()
+
+	cacheClient, err := cache.NewInMemoryClient(configs.Cache{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failedLoginAttemptCache, err := cache.NewFailedLoginAttempt(cacheClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loginThrottle := NewLoginThrottleLogic(failedLoginAttemptCache, zap.NewNop(), configs.Auth{LoginThrottle: loginThrottleConfig})
+	return loginThrottle.(*loginThrottleLogic), failedLoginAttemptCache
+}
+
+func TestLoginThrottleGetDelay(t *testing.T) {
+	l, _ := newTestLoginThrottleLogic(t, testLoginThrottleConfig)
+
+	testCases := []struct {
+		count    uint64
+		expected time.Duration
+	}{
+		{count: 1, expected: 0},
+		{count: 2, expected: 0},
+		{count: 3, expected: time.Second},
+		{count: 4, expected: 2 * time.Second},
+		{count: 5, expected: 4 * time.Second},
+		{count: 8, expected: 32 * time.Second},
+		{count: 9, expected: time.Minute},
+		{count: 100, expected: time.Minute},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(strconv.FormatUint(testCase.count, 10), func(t *testing.T) {
+			if delay := l.getDelay(testCase.count, testLoginThrottleConfig.Account); delay != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, delay)
+			}
+		})
+	}
+}
+
+func TestLoginThrottle(t *testing.T) {
+	const (
+		accountName      = "someone"
+		ipAddress        = "203.0.113.1"
+		otherIPAddress   = "203.0.113.2"
+		otherAccountName = "someone-else"
+	)
+
+	ctx := context.Background()
+	l, failedLoginAttemptCache := newTestLoginThrottleLogic(t, testLoginThrottleConfig)
+
+	checkLoginAllowed := func(t *testing.T, accountName string, ipAddress string, expectAllowed bool) {
+		t.Helper()
+
+		err := l.CheckLoginAllowed(ctx, accountName, ipAddress)
+		if (err == nil) != expectAllowed {
+			t.Fatalf("expected login allowed %t, got %v", expectAllowed, err)
+		}
+
+		if err != nil && status.Code(err) != codes.ResourceExhausted {
+			t.Fatalf("expected code %s, got %v", codes.ResourceExhausted, err)
+		}
+	}
+
+	for i := uint64(0); i < testLoginThrottleConfig.Account.FreeAttemptCount; i++ {
+		l.RecordFailedLogin(ctx, accountName, ipAddress)
+	}
+	checkLoginAllowed(t, accountName, ipAddress, true)
+
+	l.RecordFailedLogin(ctx, accountName, ipAddress)
+	checkLoginAllowed(t, accountName, ipAddress, false)
+	checkLoginAllowed(t, accountName, otherIPAddress, false)
+	checkLoginAllowed(t, otherAccountName, ipAddress, true)
+
+	l.RecordSuccessfulLogin(ctx, accountName, ipAddress)
+	checkLoginAllowed(t, accountName, ipAddress, true)
+	checkLoginAllowed(t, accountName, otherIPAddress, true)
+
+	for i := uint64(0); i < testLoginThrottleConfig.Account.LockoutThreshold; i++ {
+		l.RecordFailedLogin(ctx, accountName, ipAddress)
+	}
+
+	// Only the IP address failing the logins is locked out, the others are delayed.
+	accountNameAndIPAddressKey := getAccountNameLoginThrottleSubjectKey(accountName) + ":" + getIPAddressLoginThrottleSubjectKey(ipAddress)
+	blockedUntil, err := failedLoginAttemptCache.GetBlockedUntil(ctx, accountNameAndIPAddressKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wait := time.Until(blockedUntil); wait <= testLoginThrottleConfig.GetMaxDelay() {
+		t.Errorf("expected a lockout, logins are blocked for %s", wait)
+	}
+
+	blockedUntil, err = failedLoginAttemptCache.GetBlockedUntil(ctx, getAccountNameLoginThrottleSubjectKey(accountName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wait := time.Until(blockedUntil); wait <= 0 || wait > testLoginThrottleConfig.GetMaxDelay() {
+		t.Errorf("expected a delay, logins are blocked for %s", wait)
+	}
+
+	// The failed logins of the IP address are not forgotten by a successful login.
+	l.RecordSuccessfulLogin(ctx, accountName, ipAddress)
+	count, err := failedLoginAttemptCache.Increment(ctx, getIPAddressLoginThrottleSubjectKey(ipAddress), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := testLoginThrottleConfig.Account.FreeAttemptCount + 1 + testLoginThrottleConfig.Account.LockoutThreshold + 1; count != expected {
+		t.Errorf("expected %d failed logins of the ip address, got %d", expected, count)
+	}
+}
+
+func TestLoginThrottleDisabled(t *testing.T) {
+	loginThrottleConfig := testLoginThrottleConfig
+	loginThrottleConfig.Enabled = false
+	l, _ := newTestLoginThrottleLogic(t, loginThrottleConfig)
+
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		l.RecordFailedLogin(ctx, "someone", "203.0.113.1")
+	}
+
+	if err := l.CheckLoginAllowed(ctx, "someone", "203.0.113.1"); err != nil {
+		t.Errorf("expected login allowed, got %v", err)
+	}
+}
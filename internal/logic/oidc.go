@@ -0,0 +1,432 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/cache"
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	oidcCallbackPathFormat          = "/api/v1/oidc/providers/%s/callback"
+	oidcRandomValueSizeInBytes      = 32
+	oidcAccountNameMinLength        = 6
+	oidcAccountNameBaseMaxLength    = 24
+	oidcAccountNameSuffixSizeInByte = 4
+	oidcAccountNameMaxAttempts      = 5
+	oidcDefaultAccountNamePrefix    = "user"
+)
+
+var (
+	oidcAccountNameInvalidCharacterRegexp = regexp.MustCompile("[^a-zA-Z0-9]")
+
+	errOIDCLoginStateInvalid = errors.New("oidc login state is invalid")
+)
+
+type StartOIDCLoginInput struct {
+	// Token is the authentication token of the current session if any, the external identity is
+	// linked to its account instead of logging in.
+	Token        string
+	ProviderName string
+}
+
+type StartOIDCLoginOutput struct {
+	AuthorizationURL string
+	// State has to be kept by the browser and given back to FinishOIDCLogin, binding the login to it.
+	State          string
+	StateExpiresAt time.Time
+}
+
+type FinishOIDCLoginInput struct {
+	ProviderName string
+	Code         string
+	State        string
+	// BrowserState is the state kept by the browser when the login started.
+	BrowserState string
+	// Error is set by the provider when the user did not grant access.
+	Error     string
+	UserAgent string
+	IPAddress string
+}
+
+type FinishOIDCLoginOutput struct {
+	Session              CreateSessionOutput
+	PostLoginRedirectURL string
+}
+
+// OIDCLogic logs accounts in with external OpenID Connect providers using the authorization code flow with PKCE.
+// Accounts are provisioned on their first login.
+type OIDCLogic interface {
+	GetOIDCProviderNameList(ctx context.Context) []string
+	StartOIDCLogin(ctx context.Context, in StartOIDCLoginInput) (StartOIDCLoginOutput, error)
+	FinishOIDCLogin(ctx context.Context, in FinishOIDCLoginInput) (FinishOIDCLoginOutput, error)
+}
+
+func NewOIDCLogic(
+	database database.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	accountExternalIdentityDataAccessor database.AccountExternalIdentityDataAccessor,
+	accountLogic AccountLogic,
+	tokenLogic TokenLogic,
+	workspaceLogic WorkspaceLogic,
+	oidcLoginStateCache cache.OIDCLoginState,
+	takenAccountNameCache cache.TakenAccountName,
+	oidcConfig configs.OIDC,
+	logger *zap.Logger,
+) (OIDCLogic, error) {
+	providers := make(map[string]*oidcProvider)
+	providerNameList := make([]string, 0, len(oidcConfig.Providers))
+	for _, providerConfig := range oidcConfig.Providers {
+		if providerConfig.Name == "" || providerConfig.IssuerURL == "" || providerConfig.ClientID == "" {
+			return nil, errors.New("oidc provider must have a name, an issuer url and a client id")
+		}
+
+		if _, ok := providers[providerConfig.Name]; ok {
+			return nil, fmt.Errorf("duplicated oidc provider %s", providerConfig.Name)
+		}
+
+		providers[providerConfig.Name] = newOIDCProvider(providerConfig)
+		providerNameList = append(providerNameList, providerConfig.Name)
+	}
+
+	return &oidcLogic{
+		database:                            database,
+		accountDataAccessor:                 accountDataAccessor,
+		accountExternalIdentityDataAccessor: accountExternalIdentityDataAccessor,
+		accountLogic:                        accountLogic,
+		tokenLogic:                          tokenLogic,
+		workspaceLogic:                      workspaceLogic,
+		oidcLoginStateCache:                 oidcLoginStateCache,
+		takenAccountNameCache:               takenAccountNameCache,
+		oidcConfig:                          oidcConfig,
+		providers:                           providers,
+		providerNameList:                    providerNameList,
+		logger:                              logger,
+	}, nil
+}
+
+type oidcLogic struct {
+	database                            database.Database
+	accountDataAccessor                 database.AccountDataAccessor
+	accountExternalIdentityDataAccessor database.AccountExternalIdentityDataAccessor
+	accountLogic                        AccountLogic
+	tokenLogic                          TokenLogic
+	workspaceLogic                      WorkspaceLogic
+	oidcLoginStateCache                 cache.OIDCLoginState
+	takenAccountNameCache               cache.TakenAccountName
+	oidcConfig                          configs.OIDC
+	providers                           map[string]*oidcProvider
+	providerNameList                    []string
+	logger                              *zap.Logger
+}
+
+// GetOIDCProviderNameList implements OIDCLogic.
+func (o *oidcLogic) GetOIDCProviderNameList(ctx context.Context) []string {
+	return o.providerNameList
+}
+
+// StartOIDCLogin implements OIDCLogic.
+func (o *oidcLogic) StartOIDCLogin(ctx context.Context, in StartOIDCLoginInput) (StartOIDCLoginOutput, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.String("provider", in.ProviderName))
+
+	provider, ok := o.providers[in.ProviderName]
+	if !ok {
+		return StartOIDCLoginOutput{}, status.Error(codes.NotFound, "oidc provider not found")
+	}
+
+	var accountID uint64
+	if in.Token != "" {
+		// A stale cookie should not prevent logging in again.
+		tokenAccountID, _, err := o.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+		if err != nil {
+			logger.With(zap.Error(err)).Info("ignoring invalid authentication token when starting oidc login")
+		} else {
+			accountID = tokenAccountID
+		}
+	}
+
+	state, err := generateOIDCRandomValue()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate state")
+		return StartOIDCLoginOutput{}, status.Error(codes.Internal, "failed to start oidc login")
+	}
+
+	nonce, err := generateOIDCRandomValue()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate nonce")
+		return StartOIDCLoginOutput{}, status.Error(codes.Internal, "failed to start oidc login")
+	}
+
+	codeVerifier, err := generateOIDCRandomValue()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate code verifier")
+		return StartOIDCLoginOutput{}, status.Error(codes.Internal, "failed to start oidc login")
+	}
+
+	codeChallenge := sha256.Sum256([]byte(codeVerifier))
+	authorizationURL, err := provider.getAuthorizationURL(
+		ctx,
+		o.getCallbackURL(in.ProviderName),
+		state,
+		nonce,
+		base64.RawURLEncoding.EncodeToString(codeChallenge[:]),
+	)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get authorization url")
+		return StartOIDCLoginOutput{}, status.Error(codes.Unavailable, "oidc provider is unavailable")
+	}
+
+	stateExpiresAt := time.Now().Add(o.oidcConfig.GetLoginStateDuration())
+	err = o.oidcLoginStateCache.Set(ctx, state, cache.OIDCLoginStateValue{
+		Provider:     in.ProviderName,
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		AccountID:    accountID,
+	}, o.oidcConfig.GetLoginStateDuration())
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to set oidc login state in cache")
+		return StartOIDCLoginOutput{}, status.Error(codes.Internal, "failed to start oidc login")
+	}
+
+	return StartOIDCLoginOutput{
+		AuthorizationURL: authorizationURL,
+		State:            state,
+		StateExpiresAt:   stateExpiresAt,
+	}, nil
+}
+
+// FinishOIDCLogin implements OIDCLogic.
+func (o *oidcLogic) FinishOIDCLogin(ctx context.Context, in FinishOIDCLoginInput) (FinishOIDCLoginOutput, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.String("provider", in.ProviderName))
+
+	if in.Error != "" {
+		return FinishOIDCLoginOutput{}, status.Errorf(codes.Unauthenticated, "oidc provider returned an error: %s", in.Error)
+	}
+
+	provider, ok := o.providers[in.ProviderName]
+	if !ok {
+		return FinishOIDCLoginOutput{}, status.Error(codes.NotFound, "oidc provider not found")
+	}
+
+	loginState, err := o.popLoginState(ctx, in)
+	if err != nil {
+		if errors.Is(err, errOIDCLoginStateInvalid) {
+			return FinishOIDCLoginOutput{}, status.Error(codes.InvalidArgument, "oidc login state is invalid or expired")
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get oidc login state")
+		return FinishOIDCLoginOutput{}, status.Error(codes.Internal, "failed to finish oidc login")
+	}
+
+	idToken, err := provider.exchangeCode(ctx, in.Code, o.getCallbackURL(in.ProviderName), loginState.CodeVerifier)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to exchange authorization code")
+		return FinishOIDCLoginOutput{}, status.Error(codes.Unauthenticated, "failed to exchange authorization code")
+	}
+
+	claims, err := provider.verifyIDToken(ctx, idToken, loginState.Nonce)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify id token")
+		return FinishOIDCLoginOutput{}, status.Error(codes.Unauthenticated, "id token is invalid")
+	}
+
+	accountID, err := o.getOrCreateLinkedAccountID(ctx, in.ProviderName, claims, loginState.AccountID)
+	if err != nil {
+		return FinishOIDCLoginOutput{}, err
+	}
+
+	session, err := o.accountLogic.CreateSessionOfAccount(ctx, CreateSessionOfAccountInput{
+		AccountID: accountID,
+		UserAgent: in.UserAgent,
+		IPAddress: in.IPAddress,
+	})
+	if err != nil {
+		return FinishOIDCLoginOutput{}, err
+	}
+
+	postLoginRedirectURL := o.oidcConfig.PostLoginRedirectURL
+	if session.TOTPChallenge != "" {
+		// The challenge is passed in the fragment, which browsers do not send to servers, for the post login
+		// page to complete the login with a TOTP code.
+		postLoginRedirectURL += "#totp_challenge=" + url.QueryEscape(session.TOTPChallenge)
+	}
+
+	return FinishOIDCLoginOutput{
+		Session:              session,
+		PostLoginRedirectURL: postLoginRedirectURL,
+	}, nil
+}
+
+func (o *oidcLogic) popLoginState(ctx context.Context, in FinishOIDCLoginInput) (cache.OIDCLoginStateValue, error) {
+	// The state must come back from the browser that started the login, otherwise an attacker could
+	// log a victim into the attacker's account.
+	if in.State == "" || subtle.ConstantTimeCompare([]byte(in.State), []byte(in.BrowserState)) != 1 {
+		return cache.OIDCLoginStateValue{}, errOIDCLoginStateInvalid
+	}
+
+	loginState, err := o.oidcLoginStateCache.Pop(ctx, in.State)
+	if err != nil {
+		if errors.Is(err, cache.ErrCacheMissed) {
+			return cache.OIDCLoginStateValue{}, errOIDCLoginStateInvalid
+		}
+
+		return cache.OIDCLoginStateValue{}, err
+	}
+
+	if loginState.Provider != in.ProviderName {
+		return cache.OIDCLoginStateValue{}, errOIDCLoginStateInvalid
+	}
+
+	return loginState, nil
+}
+
+// getOrCreateLinkedAccountID returns the account linked to an external identity. Unknown identities are linked
+// to linkedAccountID if set, otherwise to a new account.
+func (o *oidcLogic) getOrCreateLinkedAccountID(
+	ctx context.Context,
+	providerName string,
+	claims oidcIDTokenClaims,
+	linkedAccountID uint64,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.String("provider", providerName))
+
+	externalIdentity, err := o.accountExternalIdentityDataAccessor.GetAccountExternalIdentity(ctx, providerName, claims.Subject)
+	if err == nil {
+		if linkedAccountID != 0 && externalIdentity.OfAccountID != linkedAccountID {
+			return 0, status.Error(codes.AlreadyExists, "external identity is already linked to another account")
+		}
+
+		return externalIdentity.OfAccountID, nil
+	}
+	if !errors.Is(err, database.ErrAccountExternalIdentityNotFound) {
+		return 0, status.Error(codes.Internal, "failed to get external identity")
+	}
+
+	var createdAccountName string
+	txErr := o.database.Transaction(func(tx *gorm.DB) error {
+		if linkedAccountID == 0 {
+			createdAccount, err := o.createAccount(ctx, tx, claims)
+			if err != nil {
+				return err
+			}
+
+			linkedAccountID = createdAccount.AccountID
+			createdAccountName = createdAccount.AccountName
+		}
+
+		return o.accountExternalIdentityDataAccessor.WithDatabaseTransaction(tx).CreateAccountExternalIdentity(
+			ctx,
+			database.AccountExternalIdentity{
+				OfAccountID: linkedAccountID,
+				Provider:    providerName,
+				Subject:     claims.Subject,
+				Email:       claims.Email,
+				CreateTime:  time.Now(),
+			},
+		)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, database.ErrAccountAlreadyExists) {
+			return 0, status.Error(codes.AlreadyExists, "failed to find an available account name")
+		}
+
+		logger.With(zap.Error(txErr)).Error("link external identity transaction failed")
+		return 0, status.Error(codes.Internal, "failed to link external identity")
+	}
+
+	if createdAccountName != "" {
+		if err = o.takenAccountNameCache.Add(ctx, createdAccountName); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to set account name into taken set in cache")
+		}
+	}
+
+	return linkedAccountID, nil
+}
+
+// createAccount provisions an account named after the claims of an ID token. The name follows the rules of
+// account names, a random suffix is added when it is taken. It returns database.ErrAccountAlreadyExists if no
+// available name is found.
+func (o *oidcLogic) createAccount(ctx context.Context, tx *gorm.DB, claims oidcIDTokenClaims) (database.Account, error) {
+	baseAccountName := getOIDCBaseAccountName(claims)
+	for attempt := 0; attempt < oidcAccountNameMaxAttempts; attempt++ {
+		accountName := baseAccountName
+		if attempt > 0 || len(accountName) < oidcAccountNameMinLength {
+			suffix := make([]byte, oidcAccountNameSuffixSizeInByte)
+			if _, err := rand.Read(suffix); err != nil {
+				return database.Account{}, err
+			}
+
+			accountName += hex.EncodeToString(suffix)
+		}
+
+		foundAccount, err := o.accountDataAccessor.WithDatabaseTransaction(tx).GetAccountByName(ctx, accountName)
+		if err != nil {
+			return database.Account{}, err
+		}
+		if foundAccount.AccountID != 0 {
+			continue
+		}
+
+		// Another login can take the name between the check and the insert, the next attempt adds a suffix.
+		createdAccount, err := o.accountDataAccessor.WithDatabaseTransaction(tx).CreateAccount(ctx, database.Account{
+			AccountName: accountName,
+		})
+		if err != nil {
+			if errors.Is(err, database.ErrAccountAlreadyExists) {
+				continue
+			}
+
+			return database.Account{}, err
+		}
+
+		if err = o.workspaceLogic.WithDatabaseTransaction(tx).CreatePersonalWorkspace(ctx, createdAccount); err != nil {
+			return database.Account{}, err
+		}
+
+		return createdAccount, nil
+	}
+
+	return database.Account{}, database.ErrAccountAlreadyExists
+}
+
+func (o *oidcLogic) getCallbackURL(providerName string) string {
+	return strings.TrimSuffix(o.oidcConfig.BaseURL, "/") + fmt.Sprintf(oidcCallbackPathFormat, providerName)
+}
+
+func getOIDCBaseAccountName(claims oidcIDTokenClaims) string {
+	candidates := []string{claims.PreferredUsername, strings.Split(claims.Email, "@")[0], claims.Name}
+	for _, candidate := range candidates {
+		accountName := oidcAccountNameInvalidCharacterRegexp.ReplaceAllString(candidate, "")
+		if accountName != "" {
+			return truncateString(accountName, oidcAccountNameBaseMaxLength)
+		}
+	}
+
+	return oidcDefaultAccountNamePrefix
+}
+
+func generateOIDCRandomValue() (string, error) {
+	value := make([]byte, oidcRandomValueSizeInBytes)
+	if _, err := rand.Read(value); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(value), nil
+}
@@ -0,0 +1,318 @@
+package logic
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/maxuanquang/idm/internal/configs"
+)
+
+const (
+	oidcDiscoveryPath         = "/.well-known/openid-configuration"
+	oidcHTTPClientTimeout     = 10 * time.Second
+	oidcJWKSMinReloadInterval = time.Minute
+	oidcMaxResponseSize       = 1 << 20
+)
+
+var (
+	defaultOIDCScopes = []string{"openid", "email", "profile"}
+)
+
+type oidcDiscoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcTokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+type oidcIDTokenClaims struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	PreferredUsername string
+	Name              string
+}
+
+// oidcProvider talks to an OpenID Connect provider, its discovery document and signing keys are
+// fetched on first use and kept in memory.
+type oidcProvider struct {
+	config             configs.OIDCProvider
+	httpClient         *http.Client
+	mutex              *sync.Mutex
+	discoveryDocument  *oidcDiscoveryDocument
+	publicKeys         map[string]*rsa.PublicKey
+	publicKeysLoadTime time.Time
+}
+
+func newOIDCProvider(config configs.OIDCProvider) *oidcProvider {
+	return &oidcProvider{
+		config:     config,
+		httpClient: &http.Client{Timeout: oidcHTTPClientTimeout},
+		mutex:      &sync.Mutex{},
+	}
+}
+
+func (o *oidcProvider) getAuthorizationURL(
+	ctx context.Context,
+	redirectURL string,
+	state string,
+	nonce string,
+	codeChallenge string,
+) (string, error) {
+	discoveryDocument, err := o.getDiscoveryDocument(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authorizationURL, err := url.Parse(discoveryDocument.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	scopes := o.config.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultOIDCScopes
+	}
+
+	query := authorizationURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", o.config.ClientID)
+	query.Set("redirect_uri", redirectURL)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	authorizationURL.RawQuery = query.Encode()
+
+	return authorizationURL.String(), nil
+}
+
+// exchangeCode exchanges an authorization code for an ID token.
+func (o *oidcProvider) exchangeCode(ctx context.Context, code string, redirectURL string, codeVerifier string) (string, error) {
+	discoveryDocument, err := o.getDiscoveryDocument(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURL)
+	form.Set("client_id", o.config.ClientID)
+	form.Set("code_verifier", codeVerifier)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, discoveryDocument.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	if o.config.ClientSecret != "" {
+		request.SetBasicAuth(url.QueryEscape(o.config.ClientID), url.QueryEscape(o.config.ClientSecret))
+	}
+
+	var tokenResponse oidcTokenResponse
+	if err = o.doJSONRequest(request, &tokenResponse); err != nil {
+		return "", fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	if tokenResponse.IDToken == "" {
+		return "", errors.New("token response has no id token")
+	}
+
+	return tokenResponse.IDToken, nil
+}
+
+// verifyIDToken checks the signature, issuer, audience, expiry and nonce of an ID token and returns its claims.
+func (o *oidcProvider) verifyIDToken(ctx context.Context, idToken string, nonce string) (oidcIDTokenClaims, error) {
+	discoveryDocument, err := o.getDiscoveryDocument(ctx)
+	if err != nil {
+		return oidcIDTokenClaims{}, err
+	}
+
+	keyFunc := func(parsedToken *jwt.Token) (interface{}, error) {
+		if _, ok := parsedToken.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+
+		kid, _ := parsedToken.Header["kid"].(string)
+		return o.getPublicKey(ctx, discoveryDocument.JWKSURI, kid)
+	}
+
+	parsedToken, err := jwt.Parse(idToken, keyFunc)
+	if err != nil {
+		return oidcIDTokenClaims{}, err
+	}
+
+	claims, ok := parsedToken.Claims.(jwt.MapClaims)
+	if !ok {
+		return oidcIDTokenClaims{}, errors.New("cannot get id token's claims")
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return oidcIDTokenClaims{}, errors.New("id token has expired")
+	}
+
+	if !claims.VerifyIssuer(discoveryDocument.Issuer, true) {
+		return oidcIDTokenClaims{}, errors.New("id token has an unexpected issuer")
+	}
+
+	if !claims.VerifyAudience(o.config.ClientID, true) {
+		return oidcIDTokenClaims{}, errors.New("id token has an unexpected audience")
+	}
+
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return oidcIDTokenClaims{}, errors.New("id token has an unexpected nonce")
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return oidcIDTokenClaims{}, errors.New("id token has no subject")
+	}
+
+	idTokenClaims := oidcIDTokenClaims{Subject: subject}
+	idTokenClaims.Email, _ = claims["email"].(string)
+	idTokenClaims.EmailVerified, _ = claims["email_verified"].(bool)
+	idTokenClaims.PreferredUsername, _ = claims["preferred_username"].(string)
+	idTokenClaims.Name, _ = claims["name"].(string)
+	return idTokenClaims, nil
+}
+
+func (o *oidcProvider) getDiscoveryDocument(ctx context.Context) (oidcDiscoveryDocument, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.discoveryDocument != nil {
+		return *o.discoveryDocument, nil
+	}
+
+	discoveryURL := strings.TrimSuffix(o.config.IssuerURL, "/") + oidcDiscoveryPath
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return oidcDiscoveryDocument{}, err
+	}
+
+	var discoveryDocument oidcDiscoveryDocument
+	if err = o.doJSONRequest(request, &discoveryDocument); err != nil {
+		return oidcDiscoveryDocument{}, fmt.Errorf("failed to get discovery document: %w", err)
+	}
+
+	if discoveryDocument.Issuer != strings.TrimSuffix(o.config.IssuerURL, "/") && discoveryDocument.Issuer != o.config.IssuerURL {
+		return oidcDiscoveryDocument{}, fmt.Errorf("discovery document issuer %s does not match %s", discoveryDocument.Issuer, o.config.IssuerURL)
+	}
+
+	if discoveryDocument.AuthorizationEndpoint == "" || discoveryDocument.TokenEndpoint == "" || discoveryDocument.JWKSURI == "" {
+		return oidcDiscoveryDocument{}, errors.New("discovery document is missing endpoints")
+	}
+
+	o.discoveryDocument = &discoveryDocument
+	return discoveryDocument, nil
+}
+
+// getPublicKey returns a signing key of the provider, the keys are reloaded when kid is unknown as the
+// provider may have rotated them.
+func (o *oidcProvider) getPublicKey(ctx context.Context, jwksURI string, kid string) (*rsa.PublicKey, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if publicKey, ok := o.publicKeys[kid]; ok {
+		return publicKey, nil
+	}
+
+	if o.publicKeys != nil && time.Since(o.publicKeysLoadTime) < oidcJWKSMinReloadInterval {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var jwks JWKS
+	if err = o.doJSONRequest(request, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to get signing keys: %w", err)
+	}
+
+	publicKeys := make(map[string]*rsa.PublicKey)
+	for _, jwk := range jwks.Keys {
+		if jwk.KeyType != jwkKeyTypeRSA || (jwk.Use != "" && jwk.Use != jwkUseSignature) {
+			continue
+		}
+
+		publicKey, err := parseRSAJWK(jwk)
+		if err != nil {
+			continue
+		}
+
+		publicKeys[jwk.KeyID] = publicKey
+	}
+
+	o.publicKeys = publicKeys
+	o.publicKeysLoadTime = time.Now()
+
+	publicKey, ok := publicKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	return publicKey, nil
+}
+
+func (o *oidcProvider) doJSONRequest(request *http.Request, out any) error {
+	response, err := o.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, oidcMaxResponseSize))
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d: %s", response.StatusCode, body)
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+func parseRSAJWK(jwk JWK) (*rsa.PublicKey, error) {
+	modulusBytes, err := base64.RawURLEncoding.DecodeString(jwk.Modulus)
+	if err != nil {
+		return nil, err
+	}
+
+	exponentBytes, err := base64.RawURLEncoding.DecodeString(jwk.Exponent)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent := new(big.Int).SetBytes(exponentBytes)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("rsa exponent is too large")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulusBytes),
+		E: int(exponent.Int64()),
+	}, nil
+}
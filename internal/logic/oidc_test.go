@@ -0,0 +1,282 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/cache"
+	"go.uber.org/zap"
+)
+
+const (
+	testOIDCClientID = "idm-client"
+	testOIDCKeyID    = "key-1"
+)
+
+// testOIDCProvider is an OpenID Connect provider answering the discovery, signing keys and token requests of
+// oidcProvider. The token endpoint returns idToken for any code whose verifier matches codeChallenge.
+type testOIDCProvider struct {
+	server        *httptest.Server
+	privateKey    *rsa.PrivateKey
+	codeChallenge string
+	idToken       string
+}
+
+func newTestOIDCProvider(t *testing.T) *testOIDCProvider {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &testOIDCProvider{privateKey: privateKey}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcDiscoveryPath, func(rw http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(rw).Encode(oidcDiscoveryDocument{
+			Issuer:                p.server.URL,
+			AuthorizationEndpoint: p.server.URL + "/authorize",
+			TokenEndpoint:         p.server.URL + "/token",
+			JWKSURI:               p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(rw http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(rw).Encode(JWKS{Keys: []JWK{{
+			KeyType:  jwkKeyTypeRSA,
+			KeyID:    testOIDCKeyID,
+			Use:      jwkUseSignature,
+			Modulus:  base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+			Exponent: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(rw http.ResponseWriter, r *http.Request) {
+		codeVerifierHash := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if r.PostFormValue("grant_type") != "authorization_code" ||
+			r.PostFormValue("client_id") != testOIDCClientID ||
+			base64.RawURLEncoding.EncodeToString(codeVerifierHash[:]) != p.codeChallenge {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_ = json.NewEncoder(rw).Encode(oidcTokenResponse{IDToken: p.idToken})
+	})
+
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func (p *testOIDCProvider) signIDToken(t *testing.T, keyID string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+	idToken, err := token.SignedString(p.privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return idToken
+}
+
+func newTestOIDCLogic(t *testing.T, provider *testOIDCProvider) *oidcLogic {
+	t.Helper()
+
+	cacheClient, err := cache.NewInMemoryClient(configs.Cache{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oidcLoginStateCache, err := cache.NewOIDCLoginState(cacheClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o, err := NewOIDCLogic(
+		nil, nil, nil, nil, nil, nil,
+		oidcLoginStateCache,
+		nil,
+		configs.OIDC{
+			BaseURL: "https://idm.example.com",
+			Providers: []configs.OIDCProvider{{
+				Name:      "test",
+				IssuerURL: provider.server.URL,
+				ClientID:  testOIDCClientID,
+			}},
+		},
+		zap.NewNop(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return o.(*oidcLogic)
+}
+
+func TestOIDCLogin(t *testing.T) {
+	provider := newTestOIDCProvider(t)
+	o := newTestOIDCLogic(t, provider)
+	ctx := context.Background()
+
+	startOutput, err := o.StartOIDCLogin(ctx, StartOIDCLoginInput{ProviderName: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authorizationURL, err := url.Parse(startOutput.AuthorizationURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := authorizationURL.Query()
+	if query.Get("state") != startOutput.State {
+		t.Errorf("expected state %s, got %s", startOutput.State, query.Get("state"))
+	}
+
+	if query.Get("client_id") != testOIDCClientID || query.Get("code_challenge_method") != "S256" {
+		t.Errorf("unexpected authorization url %s", startOutput.AuthorizationURL)
+	}
+
+	if query.Get("redirect_uri") != "https://idm.example.com/api/v1/oidc/providers/test/callback" {
+		t.Errorf("unexpected redirect uri %s", query.Get("redirect_uri"))
+	}
+
+	_, err = o.popLoginState(ctx, FinishOIDCLoginInput{ProviderName: "test", State: startOutput.State, BrowserState: "other"})
+	if !errors.Is(err, errOIDCLoginStateInvalid) {
+		t.Fatalf("expected %v for a state of another browser, got %v", errOIDCLoginStateInvalid, err)
+	}
+
+	finishInput := FinishOIDCLoginInput{ProviderName: "test", State: startOutput.State, BrowserState: startOutput.State}
+	loginState, err := o.popLoginState(ctx, finishInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = o.popLoginState(ctx, finishInput); !errors.Is(err, errOIDCLoginStateInvalid) {
+		t.Fatalf("expected %v for a used state, got %v", errOIDCLoginStateInvalid, err)
+	}
+
+	provider.codeChallenge = query.Get("code_challenge")
+	provider.idToken = provider.signIDToken(t, testOIDCKeyID, jwt.MapClaims{
+		"iss":   provider.server.URL,
+		"aud":   testOIDCClientID,
+		"sub":   "subject",
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": loginState.Nonce,
+		"email": "someone@example.com",
+	})
+
+	idToken, err := o.providers["test"].exchangeCode(ctx, "code", o.getCallbackURL("test"), loginState.CodeVerifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims, err := o.providers["test"].verifyIDToken(ctx, idToken, loginState.Nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claims.Subject != "subject" || claims.Email != "someone@example.com" {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+
+	if _, err = o.providers["test"].exchangeCode(ctx, "code", o.getCallbackURL("test"), "wrong verifier"); err == nil {
+		t.Error("expected an error for a wrong code verifier")
+	}
+}
+
+func TestOIDCProviderVerifyIDToken(t *testing.T) {
+	provider := newTestOIDCProvider(t)
+	oidcProvider := newOIDCProvider(configs.OIDCProvider{
+		Name:      "test",
+		IssuerURL: provider.server.URL,
+		ClientID:  testOIDCClientID,
+	})
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   provider.server.URL,
+			"aud":   testOIDCClientID,
+			"sub":   "subject",
+			"exp":   time.Now().Add(time.Minute).Unix(),
+			"nonce": "nonce",
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		keyID       string
+		updateClaim func(claims jwt.MapClaims)
+		expectErr   bool
+	}{
+		{name: "valid", keyID: testOIDCKeyID, updateClaim: func(claims jwt.MapClaims) {}},
+		{name: "other issuer", keyID: testOIDCKeyID, updateClaim: func(claims jwt.MapClaims) { claims["iss"] = "https://other.example.com" }, expectErr: true},
+		{name: "other audience", keyID: testOIDCKeyID, updateClaim: func(claims jwt.MapClaims) { claims["aud"] = "other-client" }, expectErr: true},
+		{name: "expired", keyID: testOIDCKeyID, updateClaim: func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Minute).Unix() }, expectErr: true},
+		{name: "other nonce", keyID: testOIDCKeyID, updateClaim: func(claims jwt.MapClaims) { claims["nonce"] = "other" }, expectErr: true},
+		{name: "no subject", keyID: testOIDCKeyID, updateClaim: func(claims jwt.MapClaims) { delete(claims, "sub") }, expectErr: true},
+		{name: "unknown key", keyID: "key-2", updateClaim: func(claims jwt.MapClaims) {}, expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			claims := validClaims()
+			testCase.updateClaim(claims)
+
+			_, err := oidcProvider.verifyIDToken(context.Background(), provider.signIDToken(t, testCase.keyID, claims), "nonce")
+			if (err != nil) != testCase.expectErr {
+				t.Errorf("expected error %t, got %v", testCase.expectErr, err)
+			}
+		})
+	}
+
+	t.Run("hmac signed", func(t *testing.T) {
+		// A token signed with the public key as an HMAC secret must not be accepted.
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims())
+		token.Header["kid"] = testOIDCKeyID
+		idToken, err := token.SignedString(provider.privateKey.N.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = oidcProvider.verifyIDToken(context.Background(), idToken, "nonce"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestGetOIDCBaseAccountName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		claims   oidcIDTokenClaims
+		expected string
+	}{
+		{name: "preferred username", claims: oidcIDTokenClaims{PreferredUsername: "some.one", Email: "other@example.com"}, expected: "someone"},
+		{name: "email", claims: oidcIDTokenClaims{Email: "some_one@example.com", Name: "Some One"}, expected: "someone"},
+		{name: "name", claims: oidcIDTokenClaims{Name: "Some One"}, expected: "SomeOne"},
+		{name: "no usable claim", claims: oidcIDTokenClaims{Name: "名前"}, expected: oidcDefaultAccountNamePrefix},
+		{name: "long", claims: oidcIDTokenClaims{PreferredUsername: "abcdefghijklmnopqrstuvwxyz"}, expected: "abcdefghijklmnopqrstuvwx"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if accountName := getOIDCBaseAccountName(testCase.claims); accountName != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, accountName)
+			}
+		})
+	}
+}
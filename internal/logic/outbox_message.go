@@ -0,0 +1,136 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/dataaccess/mq/producer"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// OutboxMessageLogic relays the messages saved in the outbox to their queues. A message is produced at least
+// once: if the server stops after producing it but before it is marked as sent, it is produced again by the
+// next run, so consumers have to handle duplicates.
+type OutboxMessageLogic interface {
+	RelayAllUnsentOutboxMessage(ctx context.Context) error
+}
+
+func NewOutboxMessageLogic(
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	producerClient producer.Client,
+	database database.Database,
+	logger *zap.Logger,
+	cronConfig configs.Cron,
+) OutboxMessageLogic {
+	return &outboxMessageLogic{
+		outboxMessageDataAccessor: outboxMessageDataAccessor,
+		producerClient:            producerClient,
+		database:                  database,
+		logger:                    logger,
+		cronConfig:                cronConfig,
+	}
+}
+
+type outboxMessageLogic struct {
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor
+	producerClient            producer.Client
+	database                  database.Database
+	logger                    *zap.Logger
+	cronConfig                configs.Cron
+}
+
+// RelayAllUnsentOutboxMessage implements OutboxMessageLogic.
+func (o *outboxMessageLogic) RelayAllUnsentOutboxMessage(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	for {
+		sentCount, err := o.relayUnsentOutboxMessageBatch(ctx)
+		if sentCount > 0 {
+			logger.With(zap.Int("sent_count", sentCount)).Info("outbox messages relayed")
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if sentCount == 0 || sentCount < o.cronConfig.RelayAllUnsentOutboxMessage.BatchSize {
+			break
+		}
+	}
+
+	retention := o.cronConfig.RelayAllUnsentOutboxMessage.GetSentMessageRetention()
+	return o.outboxMessageDataAccessor.DeleteOutboxMessageListSentBefore(ctx, time.Now().Add(-retention))
+}
+
+// relayUnsentOutboxMessageBatch produces the oldest unsent messages in order and marks them as sent. The
+// messages stay locked until they are marked, so that relays running on other servers wait for them rather
+// than producing the messages after them first. It stops at the first message failing to be produced, so that
+// the messages of a queue are not produced out of order, the messages produced before it are still marked as
+// sent.
+func (o *outboxMessageLogic) relayUnsentOutboxMessageBatch(ctx context.Context) (int, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	var (
+		sentOutboxMessageIDList []uint64
+		produceErr              error
+	)
+	txErr := o.database.Transaction(func(tx *gorm.DB) error {
+		outboxMessageDataAccessor := o.outboxMessageDataAccessor.WithDatabaseTransaction(tx)
+
+		outboxMessageList, err := outboxMessageDataAccessor.GetUnsentOutboxMessageListForUpdate(
+			ctx,
+			o.cronConfig.RelayAllUnsentOutboxMessage.BatchSize,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, outboxMessage := range outboxMessageList {
+			produceErr = o.producerClient.Produce(
+				ctx,
+				outboxMessage.QueueName,
+				outboxMessage.MessageKey,
+				outboxMessage.Payload,
+			)
+			if produceErr != nil {
+				logger.
+					With(zap.Uint64("outbox_message_id", outboxMessage.OutboxMessageID)).
+					With(zap.String("queue_name", outboxMessage.QueueName)).
+					With(zap.Error(produceErr)).
+					Error("failed to produce outbox message")
+				break
+			}
+
+			sentOutboxMessageIDList = append(sentOutboxMessageIDList, outboxMessage.OutboxMessageID)
+		}
+
+		return outboxMessageDataAccessor.UpdateOutboxMessageListSentTime(ctx, sentOutboxMessageIDList, time.Now())
+	})
+	if txErr != nil {
+		return 0, txErr
+	}
+
+	return len(sentOutboxMessageIDList), produceErr
+}
+
+// createOutboxMessage saves a message to be produced to the queue, the data accessor has to use the
+// transaction of the change the message is about.
+func createOutboxMessage(
+	ctx context.Context,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	queueName string,
+	key string,
+	payload []byte,
+) error {
+	return outboxMessageDataAccessor.CreateOutboxMessageList(ctx, []database.OutboxMessage{
+		{
+			QueueName:  queueName,
+			MessageKey: key,
+			Payload:    payload,
+		},
+	})
+}
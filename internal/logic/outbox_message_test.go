@@ -0,0 +1,292 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// fakeTransactionDatabase runs transactions without a database, for data accessors that ignore it.
+type fakeTransactionDatabase struct {
+	database.Database
+}
+
+func (fakeTransactionDatabase) Transaction(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+	return fc(nil)
+}
+
+// fakeLockingTransactionDatabase holds rowLock for the whole transaction, like the lock a relay takes on the
+// oldest unsent outbox message and the relays running on other servers wait for.
+type fakeLockingTransactionDatabase struct {
+	database.Database
+	rowLock *sync.Mutex
+}
+
+func (f fakeLockingTransactionDatabase) Transaction(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+	f.rowLock.Lock()
+	defer f.rowLock.Unlock()
+
+	return fc(nil)
+}
+
+type fakeOutboxMessageDataAccessor struct {
+	mutex             sync.Mutex
+	outboxMessageList []database.OutboxMessage
+}
+
+func (f *fakeOutboxMessageDataAccessor) CreateOutboxMessageList(ctx context.Context, outboxMessageList []database.OutboxMessage) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, outboxMessage := range outboxMessageList {
+		outboxMessage.OutboxMessageID = uint64(len(f.outboxMessageList) + 1)
+		outboxMessage.CreateTime = time.Now()
+		f.outboxMessageList = append(f.outboxMessageList, outboxMessage)
+	}
+
+	return nil
+}
+
+func (f *fakeOutboxMessageDataAccessor) GetUnsentOutboxMessageListForUpdate(ctx context.Context, limit int) ([]database.OutboxMessage, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	var outboxMessageList []database.OutboxMessage
+	for _, outboxMessage := range f.outboxMessageList {
+		if outboxMessage.SentTime == nil && len(outboxMessageList) < limit {
+			outboxMessageList = append(outboxMessageList, outboxMessage)
+		}
+	}
+
+	return outboxMessageList, nil
+}
+
+func (f *fakeOutboxMessageDataAccessor) UpdateOutboxMessageListSentTime(ctx context.Context, outboxMessageIDList []uint64, sentTime time.Time) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i := range f.outboxMessageList {
+		if slices.Contains(outboxMessageIDList, f.outboxMessageList[i].OutboxMessageID) {
+			f.outboxMessageList[i].SentTime = &sentTime
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeOutboxMessageDataAccessor) DeleteOutboxMessageListSentBefore(ctx context.Context, sentTime time.Time) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.outboxMessageList = slices.DeleteFunc(f.outboxMessageList, func(outboxMessage database.OutboxMessage) bool {
+		return outboxMessage.SentTime != nil && outboxMessage.SentTime.Before(sentTime)
+	})
+
+	return nil
+}
+
+func (f *fakeOutboxMessageDataAccessor) WithDatabaseTransaction(database database.Database) database.OutboxMessageDataAccessor {
+	return f
+}
+
+type fakeProducedMessage struct {
+	queueName string
+	key       string
+	payload   string
+}
+
+// fakeProducerClient fails to produce once it produced failAfterCount messages, if set. Each message takes
+// produceDelay to be produced.
+type fakeProducerClient struct {
+	mutex               sync.Mutex
+	producedMessageList []fakeProducedMessage
+	failAfterCount      int
+	produceDelay        time.Duration
+}
+
+func (f *fakeProducerClient) Produce(ctx context.Context, queueName string, key string, payload []byte) error {
+	time.Sleep(f.produceDelay)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.failAfterCount > 0 && len(f.producedMessageList) >= f.failAfterCount {
+		return errors.New("queue is unavailable")
+	}
+
+	f.producedMessageList = append(f.producedMessageList, fakeProducedMessage{
+		queueName: queueName,
+		key:       key,
+		payload:   string(payload),
+	})
+	return nil
+}
+
+func newTestOutboxMessageLogic(
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	producerClient *fakeProducerClient,
+	database database.Database,
+	batchSize int,
+) OutboxMessageLogic {
+	return NewOutboxMessageLogic(
+		outboxMessageDataAccessor,
+		producerClient,
+		database,
+		zap.NewNop(),
+		configs.Cron{
+			RelayAllUnsentOutboxMessage: configs.RelayAllUnsentOutboxMessage{
+				BatchSize:            batchSize,
+				SentMessageRetention: 24,
+			},
+		},
+	)
+}
+
+func createTestOutboxMessageList(t *testing.T, outboxMessageDataAccessor database.OutboxMessageDataAccessor, count int) []fakeProducedMessage {
+	t.Helper()
+
+	var expectedMessageList []fakeProducedMessage
+	for i := 0; i < count; i++ {
+		message := fakeProducedMessage{
+			queueName: "queue",
+			key:       fmt.Sprintf("key-%d", i%2),
+			payload:   fmt.Sprintf("payload-%d", i),
+		}
+
+		err := createOutboxMessage(context.Background(), outboxMessageDataAccessor, message.queueName, message.key, []byte(message.payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expectedMessageList = append(expectedMessageList, message)
+	}
+
+	return expectedMessageList
+}
+
+func TestRelayAllUnsentOutboxMessage(t *testing.T) {
+	testCases := []struct {
+		name         string
+		messageCount int
+		batchSize    int
+	}{
+		{name: "no message", messageCount: 0, batchSize: 2},
+		{name: "single batch", messageCount: 3, batchSize: 10},
+		{name: "full batches", messageCount: 4, batchSize: 2},
+		{name: "last batch not full", messageCount: 5, batchSize: 2},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			outboxMessageDataAccessor := &fakeOutboxMessageDataAccessor{}
+			producerClient := &fakeProducerClient{}
+			expectedMessageList := createTestOutboxMessageList(t, outboxMessageDataAccessor, testCase.messageCount)
+
+			o := newTestOutboxMessageLogic(outboxMessageDataAccessor, producerClient, fakeTransactionDatabase{}, testCase.batchSize)
+			if err := o.RelayAllUnsentOutboxMessage(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(producerClient.producedMessageList, expectedMessageList) {
+				t.Errorf("expected %v to be produced, got %v", expectedMessageList, producerClient.producedMessageList)
+			}
+
+			for _, outboxMessage := range outboxMessageDataAccessor.outboxMessageList {
+				if outboxMessage.SentTime == nil {
+					t.Errorf("outbox message %d is not marked as sent", outboxMessage.OutboxMessageID)
+				}
+			}
+		})
+	}
+}
+
+func TestRelayAllUnsentOutboxMessageProduceFailure(t *testing.T) {
+	outboxMessageDataAccessor := &fakeOutboxMessageDataAccessor{}
+	producerClient := &fakeProducerClient{failAfterCount: 3}
+	expectedMessageList := createTestOutboxMessageList(t, outboxMessageDataAccessor, 5)
+
+	o := newTestOutboxMessageLogic(outboxMessageDataAccessor, producerClient, fakeTransactionDatabase{}, 2)
+	if err := o.RelayAllUnsentOutboxMessage(context.Background()); err == nil {
+		t.Fatal("expected an error when the queue is unavailable")
+	}
+
+	// The messages produced before the failure are marked as sent, the others are left for the next run.
+	for i, outboxMessage := range outboxMessageDataAccessor.outboxMessageList {
+		if isSent := outboxMessage.SentTime != nil; isSent != (i < 3) {
+			t.Errorf("expected outbox message %d sent %t, got %t", outboxMessage.OutboxMessageID, i < 3, isSent)
+		}
+	}
+
+	producerClient.failAfterCount = 0
+	if err := o.RelayAllUnsentOutboxMessage(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(producerClient.producedMessageList, expectedMessageList) {
+		t.Errorf("expected %v to be produced once in order, got %v", expectedMessageList, producerClient.producedMessageList)
+	}
+}
+
+func TestRelayAllUnsentOutboxMessageConcurrently(t *testing.T) {
+	outboxMessageDataAccessor := &fakeOutboxMessageDataAccessor{}
+	producerClient := &fakeProducerClient{produceDelay: time.Millisecond}
+	expectedMessageList := createTestOutboxMessageList(t, outboxMessageDataAccessor, 20)
+
+	// Each server runs its own relay, sharing the outbox table and its locks.
+	database := fakeLockingTransactionDatabase{rowLock: &sync.Mutex{}}
+	relayList := []OutboxMessageLogic{
+		newTestOutboxMessageLogic(outboxMessageDataAccessor, producerClient, database, 3),
+		newTestOutboxMessageLogic(outboxMessageDataAccessor, producerClient, database, 3),
+	}
+
+	var waitGroup sync.WaitGroup
+	errList := make([]error, len(relayList))
+	for i, relay := range relayList {
+		waitGroup.Add(1)
+		go func(i int, relay OutboxMessageLogic) {
+			defer waitGroup.Done()
+			errList[i] = relay.RelayAllUnsentOutboxMessage(context.Background())
+		}(i, relay)
+	}
+	waitGroup.Wait()
+
+	for _, err := range errList {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !slices.Equal(producerClient.producedMessageList, expectedMessageList) {
+		t.Errorf("expected %v to be produced once in order, got %v", expectedMessageList, producerClient.producedMessageList)
+	}
+}
+
+func TestRelayAllUnsentOutboxMessageDeletesOldSentMessages(t *testing.T) {
+	oldSentTime := time.Now().Add(-48 * time.Hour)
+	recentSentTime := time.Now().Add(-time.Hour)
+	outboxMessageDataAccessor := &fakeOutboxMessageDataAccessor{
+		outboxMessageList: []database.OutboxMessage{
+			{OutboxMessageID: 1, QueueName: "queue", SentTime: &oldSentTime},
+			{OutboxMessageID: 2, QueueName: "queue", SentTime: &recentSentTime},
+		},
+	}
+
+	o := newTestOutboxMessageLogic(outboxMessageDataAccessor, &fakeProducerClient{}, fakeTransactionDatabase{}, 2)
+	if err := o.RelayAllUnsentOutboxMessage(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(outboxMessageDataAccessor.outboxMessageList) != 1 || outboxMessageDataAccessor.outboxMessageList[0].OutboxMessageID != 2 {
+		t.Errorf("expected only outbox message 2 to be kept, got %v", outboxMessageDataAccessor.outboxMessageList)
+	}
+}
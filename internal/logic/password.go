@@ -0,0 +1,385 @@
+package logic
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	passwordResetTokenSizeInBytes = 32
+	// passwordMaxLengthInBytes is the most bcrypt hashes, characters outside of ASCII take more than one byte so
+	// it can be reached before the max length of the policy.
+	passwordMaxLengthInBytes = 72
+)
+
+var (
+	errPasswordResetTokenInvalid = errors.New("password reset token is invalid")
+)
+
+type ChangePasswordInput struct {
+	Token           string
+	CurrentPassword string
+	NewPassword     string
+}
+
+type CreatePasswordResetTokenOutput struct {
+	ResetToken string
+	ExpireTime *timestamppb.Timestamp
+}
+
+type ResetPasswordInput struct {
+	ResetToken  string
+	NewPassword string
+}
+
+type PasswordLogic interface {
+	// CheckPasswordPolicy returns an InvalidArgument error describing the first rule of the password policy the
+	// password breaks.
+	CheckPasswordPolicy(ctx context.Context, password string) error
+	// SetPassword checks the password policy and makes every unused password reset token of the account
+	// unusable, it does not revoke the sessions of the account. Accounts without a password are given one.
+	SetPassword(ctx context.Context, accountID uint64, password string) error
+	// ChangePassword revokes every session of the account except the one of the token, and every unused
+	// password reset token of the account.
+	ChangePassword(ctx context.Context, in ChangePasswordInput) error
+	// CreatePasswordResetToken does not authenticate its callers, the caller must have checked that they are
+	// allowed to reset the password of the account.
+	CreatePasswordResetToken(ctx context.Context, accountID uint64) (CreatePasswordResetTokenOutput, error)
+	// ResetPassword revokes every session of the account. Accounts without a password are given one.
+	ResetPassword(ctx context.Context, in ResetPasswordInput) error
+}
+
+func NewPasswordLogic(
+	database database.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	passwordDataAccessor database.AccountPasswordDataAccessor,
+	passwordResetTokenDataAccessor database.PasswordResetTokenDataAccessor,
+	hashLogic HashLogic,
+	tokenLogic TokenLogic,
+	logger *zap.Logger,
+	authConfig configs.Auth,
+) (PasswordLogic, error) {
+	breachedPasswordHashSet, err := loadBreachedPasswordHashSet(authConfig.PasswordPolicy.BreachedPasswordListFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passwordLogic{
+		database:                       database,
+		accountDataAccessor:            accountDataAccessor,
+		passwordDataAccessor:           passwordDataAccessor,
+		passwordResetTokenDataAccessor: passwordResetTokenDataAccessor,
+		hashLogic:                      hashLogic,
+		tokenLogic:                     tokenLogic,
+		logger:                         logger,
+		authConfig:                     authConfig,
+		breachedPasswordHashSet:        breachedPasswordHashSet,
+	}, nil
+}
+
+type passwordLogic struct {
+	database                       database.Database
+	accountDataAccessor            database.AccountDataAccessor
+	passwordDataAccessor           database.AccountPasswordDataAccessor
+	passwordResetTokenDataAccessor database.PasswordResetTokenDataAccessor
+	hashLogic                      HashLogic
+	tokenLogic                     TokenLogic
+	logger                         *zap.Logger
+	authConfig                     configs.Auth
+	// breachedPasswordHashSet holds the upper case hex encoded SHA-1 hashes of the breached passwords.
+	breachedPasswordHashSet map[string]struct{}
+}
+
+// CheckPasswordPolicy implements PasswordLogic.
+func (p *passwordLogic) CheckPasswordPolicy(ctx context.Context, password string) error {
+	policy := p.authConfig.PasswordPolicy
+
+	length := utf8.RuneCountInString(password)
+	if length < policy.MinLength {
+		return status.Errorf(codes.InvalidArgument, "password must have at least %d characters", policy.MinLength)
+	}
+	if policy.MaxLength > 0 && length > policy.MaxLength {
+		return status.Errorf(codes.InvalidArgument, "password must have at most %d characters", policy.MaxLength)
+	}
+	if len(password) > passwordMaxLengthInBytes {
+		return status.Errorf(codes.InvalidArgument, "password must be at most %d bytes long", passwordMaxLengthInBytes)
+	}
+
+	var hasLowercase, hasUppercase, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLowercase = true
+		case unicode.IsUpper(r):
+			hasUppercase = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+
+	switch {
+	case policy.RequireLowercase && !hasLowercase:
+		return status.Error(codes.InvalidArgument, "password must have a lowercase letter")
+	case policy.RequireUppercase && !hasUppercase:
+		return status.Error(codes.InvalidArgument, "password must have an uppercase letter")
+	case policy.RequireDigit && !hasDigit:
+		return status.Error(codes.InvalidArgument, "password must have a digit")
+	case policy.RequireSymbol && !hasSymbol:
+		return status.Error(codes.InvalidArgument, "password must have a symbol")
+	}
+
+	if _, ok := p.breachedPasswordHashSet[hashBreachedPassword(password)]; ok {
+		return status.Error(codes.InvalidArgument, "password has appeared in a data breach, choose another one")
+	}
+
+	return nil
+}
+
+// ChangePassword implements PasswordLogic.
+func (p *passwordLogic) ChangePassword(ctx context.Context, in ChangePasswordInput) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	accountID, sessionID, err := p.tokenLogic.GetAccountIDAndSessionID(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to find account from token")
+		return status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	logger = logger.With(zap.Uint64("account_id", accountID))
+
+	currentPassword, err := p.passwordDataAccessor.GetPassword(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountPasswordNotFound) {
+			return status.Error(codes.FailedPrecondition, "account does not have a password")
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get account password")
+		return status.Error(codes.Internal, "failed to get account password")
+	}
+
+	matched, err := p.hashLogic.IsHashEqual(ctx, in.CurrentPassword, currentPassword.Hashed)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed comparing password")
+		return status.Error(codes.Internal, "failed comparing password")
+	}
+	if !matched {
+		return status.Error(codes.PermissionDenied, "current password is wrong")
+	}
+
+	if err = p.SetPassword(ctx, accountID, in.NewPassword); err != nil {
+		return err
+	}
+
+	if err = p.tokenLogic.RevokeSessionListOfAccount(ctx, accountID, sessionID); err != nil {
+		logger.With(zap.Error(err)).Error("failed to revoke sessions after password change")
+		return status.Error(codes.Internal, "failed to revoke other sessions of account")
+	}
+
+	logger.Info("account password changed")
+	return nil
+}
+
+// CreatePasswordResetToken implements PasswordLogic.
+func (p *passwordLogic) CreatePasswordResetToken(ctx context.Context, accountID uint64) (CreatePasswordResetTokenOutput, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("account_id", accountID))
+
+	account, err := p.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return CreatePasswordResetTokenOutput{}, status.Error(codes.Internal, "error getting account")
+	}
+	if account.AccountID == 0 {
+		return CreatePasswordResetTokenOutput{}, status.Error(codes.NotFound, "account not found")
+	}
+
+	resetToken, hashedResetToken, err := generatePasswordResetToken()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate password reset token")
+		return CreatePasswordResetTokenOutput{}, status.Error(codes.Internal, "failed to create password reset token")
+	}
+
+	now := time.Now()
+	expireTime := now.Add(p.authConfig.PasswordReset.GetTokenDuration())
+	err = p.passwordResetTokenDataAccessor.CreatePasswordResetToken(ctx, database.PasswordResetToken{
+		OfAccountID: accountID,
+		HashedToken: hashedResetToken,
+		CreateTime:  now,
+		ExpireTime:  expireTime,
+	})
+	if err != nil {
+		return CreatePasswordResetTokenOutput{}, status.Error(codes.Internal, "failed to create password reset token")
+	}
+
+	logger.Info("password reset token created")
+	return CreatePasswordResetTokenOutput{
+		ResetToken: resetToken,
+		ExpireTime: timestamppb.New(expireTime),
+	}, nil
+}
+
+// ResetPassword implements PasswordLogic.
+func (p *passwordLogic) ResetPassword(ctx context.Context, in ResetPasswordInput) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	if err := p.CheckPasswordPolicy(ctx, in.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := p.hashLogic.HashPassword(ctx, in.NewPassword)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to hash password")
+		return status.Error(codes.Internal, "failed to reset password")
+	}
+
+	var accountID uint64
+	txErr := p.database.Transaction(func(tx *gorm.DB) error {
+		resetToken, err := p.passwordResetTokenDataAccessor.WithDatabaseTransaction(tx).
+			GetPasswordResetTokenByHashedTokenForUpdate(ctx, hashPasswordResetToken(in.ResetToken))
+		if err != nil {
+			if errors.Is(err, database.ErrPasswordResetTokenNotFound) {
+				return errPasswordResetTokenInvalid
+			}
+
+			return err
+		}
+
+		if resetToken.IsUsed || !time.Now().Before(resetToken.ExpireTime) {
+			return errPasswordResetTokenInvalid
+		}
+
+		accountID = resetToken.OfAccountID
+		if err = p.passwordResetTokenDataAccessor.WithDatabaseTransaction(tx).MarkPasswordResetTokenListOfAccountUsed(ctx, accountID); err != nil {
+			return err
+		}
+
+		return p.passwordDataAccessor.WithDatabaseTransaction(tx).UpdatePassword(ctx, accountID, hashedPassword)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, errPasswordResetTokenInvalid) {
+			return status.Error(codes.InvalidArgument, "password reset token is invalid or expired")
+		}
+
+		logger.With(zap.Error(txErr)).Error("reset password transaction failed")
+		return status.Error(codes.Internal, "failed to reset password")
+	}
+
+	logger = logger.With(zap.Uint64("account_id", accountID))
+	if err = p.tokenLogic.RevokeSessionListOfAccount(ctx, accountID, ""); err != nil {
+		logger.With(zap.Error(err)).Error("failed to revoke sessions after password reset")
+		return status.Error(codes.Internal, "failed to revoke sessions of account")
+	}
+
+	logger.Info("account password reset with password reset token")
+	return nil
+}
+
+// SetPassword implements PasswordLogic.
+func (p *passwordLogic) SetPassword(ctx context.Context, accountID uint64, password string) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("account_id", accountID))
+
+	if err := p.CheckPasswordPolicy(ctx, password); err != nil {
+		return err
+	}
+
+	hashedPassword, err := p.hashLogic.HashPassword(ctx, password)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to hash password")
+		return status.Error(codes.Internal, "failed to set password")
+	}
+
+	txErr := p.database.Transaction(func(tx *gorm.DB) error {
+		if err := p.passwordDataAccessor.WithDatabaseTransaction(tx).UpdatePassword(ctx, accountID, hashedPassword); err != nil {
+			return err
+		}
+
+		return p.passwordResetTokenDataAccessor.WithDatabaseTransaction(tx).MarkPasswordResetTokenListOfAccountUsed(ctx, accountID)
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Error("set password transaction failed")
+		return status.Error(codes.Internal, "failed to set password")
+	}
+
+	return nil
+}
+
+// generatePasswordResetToken returns a random password reset token and its hash, only the hash is stored.
+func generatePasswordResetToken() (string, string, error) {
+	resetToken := make([]byte, passwordResetTokenSizeInBytes)
+	if _, err := rand.Read(resetToken); err != nil {
+		return "", "", err
+	}
+
+	encodedResetToken := base64.RawURLEncoding.EncodeToString(resetToken)
+	return encodedResetToken, hashPasswordResetToken(encodedResetToken), nil
+}
+
+func hashPasswordResetToken(resetToken string) string {
+	hashedResetToken := sha256.Sum256([]byte(resetToken))
+	return hex.EncodeToString(hashedResetToken[:])
+}
+
+// hashBreachedPassword returns the hash a password is looked up with in the breached password list. SHA-1 is
+// used as widely published breached password lists are distributed as SHA-1 hashes.
+func hashBreachedPassword(password string) string {
+	hashedPassword := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(hashedPassword[:]))
+}
+
+// loadBreachedPasswordHashSet reads a breached password list, lines of 40 hex digits optionally followed by
+// ":<count>" are SHA-1 hashes and other lines are plain text passwords.
+func loadBreachedPasswordHashSet(filePath string) (map[string]struct{}, error) {
+	hashSet := make(map[string]struct{})
+	if filePath == "" {
+		return hashSet, nil
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening breached password list: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(line, ":")
+		if _, err := hex.DecodeString(hash); err == nil && len(hash) == sha1.Size*2 {
+			hashSet[strings.ToUpper(hash)] = struct{}{}
+			continue
+		}
+
+		hashSet[hashBreachedPassword(line)] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading breached password list: %w", err)
+	}
+
+	return hashSet, nil
+}
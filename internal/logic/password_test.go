@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckPasswordPolicy(t *testing.T) {
+	p := &passwordLogic{
+		authConfig: configs.Auth{
+			PasswordPolicy: configs.PasswordPolicy{
+				MinLength:        8,
+				MaxLength:        72,
+				RequireLowercase: true,
+				RequireDigit:     true,
+			},
+		},
+		breachedPasswordHashSet: map[string]struct{}{
+			hashBreachedPassword("password1"): {},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		password  string
+		expectErr bool
+	}{
+		{name: "valid", password: "correct horse 1", expectErr: false},
+		{name: "too short", password: "abc1", expectErr: true},
+		{name: "max length", password: strings.Repeat("a", 71) + "1", expectErr: false},
+		{name: "too long", password: strings.Repeat("a", 72) + "1", expectErr: true},
+		{name: "too many bytes", password: strings.Repeat("é", 40) + "a1", expectErr: true},
+		{name: "no lowercase", password: "CORRECT HORSE 1", expectErr: true},
+		{name: "no digit", password: "correct horse", expectErr: true},
+		{name: "breached", password: "password1", expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := p.CheckPasswordPolicy(context.Background(), testCase.password)
+			if (err != nil) != testCase.expectErr {
+				t.Fatalf("expected error %t, got %v", testCase.expectErr, err)
+			}
+
+			if err != nil && status.Code(err) != codes.InvalidArgument {
+				t.Errorf("expected code %s, got %s", codes.InvalidArgument, status.Code(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package logic
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	sharedFilePathPrefix = "/api/v1/shared-files/"
+)
+
+var (
+	ErrShareLinkInvalid = status.Error(codes.NotFound, "share link is invalid or has expired")
+)
+
+type CreateShareLinkInput struct {
+	Token            string
+	DownloadTaskID   uint64
+	ExpireIn         time.Duration
+	Password         string
+	MaxDownloadCount uint64
+}
+
+type CreateShareLinkOutput struct {
+	ShareLink *idm.ShareLink
+}
+
+type GetShareLinkListInput struct {
+	Token          string
+	DownloadTaskID uint64
+}
+
+type GetShareLinkListOutput struct {
+	ShareLinkList []*idm.ShareLink
+}
+
+type DeleteShareLinkInput struct {
+	Token       string
+	ShareLinkID uint64
+}
+
+type GetSharedFileInput struct {
+	ShareToken string
+	Password   string
+}
+
+type ShareLinkLogic interface {
+	CreateShareLink(ctx context.Context, in CreateShareLinkInput) (CreateShareLinkOutput, error)
+	GetShareLinkList(ctx context.Context, in GetShareLinkListInput) (GetShareLinkListOutput, error)
+	DeleteShareLink(ctx context.Context, in DeleteShareLinkInput) error
+	GetSharedFile(ctx context.Context, in GetSharedFileInput) (GetDownloadTaskFileOutput, error)
+}
+
+func NewShareLinkLogic(
+	tokenLogic TokenLogic,
+	hashLogic HashLogic,
+	workspaceLogic WorkspaceLogic,
+	downloadTaskLogic DownloadTaskLogic,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	shareLinkDataAccessor database.ShareLinkDataAccessor,
+	shareLinkConfig configs.ShareLink,
+	logger *zap.Logger,
+) (ShareLinkLogic, error) {
+	if shareLinkConfig.Secret == "" {
+		return nil, errors.New("share link secret is not configured")
+	}
+
+	return &shareLinkLogic{
+		tokenLogic:               tokenLogic,
+		hashLogic:                hashLogic,
+		workspaceLogic:           workspaceLogic,
+		downloadTaskLogic:        downloadTaskLogic,
+		downloadTaskDataAccessor: downloadTaskDataAccessor,
+		shareLinkDataAccessor:    shareLinkDataAccessor,
+		shareLinkConfig:          shareLinkConfig,
+		logger:                   logger,
+	}, nil
+}
+
+type shareLinkLogic struct {
+	tokenLogic               TokenLogic
+	hashLogic                HashLogic
+	workspaceLogic           WorkspaceLogic
+	downloadTaskLogic        DownloadTaskLogic
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor
+	shareLinkDataAccessor    database.ShareLinkDataAccessor
+	shareLinkConfig          configs.ShareLink
+	logger                   *zap.Logger
+}
+
+// CreateShareLink implements ShareLinkLogic.
+func (s *shareLinkLogic) CreateShareLink(ctx context.Context, in CreateShareLinkInput) (CreateShareLinkOutput, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("download_task_id", in.DownloadTaskID))
+
+	accountID, _, err := s.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
+		return CreateShareLinkOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	if in.ExpireIn <= 0 || in.ExpireIn > s.shareLinkConfig.GetMaxDuration() {
+		return CreateShareLinkOutput{}, status.Errorf(
+			codes.InvalidArgument, "share link must expire within %d seconds", s.shareLinkConfig.MaxDuration,
+		)
+	}
+
+	downloadTask, err := s.downloadTaskDataAccessor.GetDownloadTask(ctx, in.DownloadTaskID)
+	if err != nil {
+		if errors.Is(err, database.ErrDownloadTaskNotFound) {
+			return CreateShareLinkOutput{}, status.Error(codes.NotFound, "download task not found")
+		}
+
+		return CreateShareLinkOutput{}, status.Error(codes.Internal, "failed to get download task")
+	}
+
+	// Share links give access to the file, members who can not download it can not share it either.
+	if _, err = s.workspaceLogic.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: downloadTask.OfWorkspaceID,
+		Role:        idm.WorkspaceRole_Downloader,
+	}); err != nil {
+		return CreateShareLinkOutput{}, err
+	}
+
+	if downloadTask.DownloadStatus != uint16(idm.DownloadStatus_Success) {
+		return CreateShareLinkOutput{}, ErrDownloadTaskNotCompleted
+	}
+
+	var hashedPassword string
+	if in.Password != "" {
+		hashedPassword, err = s.hashLogic.HashPassword(ctx, in.Password)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to hash share link password")
+			return CreateShareLinkOutput{}, status.Error(codes.Internal, "failed to hash password")
+		}
+	}
+
+	// MySQL DATETIME has a precision of one second, the token is signed with the stored value.
+	expireTime := time.Now().Add(in.ExpireIn).UTC().Truncate(time.Second)
+	createdShareLink, err := s.shareLinkDataAccessor.CreateShareLink(ctx, database.ShareLink{
+		OfDownloadTaskID: downloadTask.DownloadTaskID,
+		OfAccountID:      accountID,
+		HashedPassword:   hashedPassword,
+		MaxDownloadCount: in.MaxDownloadCount,
+		ExpireTime:       expireTime,
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create share link")
+		return CreateShareLinkOutput{}, status.Error(codes.Internal, "failed to create share link")
+	}
+
+	output := CreateShareLinkOutput{
+		ShareLink: s.toProtoShareLink(createdShareLink),
+	}
+	output.ShareLink.Url = s.getShareLinkURL(createdShareLink)
+	return output, nil
+}
+
+// GetShareLinkList implements ShareLinkLogic.
+func (s *shareLinkLogic) GetShareLinkList(ctx context.Context, in GetShareLinkListInput) (GetShareLinkListOutput, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("download_task_id", in.DownloadTaskID))
+
+	accountID, _, err := s.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
+		return GetShareLinkListOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	shareLinks, err := s.shareLinkDataAccessor.GetShareLinkListOfAccount(ctx, accountID, in.DownloadTaskID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get share link list")
+		return GetShareLinkListOutput{}, status.Error(codes.Internal, "failed to get share link list")
+	}
+
+	shareLinkList := make([]*idm.ShareLink, 0, len(shareLinks))
+	for _, shareLink := range shareLinks {
+		shareLinkList = append(shareLinkList, s.toProtoShareLink(shareLink))
+	}
+
+	return GetShareLinkListOutput{
+		ShareLinkList: shareLinkList,
+	}, nil
+}
+
+// DeleteShareLink implements ShareLinkLogic.
+func (s *shareLinkLogic) DeleteShareLink(ctx context.Context, in DeleteShareLinkInput) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("share_link_id", in.ShareLinkID))
+
+	accountID, _, err := s.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
+		return status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	shareLink, err := s.shareLinkDataAccessor.GetShareLink(ctx, in.ShareLinkID)
+	if err != nil {
+		if errors.Is(err, database.ErrShareLinkNotFound) {
+			return status.Error(codes.NotFound, "share link not found")
+		}
+
+		return status.Error(codes.Internal, "failed to get share link")
+	}
+
+	if shareLink.OfAccountID != accountID {
+		return ErrPermissionDenied
+	}
+
+	// Share links are revoked instead of deleted so that their download count stays visible to the owner.
+	if err = s.shareLinkDataAccessor.RevokeShareLink(ctx, in.ShareLinkID); err != nil {
+		return status.Error(codes.Internal, "failed to revoke share link")
+	}
+
+	return nil
+}
+
+// GetSharedFile implements ShareLinkLogic.
+func (s *shareLinkLogic) GetSharedFile(ctx context.Context, in GetSharedFileInput) (GetDownloadTaskFileOutput, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	shareLinkID, err := s.verifyShareToken(in.ShareToken)
+	if err != nil {
+		logger.With(zap.Error(err)).Info("invalid share token")
+		return GetDownloadTaskFileOutput{}, ErrShareLinkInvalid
+	}
+
+	logger = logger.With(zap.Uint64("share_link_id", shareLinkID))
+
+	shareLink, err := s.shareLinkDataAccessor.GetShareLink(ctx, shareLinkID)
+	if err != nil {
+		if errors.Is(err, database.ErrShareLinkNotFound) {
+			return GetDownloadTaskFileOutput{}, ErrShareLinkInvalid
+		}
+
+		return GetDownloadTaskFileOutput{}, status.Error(codes.Internal, "failed to get share link")
+	}
+
+	if shareLink.IsRevoked || !time.Now().Before(shareLink.ExpireTime) {
+		return GetDownloadTaskFileOutput{}, ErrShareLinkInvalid
+	}
+
+	if shareLink.HashedPassword != "" {
+		matched, err := s.hashLogic.IsHashEqual(ctx, in.Password, shareLink.HashedPassword)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed comparing share link password")
+			return GetDownloadTaskFileOutput{}, status.Error(codes.Internal, "failed comparing password")
+		}
+		if !matched {
+			return GetDownloadTaskFileOutput{}, status.Error(codes.PermissionDenied, "wrong share link password")
+		}
+	}
+
+	// The download is only counted once the file is opened, a missing file does not use up the download limit.
+	output, err := s.downloadTaskLogic.OpenDownloadTaskFile(ctx, OpenDownloadTaskFileInput{
+		DownloadTaskID: shareLink.OfDownloadTaskID,
+	})
+	if err != nil {
+		return GetDownloadTaskFileOutput{}, err
+	}
+
+	increased, err := s.shareLinkDataAccessor.IncreaseShareLinkDownloadCount(ctx, shareLinkID)
+	if err != nil {
+		output.Reader.Close()
+		return GetDownloadTaskFileOutput{}, status.Error(codes.Internal, "failed to update share link download count")
+	}
+	if !increased {
+		output.Reader.Close()
+		return GetDownloadTaskFileOutput{}, status.Error(codes.ResourceExhausted, "share link has reached its download limit")
+	}
+
+	return output, nil
+}
+
+// getShareLinkURL returns a URL of the server rather than of the storage holding the file, so that the link
+// stops working once revoked and keeps working after the file is moved to another storage.
+func (s *shareLinkLogic) getShareLinkURL(shareLink database.ShareLink) string {
+	return strings.TrimSuffix(s.shareLinkConfig.BaseURL, "/") + sharedFilePathPrefix + s.signShareToken(shareLink)
+}
+
+// signShareToken creates a token of the form <share link id>.<expire unix time>.<signature>.
+func (s *shareLinkLogic) signShareToken(shareLink database.ShareLink) string {
+	payload := fmt.Sprintf("%d.%d", shareLink.ShareLinkID, shareLink.ExpireTime.Unix())
+	return payload + "." + s.getShareTokenSignature(payload)
+}
+
+// verifyShareToken checks the signature and the expire time of a share token and returns its share link ID.
+func (s *shareLinkLogic) verifyShareToken(shareToken string) (uint64, error) {
+	parts := strings.Split(shareToken, ".")
+	if len(parts) != 3 {
+		return 0, errors.New("malformed share token")
+	}
+
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(s.getShareTokenSignature(payload))) {
+		return 0, errors.New("invalid share token signature")
+	}
+
+	shareLinkID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid share link id: %w", err)
+	}
+
+	expireUnix, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid share token expire time: %w", err)
+	}
+
+	if !time.Now().Before(time.Unix(expireUnix, 0)) {
+		return 0, errors.New("share token has expired")
+	}
+
+	return shareLinkID, nil
+}
+
+func (s *shareLinkLogic) getShareTokenSignature(payload string) string {
+	mac := hmac.New(sha256.New, []byte(s.shareLinkConfig.Secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *shareLinkLogic) toProtoShareLink(shareLink database.ShareLink) *idm.ShareLink {
+	return &idm.ShareLink{
+		Id:                shareLink.ShareLinkID,
+		DownloadTaskId:    shareLink.OfDownloadTaskID,
+		ExpireTime:        timestamppb.New(shareLink.ExpireTime),
+		PasswordProtected: shareLink.HashedPassword != "",
+		MaxDownloadCount:  shareLink.MaxDownloadCount,
+		DownloadCount:     shareLink.DownloadCount,
+		Revoked:           shareLink.IsRevoked,
+	}
+}
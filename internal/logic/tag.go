@@ -0,0 +1,212 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateTagInput struct {
+	Token string
+	// WorkspaceID is 0 for the personal workspace of the account.
+	WorkspaceID uint64
+	Name        string
+}
+
+type GetTagListInput struct {
+	Token string
+	// WorkspaceID is 0 for the personal workspace of the account.
+	WorkspaceID uint64
+}
+
+type GetTagListOutput struct {
+	TagList []*idm.Tag
+}
+
+type UpdateTagInput struct {
+	Token string
+	TagID uint64
+	Name  string
+}
+
+type TagOutput struct {
+	Tag *idm.Tag
+}
+
+type DeleteTagInput struct {
+	Token string
+	TagID uint64
+}
+
+// TagLogic manages the tags of workspaces, the managers of a workspace name its tags and its members read them.
+type TagLogic interface {
+	CreateTag(ctx context.Context, in CreateTagInput) (TagOutput, error)
+	GetTagList(ctx context.Context, in GetTagListInput) (GetTagListOutput, error)
+	UpdateTag(ctx context.Context, in UpdateTagInput) (TagOutput, error)
+	DeleteTag(ctx context.Context, in DeleteTagInput) error
+}
+
+func NewTagLogic(
+	tokenLogic TokenLogic,
+	workspaceLogic WorkspaceLogic,
+	tagDataAccessor database.TagDataAccessor,
+	logger *zap.Logger,
+) TagLogic {
+	return &tagLogic{
+		tokenLogic:      tokenLogic,
+		workspaceLogic:  workspaceLogic,
+		tagDataAccessor: tagDataAccessor,
+		logger:          logger,
+	}
+}
+
+type tagLogic struct {
+	tokenLogic      TokenLogic
+	workspaceLogic  WorkspaceLogic
+	tagDataAccessor database.TagDataAccessor
+	logger          *zap.Logger
+}
+
+// CreateTag implements TagLogic.
+func (t *tagLogic) CreateTag(ctx context.Context, in CreateTagInput) (TagOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.String("name", in.Name))
+
+	accountID, err := getAccountIDWithScope(ctx, t.tokenLogic, t.logger, in.Token, APIKeyScopeTasksWrite)
+	if err != nil {
+		return TagOutput{}, err
+	}
+
+	workspaceID, err := t.workspaceLogic.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: in.WorkspaceID,
+		Role:        idm.WorkspaceRole_Manager,
+	})
+	if err != nil {
+		return TagOutput{}, err
+	}
+
+	createdTag, err := t.tagDataAccessor.CreateTag(ctx, database.Tag{
+		OfWorkspaceID: workspaceID,
+		Name:          in.Name,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrTagAlreadyExists) {
+			return TagOutput{}, status.Error(codes.AlreadyExists, "tag name already exists in the workspace")
+		}
+
+		logger.With(zap.Error(err)).Error("failed to create tag")
+		return TagOutput{}, status.Error(codes.Internal, "failed to create tag")
+	}
+
+	return TagOutput{Tag: databaseTagToProtoTag(createdTag)}, nil
+}
+
+// GetTagList implements TagLogic.
+func (t *tagLogic) GetTagList(ctx context.Context, in GetTagListInput) (GetTagListOutput, error) {
+	accountID, err := getAccountIDWithScope(ctx, t.tokenLogic, t.logger, in.Token, APIKeyScopeTasksRead)
+	if err != nil {
+		return GetTagListOutput{}, err
+	}
+
+	workspaceID, err := t.workspaceLogic.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: in.WorkspaceID,
+		Role:        idm.WorkspaceRole_Viewer,
+	})
+	if err != nil {
+		return GetTagListOutput{}, err
+	}
+
+	tags, err := t.tagDataAccessor.GetTagListOfWorkspace(ctx, workspaceID)
+	if err != nil {
+		return GetTagListOutput{}, status.Error(codes.Internal, "failed to get tag list")
+	}
+
+	tagList := make([]*idm.Tag, 0, len(tags))
+	for _, tag := range tags {
+		tagList = append(tagList, databaseTagToProtoTag(tag))
+	}
+
+	return GetTagListOutput{TagList: tagList}, nil
+}
+
+// UpdateTag implements TagLogic.
+func (t *tagLogic) UpdateTag(ctx context.Context, in UpdateTagInput) (TagOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("tag_id", in.TagID))
+
+	tag, err := t.getManagedTag(ctx, in.Token, in.TagID)
+	if err != nil {
+		return TagOutput{}, err
+	}
+
+	if err = t.tagDataAccessor.UpdateTagName(ctx, tag.TagID, in.Name); err != nil {
+		if errors.Is(err, database.ErrTagAlreadyExists) {
+			return TagOutput{}, status.Error(codes.AlreadyExists, "tag name already exists in the workspace")
+		}
+
+		logger.With(zap.Error(err)).Error("failed to update tag")
+		return TagOutput{}, status.Error(codes.Internal, "failed to update tag")
+	}
+
+	tag.Name = in.Name
+	return TagOutput{Tag: databaseTagToProtoTag(tag)}, nil
+}
+
+// DeleteTag implements TagLogic.
+func (t *tagLogic) DeleteTag(ctx context.Context, in DeleteTagInput) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("tag_id", in.TagID))
+
+	tag, err := t.getManagedTag(ctx, in.Token, in.TagID)
+	if err != nil {
+		return err
+	}
+
+	if err = t.tagDataAccessor.DeleteTag(ctx, tag.TagID); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete tag")
+		return status.Error(codes.Internal, "failed to delete tag")
+	}
+
+	return nil
+}
+
+// getManagedTag returns the tag if the account of the token manages its workspace.
+func (t *tagLogic) getManagedTag(ctx context.Context, token string, tagID uint64) (database.Tag, error) {
+	accountID, err := getAccountIDWithScope(ctx, t.tokenLogic, t.logger, token, APIKeyScopeTasksWrite)
+	if err != nil {
+		return database.Tag{}, err
+	}
+
+	tag, err := t.tagDataAccessor.GetTag(ctx, tagID)
+	if err != nil {
+		if errors.Is(err, database.ErrTagNotFound) {
+			return database.Tag{}, status.Error(codes.NotFound, "tag not found")
+		}
+
+		return database.Tag{}, status.Error(codes.Internal, "failed to get tag")
+	}
+
+	_, err = t.workspaceLogic.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: tag.OfWorkspaceID,
+		Role:        idm.WorkspaceRole_Manager,
+	})
+	if err != nil {
+		return database.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+func databaseTagToProtoTag(tag database.Tag) *idm.Tag {
+	return &idm.Tag{
+		Id:            tag.TagID,
+		OfWorkspaceId: tag.OfWorkspaceID,
+		Name:          tag.Name,
+	}
+}
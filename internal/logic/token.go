@@ -2,12 +2,13 @@ package logic
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/rsa"
-	"crypto/x509"
-	"encoding/pem"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang-jwt/jwt"
@@ -18,69 +19,178 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	sessionLastSeenTimeUpdateInterval = time.Minute
+	apiKeyLastUsedTimeUpdateInterval  = time.Minute
+)
+
+var (
+	ErrSessionRevoked     = errors.New("session has been revoked")
+	ErrAPIKeyInvalid      = errors.New("api key is invalid")
+	ErrAPIKeyScopeMissing = errors.New("api key is missing the required scope")
+)
+
 type TokenLogic interface {
-	CreateTokenString(ctx context.Context, accountID uint64) (string, time.Time, error)
+	CreateTokenString(ctx context.Context, accountID uint64, sessionID string) (string, time.Time, error)
 	GetAccountIDAndExpireTime(ctx context.Context, token string) (uint64, time.Time, error)
+	// GetAccountIDWithScope accepts both session tokens and API keys, API keys must have been granted scope.
+	// Other methods only accept session tokens.
+	GetAccountIDWithScope(ctx context.Context, token string, scope string) (uint64, error)
+	// GetAccountIDAndSessionID parses the token once for callers needing both of its account and session.
+	GetAccountIDAndSessionID(ctx context.Context, token string) (uint64, string, error)
+	// RevokeSession makes every token of the session invalid.
+	RevokeSession(ctx context.Context, session database.Session) error
+	// RevokeSessionListOfAccount revokes every active session of an account except exceptSessionID, which may
+	// be empty.
+	RevokeSessionListOfAccount(ctx context.Context, accountID uint64, exceptSessionID string) error
 	WithDatabase(database database.Database) TokenLogic
 }
 
 func NewTokenLogic(
 	accountDataAccessor database.AccountDataAccessor,
-	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
+	apiKeyDataAccessor database.APIKeyDataAccessor,
+	tokenSigningKeyLogic TokenSigningKeyLogic,
 	logger *zap.Logger,
 	authConfig configs.Auth,
-	tokenPublicKeyCache cache.TokenPublicKey,
+	sessionDenylist cache.SessionDenylist,
 ) (TokenLogic, error) {
+	t := &tokenLogic{
+		accountDataAccessor:  accountDataAccessor,
+		sessionDataAccessor:  sessionDataAccessor,
+		apiKeyDataAccessor:   apiKeyDataAccessor,
+		tokenSigningKeyLogic: tokenSigningKeyLogic,
+		logger:               logger,
+		authConfig:           authConfig,
+		sessionDenylist:      sessionDenylist,
+	}
+
+	// The denylist may live in a cache that does not survive restarts, refill it from the database.
+	if err := t.loadSessionDenylist(context.Background()); err != nil {
+		logger.With(zap.Error(err)).Warn("can not load revoked sessions into session denylist")
+	}
+
+	return t, nil
+}
+
+type tokenLogic struct {
+	accountDataAccessor  database.AccountDataAccessor
+	sessionDataAccessor  database.SessionDataAccessor
+	apiKeyDataAccessor   database.APIKeyDataAccessor
+	tokenSigningKeyLogic TokenSigningKeyLogic
+	logger               *zap.Logger
+	authConfig           configs.Auth
+	sessionDenylist      cache.SessionDenylist
+
+	// sessionLastSeenTimeUpdateTimeMap holds when this server last updated the last seen time of each session,
+	// entries older than sessionLastSeenTimeUpdateInterval are pruned at most once per interval.
+	sessionLastSeenTimeUpdateTimeMap sync.Map
+	sessionLastSeenTimePruneTime     atomic.Int64
+}
+
+// GetAccountIDAndExpireTime implements Token.
+func (t *tokenLogic) GetAccountIDAndExpireTime(ctx context.Context, tokenString string) (uint64, time.Time, error) {
+	accountID, _, expireTime, err := t.parseToken(ctx, tokenString)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return accountID, expireTime, nil
+}
+
+// GetAccountIDWithScope implements Token.
+func (t *tokenLogic) GetAccountIDWithScope(ctx context.Context, token string, scope string) (uint64, error) {
+	if !isAPIKey(token) {
+		accountID, _, _, err := t.parseToken(ctx, token)
+		return accountID, err
+	}
 
-	rsaKeyPair, err := generateRSAKeyPair(int(authConfig.Token.RS512KeyPairBitSize))
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	apiKey, err := t.apiKeyDataAccessor.GetAPIKeyByHashedKey(ctx, hashAPIKey(token))
 	if err != nil {
-		logger.Error("failed to genereate RSA key pair", zap.Error(err))
-		return nil, err
+		if errors.Is(err, database.ErrAPIKeyNotFound) {
+			return 0, ErrAPIKeyInvalid
+		}
+
+		return 0, err
+	}
+
+	now := time.Now()
+	if apiKey.IsRevoked || (apiKey.ExpireTime != nil && !now.Before(*apiKey.ExpireTime)) {
+		return 0, ErrAPIKeyInvalid
+	}
+
+	if !slices.Contains(strings.Fields(apiKey.Scopes), scope) {
+		return 0, ErrAPIKeyScopeMissing
 	}
 
-	publicKeyBytes, err := pemEncodePublicKey(&rsaKeyPair.PublicKey)
+	account, err := t.accountDataAccessor.GetAccountByID(ctx, apiKey.OfAccountID)
 	if err != nil {
-		logger.Error("failed to encode public key in pem format", zap.Error(err))
-		return nil, err
+		return 0, err
 	}
 
-	tokenPublicKeyID, err := tokenPublicKeyDataAccessor.CreatePublicKey(
-		context.Background(),
-		database.TokenPublicKey{
-			TokenPublicKeyValue: publicKeyBytes,
-		})
+	if account.IsDisabled {
+		return 0, ErrAPIKeyInvalid
+	}
+
+	err = t.apiKeyDataAccessor.UpdateAPIKeyLastUsedTime(ctx, apiKey.APIKeyID, now, apiKeyLastUsedTimeUpdateInterval)
 	if err != nil {
-		logger.Error("failed to create token public key", zap.Error(err))
-		return nil, err
+		logger.With(zap.Error(err)).Warn("failed to update api key last used time")
 	}
-	err = tokenPublicKeyCache.Set(context.Background(), fmt.Sprint(tokenPublicKeyID), publicKeyBytes)
+
+	return apiKey.OfAccountID, nil
+}
+
+// GetAccountIDAndSessionID implements Token.
+func (t *tokenLogic) GetAccountIDAndSessionID(ctx context.Context, tokenString string) (uint64, string, error) {
+	accountID, sessionID, _, err := t.parseToken(ctx, tokenString)
 	if err != nil {
-		logger.With(zap.Error(err)).Warn("can not set token public key in cache")
+		return 0, "", err
 	}
 
-	return &tokenLogic{
-		accountDataAccessor:        accountDataAccessor,
-		tokenPublicKeyDataAccessor: tokenPublicKeyDataAccessor,
-		logger:                     logger,
-		authConfig:                 authConfig,
-		tokenPublicKeyID:           tokenPublicKeyID,
-		tokenPrivateKeyValue:       rsaKeyPair,
-		tokenPublicKeyCache:        tokenPublicKeyCache,
-	}, nil
+	return accountID, sessionID, nil
 }
 
-type tokenLogic struct {
-	accountDataAccessor        database.AccountDataAccessor
-	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor
-	logger                     *zap.Logger
-	authConfig                 configs.Auth
-	tokenPublicKeyID           uint64
-	tokenPrivateKeyValue       *rsa.PrivateKey
-	tokenPublicKeyCache        cache.TokenPublicKey
+// RevokeSession implements Token.
+func (t *tokenLogic) RevokeSession(ctx context.Context, session database.Session) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.String("session_id", session.SessionID))
+
+	if err := t.sessionDataAccessor.RevokeSession(ctx, session.SessionID); err != nil {
+		return err
+	}
+
+	// Sessions missing from the denylist are trusted to be active, tokens stay valid until it is added.
+	if err := t.sessionDenylist.Add(ctx, session.SessionID, time.Until(session.ExpireTime)); err != nil {
+		logger.With(zap.Error(err)).Error("can not add session to session denylist")
+		return err
+	}
+
+	return nil
 }
 
-// GetAccountIDAndExpireTime implements Token.
-func (t *tokenLogic) GetAccountIDAndExpireTime(ctx context.Context, tokenString string) (uint64, time.Time, error) {
+// RevokeSessionListOfAccount implements Token.
+func (t *tokenLogic) RevokeSessionListOfAccount(ctx context.Context, accountID uint64, exceptSessionID string) error {
+	sessions, err := t.sessionDataAccessor.GetActiveSessionListOfAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.SessionID == exceptSessionID {
+			continue
+		}
+
+		if err = t.RevokeSession(ctx, session); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseToken verifies a token and returns its account ID, session ID and expire time.
+func (t *tokenLogic) parseToken(ctx context.Context, tokenString string) (uint64, string, time.Time, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
 	keyFunc := func(parsedToken *jwt.Token) (interface{}, error) {
@@ -89,19 +199,13 @@ func (t *tokenLogic) GetAccountIDAndExpireTime(ctx context.Context, tokenString
 			return nil, errors.New("unexpected signing method")
 		}
 
-		claims, ok := parsedToken.Claims.(jwt.MapClaims)
-		if !ok {
-			logger.Error("cannot get token's claims")
-			return nil, errors.New("cannot get token's claims")
-		}
-
-		tokenPublicKeyID, ok := claims["kid"].(float64)
-		if !ok {
-			logger.Error("cannot get token's kid claim")
-			return nil, errors.New("cannot get token's kid claim")
+		tokenPublicKeyID, err := getTokenPublicKeyID(parsedToken)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("cannot get token's key id")
+			return nil, err
 		}
 
-		tokenPublicKeyValue, err := t.getJWTPublicKeyValue(ctx, uint64(tokenPublicKeyID))
+		tokenPublicKeyValue, err := t.tokenSigningKeyLogic.GetPublicKey(ctx, tokenPublicKeyID)
 		if err != nil {
 			logger.Error("cannot get public key's value")
 			return nil, err
@@ -112,51 +216,153 @@ func (t *tokenLogic) GetAccountIDAndExpireTime(ctx context.Context, tokenString
 	parsedToken, err := jwt.Parse(tokenString, keyFunc)
 	if err != nil {
 		logger.Error("cannot parse token", zap.Error(err))
-		return 0, time.Time{}, err
+		return 0, "", time.Time{}, err
 	}
 
 	if !parsedToken.Valid {
 		logger.Error("invalid token")
-		return 0, time.Time{}, errors.New("invalid token")
+		return 0, "", time.Time{}, errors.New("invalid token")
 	}
 
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
 		logger.Error("cannot get token's claims")
-		return 0, time.Time{}, errors.New("cannot get token's claims")
+		return 0, "", time.Time{}, errors.New("cannot get token's claims")
 	}
 
 	accountID, ok := claims["sub"].(float64)
 	if !ok {
 		logger.Error("cannot get token's sub claim")
-		return 0, time.Time{}, errors.New("cannot get token's sub claim")
+		return 0, "", time.Time{}, errors.New("cannot get token's sub claim")
 	}
 
 	expiresAtUnix, ok := claims["exp"].(float64)
 	if !ok {
 		logger.Error("cannot get token's exp claim")
-		return 0, time.Time{}, errors.New("cannot get token's exp claim")
+		return 0, "", time.Time{}, errors.New("cannot get token's exp claim")
+	}
+
+	sessionID, ok := claims["jti"].(string)
+	if !ok {
+		logger.Error("cannot get token's jti claim")
+		return 0, "", time.Time{}, errors.New("cannot get token's jti claim")
+	}
+
+	if err = t.checkSessionActive(ctx, sessionID); err != nil {
+		return 0, "", time.Time{}, err
+	}
+
+	return uint64(accountID), sessionID, time.Unix(int64(expiresAtUnix), 0), nil
+}
+
+// checkSessionActive answers from the denylist, sessions missing from it are active. The database is only
+// queried when the denylist can not be reached.
+func (t *tokenLogic) checkSessionActive(ctx context.Context, sessionID string) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.String("session_id", sessionID))
+
+	revoked, err := t.sessionDenylist.Has(ctx, sessionID)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to check session denylist, will fall back to database")
+
+		session, err := t.sessionDataAccessor.GetSession(ctx, sessionID)
+		if err != nil {
+			if errors.Is(err, database.ErrSessionNotFound) {
+				return ErrSessionRevoked
+			}
+
+			logger.With(zap.Error(err)).Error("cannot get session from database")
+			return err
+		}
+
+		revoked = session.IsRevoked
+	}
+	if revoked {
+		return ErrSessionRevoked
+	}
+
+	t.updateSessionLastSeenTime(ctx, sessionID)
+	return nil
+}
+
+// updateSessionLastSeenTime updates the last seen time of a session in the background, at most once per
+// sessionLastSeenTimeUpdateInterval from this server, so that requests neither wait for nor write to the
+// database each time.
+func (t *tokenLogic) updateSessionLastSeenTime(ctx context.Context, sessionID string) {
+	now := time.Now()
+	if lastUpdateTime, loaded := t.sessionLastSeenTimeUpdateTimeMap.LoadOrStore(sessionID, now); loaded {
+		if now.Sub(lastUpdateTime.(time.Time)) < sessionLastSeenTimeUpdateInterval ||
+			!t.sessionLastSeenTimeUpdateTimeMap.CompareAndSwap(sessionID, lastUpdateTime, now) {
+			return
+		}
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		logger := utils.LoggerWithContext(ctx, t.logger).With(zap.String("session_id", sessionID))
+
+		err := t.sessionDataAccessor.UpdateSessionLastSeenTime(ctx, sessionID, now, sessionLastSeenTimeUpdateInterval)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to update session last seen time")
+		}
+
+		t.pruneSessionLastSeenTimeUpdateTimeMap(now)
+	}()
+}
+
+func (t *tokenLogic) pruneSessionLastSeenTimeUpdateTimeMap(now time.Time) {
+	pruneTime := t.sessionLastSeenTimePruneTime.Load()
+	if now.Sub(time.Unix(0, pruneTime)) < sessionLastSeenTimeUpdateInterval ||
+		!t.sessionLastSeenTimePruneTime.CompareAndSwap(pruneTime, now.UnixNano()) {
+		return
+	}
+
+	t.sessionLastSeenTimeUpdateTimeMap.Range(func(sessionID, lastUpdateTime any) bool {
+		if now.Sub(lastUpdateTime.(time.Time)) >= sessionLastSeenTimeUpdateInterval {
+			t.sessionLastSeenTimeUpdateTimeMap.CompareAndDelete(sessionID, lastUpdateTime)
+		}
+
+		return true
+	})
+}
+
+func (t *tokenLogic) loadSessionDenylist(ctx context.Context) error {
+	revokedSessions, err := t.sessionDataAccessor.GetRevokedUnexpiredSessionList(ctx)
+	if err != nil {
+		return err
 	}
 
-	return uint64(accountID), time.Unix(int64(expiresAtUnix), 0), nil
+	for _, session := range revokedSessions {
+		if err = t.sessionDenylist.Add(ctx, session.SessionID, time.Until(session.ExpireTime)); err != nil {
+			return err
+		}
+	}
 
+	return nil
 }
 
 // CreateTokenString implements Token.
-func (t *tokenLogic) CreateTokenString(ctx context.Context, accountID uint64) (string, time.Time, error) {
+func (t *tokenLogic) CreateTokenString(ctx context.Context, accountID uint64, sessionID string) (string, time.Time, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
+	signingKey, err := t.tokenSigningKeyLogic.GetCurrentSigningKey(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get signing key")
+		return "", time.Time{}, err
+	}
+
 	expiresAt := time.Now().Add(t.authConfig.Token.GetTokenDuration())
 	token := jwt.NewWithClaims(jwt.SigningMethodRS512, jwt.MapClaims{
 		"sub": accountID,
 		"exp": expiresAt.Unix(),
-		"kid": t.tokenPublicKeyID,
+		"kid": signingKey.ID,
+		"jti": sessionID,
 	})
+	token.Header["kid"] = fmt.Sprint(signingKey.ID)
 
-	tokenString, err := token.SignedString(t.tokenPrivateKeyValue)
+	tokenString, err := token.SignedString(signingKey.PrivateKey)
 	if err != nil {
 		logger.Error("failed signing token", zap.Error(err))
-		return "", time.Time{}, nil
+		return "", time.Time{}, err
 	}
 
 	return tokenString, expiresAt, nil
@@ -167,52 +373,22 @@ func (t *tokenLogic) WithDatabase(database database.Database) TokenLogic {
 	panic("unimplemented")
 }
 
-func (t *tokenLogic) getJWTPublicKeyValue(ctx context.Context, tokenPublicKeyID uint64) (*rsa.PublicKey, error) {
-	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("tokenPublicKeyID", tokenPublicKeyID))
-
-	var tokenPublicKeyValue database.TokenPublicKey
-
-	cacheHit := true
-	bytes, err := t.tokenPublicKeyCache.Get(ctx, fmt.Sprintf("%d", tokenPublicKeyID))
-	if err != nil {
-		logger.With(zap.Error(err)).Warn("failed to get tokenPublicKeyValue from cache, will fall back to database")
-		cacheHit = false
-	} else {
-		tokenPublicKeyValue = database.TokenPublicKey{
-			TokenPublicKeyID:    tokenPublicKeyID,
-			TokenPublicKeyValue: bytes,
-		}
-	}
-
-	if !cacheHit {
-		tokenPublicKeyValue, err = t.tokenPublicKeyDataAccessor.GetPublicKey(ctx, tokenPublicKeyID)
-		if err != nil {
-			logger.Error("cannot get token's public key from database", zap.Error(err))
-			return nil, err
-		}
+// getTokenPublicKeyID reads the key id from the token header, tokens signed before the header was set
+// only carry it in their claims.
+func getTokenPublicKeyID(parsedToken *jwt.Token) (uint64, error) {
+	if kid, ok := parsedToken.Header["kid"].(string); ok {
+		return strconv.ParseUint(kid, 10, 64)
 	}
 
-	return jwt.ParseRSAPublicKeyFromPEM(tokenPublicKeyValue.TokenPublicKeyValue)
-}
-
-func pemEncodePublicKey(pubKey *rsa.PublicKey) ([]byte, error) {
-	pubBytes, err := x509.MarshalPKIXPublicKey(pubKey)
-	if err != nil {
-		return nil, err
-	}
-	block := &pem.Block{
-		Type:  "PUBLIC KEY",
-		Bytes: pubBytes,
+	claims, ok := parsedToken.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, errors.New("cannot get token's claims")
 	}
 
-	return pem.EncodeToMemory(block), nil
-}
-
-func generateRSAKeyPair(bits int) (*rsa.PrivateKey, error) {
-	privateKeyPair, err := rsa.GenerateKey(rand.Reader, bits)
-	if err != nil {
-		return nil, err
+	kid, ok := claims["kid"].(float64)
+	if !ok {
+		return 0, errors.New("cannot get token's kid claim")
 	}
 
-	return privateKeyPair, nil
+	return uint64(kid), nil
 }
@@ -0,0 +1,364 @@
+package logic
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/cache"
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	signingKeyEncryptionKeySizeInBytes = 32
+	jwkKeyTypeRSA                      = "RSA"
+	jwkUseSignature                    = "sig"
+)
+
+type TokenSigningKey struct {
+	ID         uint64
+	PrivateKey *rsa.PrivateKey
+}
+
+// JWK is a public signing key in the JSON Web Key format (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Modulus   string `json:"n"`
+	Exponent  string `json:"e"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// TokenSigningKeyLogic manages the keys signing tokens. Keys are stored in the database with their private
+// key encrypted so that every replica signs with the same key, and retired keys are kept until every token
+// they signed has expired.
+type TokenSigningKeyLogic interface {
+	GetCurrentSigningKey(ctx context.Context) (TokenSigningKey, error)
+	GetPublicKey(ctx context.Context, tokenPublicKeyID uint64) (*rsa.PublicKey, error)
+	// RotateSigningKey creates a new signing key if there is none or if the current one is older than the rotation interval.
+	RotateSigningKey(ctx context.Context) error
+	PruneRetiredSigningKey(ctx context.Context) error
+	GetJWKS(ctx context.Context) (JWKS, error)
+}
+
+func NewTokenSigningKeyLogic(
+	database database.Database,
+	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor,
+	tokenPublicKeyCache cache.TokenPublicKey,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (TokenSigningKeyLogic, error) {
+	encryptionKey, err := base64.StdEncoding.DecodeString(authConfig.Token.SigningKey.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("signing key encryption key is not valid base64: %w", err)
+	}
+
+	if len(encryptionKey) != signingKeyEncryptionKeySizeInBytes {
+		return nil, fmt.Errorf("signing key encryption key must be %d bytes long, got %d", signingKeyEncryptionKeySizeInBytes, len(encryptionKey))
+	}
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &tokenSigningKeyLogic{
+		database:                   database,
+		tokenPublicKeyDataAccessor: tokenPublicKeyDataAccessor,
+		tokenPublicKeyCache:        tokenPublicKeyCache,
+		authConfig:                 authConfig,
+		encryptionAEAD:             aead,
+		currentSigningKeyMutex:     &sync.Mutex{},
+		logger:                     logger,
+	}
+
+	if err = t.RotateSigningKey(context.Background()); err != nil {
+		logger.With(zap.Error(err)).Error("failed to initialize signing key")
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type tokenSigningKeyLogic struct {
+	database                   database.Database
+	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor
+	tokenPublicKeyCache        cache.TokenPublicKey
+	authConfig                 configs.Auth
+	encryptionAEAD             cipher.AEAD
+	currentSigningKey          TokenSigningKey
+	currentSigningKeyLoadTime  time.Time
+	currentSigningKeyMutex     *sync.Mutex
+	logger                     *zap.Logger
+}
+
+// GetCurrentSigningKey implements TokenSigningKeyLogic.
+func (t *tokenSigningKeyLogic) GetCurrentSigningKey(ctx context.Context) (TokenSigningKey, error) {
+	t.currentSigningKeyMutex.Lock()
+	defer t.currentSigningKeyMutex.Unlock()
+
+	// Another replica may have rotated the key, reload it from time to time.
+	if t.currentSigningKey.PrivateKey != nil &&
+		time.Since(t.currentSigningKeyLoadTime) < t.authConfig.Token.SigningKey.GetReloadInterval() {
+		return t.currentSigningKey, nil
+	}
+
+	tokenPublicKey, err := t.tokenPublicKeyDataAccessor.GetCurrentSigningKey(ctx)
+	if err != nil {
+		return TokenSigningKey{}, err
+	}
+
+	signingKey, err := t.decryptSigningKey(tokenPublicKey)
+	if err != nil {
+		utils.LoggerWithContext(ctx, t.logger).With(zap.Error(err)).Error("failed to decrypt signing key")
+		return TokenSigningKey{}, err
+	}
+
+	t.currentSigningKey = signingKey
+	t.currentSigningKeyLoadTime = time.Now()
+	return signingKey, nil
+}
+
+// GetPublicKey implements TokenSigningKeyLogic.
+func (t *tokenSigningKeyLogic) GetPublicKey(ctx context.Context, tokenPublicKeyID uint64) (*rsa.PublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("tokenPublicKeyID", tokenPublicKeyID))
+
+	var tokenPublicKeyValue database.TokenPublicKey
+
+	cacheHit := true
+	bytes, err := t.tokenPublicKeyCache.Get(ctx, fmt.Sprintf("%d", tokenPublicKeyID))
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to get tokenPublicKeyValue from cache, will fall back to database")
+		cacheHit = false
+	} else {
+		tokenPublicKeyValue = database.TokenPublicKey{
+			TokenPublicKeyID:    tokenPublicKeyID,
+			TokenPublicKeyValue: bytes,
+		}
+	}
+
+	if !cacheHit {
+		tokenPublicKeyValue, err = t.tokenPublicKeyDataAccessor.GetPublicKey(ctx, tokenPublicKeyID)
+		if err != nil {
+			logger.Error("cannot get token's public key from database", zap.Error(err))
+			return nil, err
+		}
+
+		err = t.tokenPublicKeyCache.Set(ctx, fmt.Sprint(tokenPublicKeyID), tokenPublicKeyValue.TokenPublicKeyValue)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("can not set token public key in cache")
+		}
+	}
+
+	return jwt.ParseRSAPublicKeyFromPEM(tokenPublicKeyValue.TokenPublicKeyValue)
+}
+
+// RotateSigningKey implements TokenSigningKeyLogic.
+func (t *tokenSigningKeyLogic) RotateSigningKey(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	rotated := false
+	txErr := t.database.Transaction(func(tx *gorm.DB) error {
+		// Locking the current key makes replicas rotating at the same time wait for each other.
+		currentSigningKey, err := t.tokenPublicKeyDataAccessor.WithDatabaseTransaction(tx).GetCurrentSigningKeyForUpdate(ctx)
+		if err != nil && !errors.Is(err, database.ErrTokenPublicKeyNotFound) {
+			return err
+		}
+		if err == nil && time.Since(currentSigningKey.CreateTime) < t.authConfig.Token.SigningKey.GetRotationInterval() {
+			return nil
+		}
+
+		tokenPublicKey, err := t.generateSigningKey()
+		if err != nil {
+			return err
+		}
+
+		tokenPublicKeyID, err := t.tokenPublicKeyDataAccessor.WithDatabaseTransaction(tx).CreatePublicKey(ctx, tokenPublicKey)
+		if err != nil {
+			return err
+		}
+
+		err = t.tokenPublicKeyDataAccessor.WithDatabaseTransaction(tx).RetireSigningKeysExcept(ctx, tokenPublicKeyID, tokenPublicKey.CreateTime)
+		if err != nil {
+			return err
+		}
+
+		logger.With(zap.Uint64("token_public_key_id", tokenPublicKeyID)).Info("rotated token signing key")
+		rotated = true
+		return nil
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Error("failed to rotate signing key")
+		return txErr
+	}
+
+	if rotated {
+		t.currentSigningKeyMutex.Lock()
+		t.currentSigningKey = TokenSigningKey{}
+		t.currentSigningKeyMutex.Unlock()
+	}
+
+	return nil
+}
+
+// PruneRetiredSigningKey implements TokenSigningKeyLogic.
+func (t *tokenSigningKeyLogic) PruneRetiredSigningKey(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	deletedCount, err := t.tokenPublicKeyDataAccessor.DeletePublicKeysRetiredBefore(ctx, t.getVerifiableRetireTime())
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to prune retired signing keys")
+		return err
+	}
+
+	if deletedCount > 0 {
+		logger.With(zap.Int64("deleted_count", deletedCount)).Info("pruned retired signing keys")
+	}
+
+	return nil
+}
+
+// GetJWKS implements TokenSigningKeyLogic.
+func (t *tokenSigningKeyLogic) GetJWKS(ctx context.Context) (JWKS, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	tokenPublicKeys, err := t.tokenPublicKeyDataAccessor.GetPublicKeyListRetiredAfter(ctx, t.getVerifiableRetireTime())
+	if err != nil {
+		return JWKS{}, err
+	}
+
+	jwks := JWKS{
+		Keys: make([]JWK, 0, len(tokenPublicKeys)),
+	}
+	for _, tokenPublicKey := range tokenPublicKeys {
+		publicKey, err := jwt.ParseRSAPublicKeyFromPEM(tokenPublicKey.TokenPublicKeyValue)
+		if err != nil {
+			logger.With(zap.Uint64("token_public_key_id", tokenPublicKey.TokenPublicKeyID)).With(zap.Error(err)).
+				Error("failed to parse public key")
+			return JWKS{}, err
+		}
+
+		jwks.Keys = append(jwks.Keys, JWK{
+			KeyType:   jwkKeyTypeRSA,
+			KeyID:     fmt.Sprint(tokenPublicKey.TokenPublicKeyID),
+			Use:       jwkUseSignature,
+			Algorithm: jwt.SigningMethodRS512.Alg(),
+			Modulus:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+			Exponent:  base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		})
+	}
+
+	return jwks, nil
+}
+
+// getVerifiableRetireTime returns the time before which retired keys can not have signed unexpired tokens.
+// A replica keeps signing with a retired key until it reloads the current key.
+func (t *tokenSigningKeyLogic) getVerifiableRetireTime() time.Time {
+	return time.Now().
+		Add(-t.authConfig.Token.GetTokenDuration()).
+		Add(-t.authConfig.Token.SigningKey.GetReloadInterval())
+}
+
+func (t *tokenSigningKeyLogic) generateSigningKey() (database.TokenPublicKey, error) {
+	privateKey, err := generateRSAKeyPair(int(t.authConfig.Token.RS512KeyPairBitSize))
+	if err != nil {
+		return database.TokenPublicKey{}, err
+	}
+
+	publicKeyBytes, err := pemEncodePublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return database.TokenPublicKey{}, err
+	}
+
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return database.TokenPublicKey{}, err
+	}
+
+	nonce := make([]byte, t.encryptionAEAD.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return database.TokenPublicKey{}, err
+	}
+
+	// The public key is authenticated with the private key so that the pair can not be mixed up.
+	return database.TokenPublicKey{
+		TokenPublicKeyValue: publicKeyBytes,
+		EncryptedPrivateKey: t.encryptionAEAD.Seal(nonce, nonce, privateKeyBytes, publicKeyBytes),
+		CreateTime:          time.Now().UTC().Truncate(time.Second),
+	}, nil
+}
+
+func (t *tokenSigningKeyLogic) decryptSigningKey(tokenPublicKey database.TokenPublicKey) (TokenSigningKey, error) {
+	nonceSize := t.encryptionAEAD.NonceSize()
+	if len(tokenPublicKey.EncryptedPrivateKey) < nonceSize {
+		return TokenSigningKey{}, errors.New("encrypted private key is too short")
+	}
+
+	nonce, sealedPrivateKey := tokenPublicKey.EncryptedPrivateKey[:nonceSize], tokenPublicKey.EncryptedPrivateKey[nonceSize:]
+	privateKeyBytes, err := t.encryptionAEAD.Open(nil, nonce, sealedPrivateKey, tokenPublicKey.TokenPublicKeyValue)
+	if err != nil {
+		return TokenSigningKey{}, err
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(privateKeyBytes)
+	if err != nil {
+		return TokenSigningKey{}, err
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return TokenSigningKey{}, errors.New("private key is not an rsa key")
+	}
+
+	return TokenSigningKey{
+		ID:         tokenPublicKey.TokenPublicKeyID,
+		PrivateKey: rsaPrivateKey,
+	}, nil
+}
+
+func pemEncodePublicKey(pubKey *rsa.PublicKey) ([]byte, error) {
+	pubBytes, err := x509.MarshalPKIXPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	block := &pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: pubBytes,
+	}
+
+	return pem.EncodeToMemory(block), nil
+}
+
+func generateRSAKeyPair(bits int) (*rsa.PrivateKey, error) {
+	privateKeyPair, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKeyPair, nil
+}
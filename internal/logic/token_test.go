@@ -0,0 +1,143 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"go.uber.org/zap"
+)
+
+type fakeSessionDenylist struct {
+	revokedSessionIDList []string
+	hasErr               error
+}
+
+func (f *fakeSessionDenylist) Add(ctx context.Context, sessionID string, ttl time.Duration) error {
+	f.revokedSessionIDList = append(f.revokedSessionIDList, sessionID)
+	return nil
+}
+
+func (f *fakeSessionDenylist) Has(ctx context.Context, sessionID string) (bool, error) {
+	for _, revokedSessionID := range f.revokedSessionIDList {
+		if revokedSessionID == sessionID {
+			return true, f.hasErr
+		}
+	}
+
+	return false, f.hasErr
+}
+
+type fakeSessionDataAccessor struct {
+	database.SessionDataAccessor
+
+	mutex                sync.Mutex
+	sessionList          []database.Session
+	getSessionCount      int
+	lastSeenTimeUpdateCh chan string
+}
+
+func (f *fakeSessionDataAccessor) GetSession(ctx context.Context, sessionID string) (database.Session, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.getSessionCount++
+	for _, session := range f.sessionList {
+		if session.SessionID == sessionID {
+			return session, nil
+		}
+	}
+
+	return database.Session{}, database.ErrSessionNotFound
+}
+
+func (f *fakeSessionDataAccessor) UpdateSessionLastSeenTime(
+	ctx context.Context,
+	sessionID string,
+	lastSeenTime time.Time,
+	minUpdateInterval time.Duration,
+) error {
+	f.lastSeenTimeUpdateCh <- sessionID
+	return nil
+}
+
+func TestCheckSessionActive(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		sessionID               string
+		denylistErr             error
+		expectedErr             error
+		expectedGetSessionCount int
+	}{
+		{name: "active", sessionID: "active", expectedGetSessionCount: 0},
+		{name: "revoked in denylist", sessionID: "revoked", expectedErr: ErrSessionRevoked},
+		{name: "active without denylist", sessionID: "active", denylistErr: errors.New("cache is unavailable"), expectedGetSessionCount: 1},
+		{name: "revoked without denylist", sessionID: "revoked", denylistErr: errors.New("cache is unavailable"), expectedErr: ErrSessionRevoked, expectedGetSessionCount: 1},
+		{name: "unknown without denylist", sessionID: "unknown", denylistErr: errors.New("cache is unavailable"), expectedErr: ErrSessionRevoked, expectedGetSessionCount: 1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sessionDataAccessor := &fakeSessionDataAccessor{
+				sessionList: []database.Session{
+					{SessionID: "active"},
+					{SessionID: "revoked", IsRevoked: true},
+				},
+				lastSeenTimeUpdateCh: make(chan string, 1),
+			}
+			tl := &tokenLogic{
+				sessionDataAccessor: sessionDataAccessor,
+				logger:              zap.NewNop(),
+				sessionDenylist:     &fakeSessionDenylist{revokedSessionIDList: []string{"revoked"}, hasErr: testCase.denylistErr},
+			}
+
+			if err := tl.checkSessionActive(context.Background(), testCase.sessionID); !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected %v, got %v", testCase.expectedErr, err)
+			}
+
+			if testCase.expectedErr == nil {
+				if sessionID := <-sessionDataAccessor.lastSeenTimeUpdateCh; sessionID != testCase.sessionID {
+					t.Errorf("expected last seen time of %s to be updated, got %s", testCase.sessionID, sessionID)
+				}
+			}
+
+			sessionDataAccessor.mutex.Lock()
+			defer sessionDataAccessor.mutex.Unlock()
+			if sessionDataAccessor.getSessionCount != testCase.expectedGetSessionCount {
+				t.Errorf("expected %d session queries, got %d", testCase.expectedGetSessionCount, sessionDataAccessor.getSessionCount)
+			}
+		})
+	}
+}
+
+func TestCheckSessionActiveUpdatesLastSeenTimeOncePerInterval(t *testing.T) {
+	sessionDataAccessor := &fakeSessionDataAccessor{lastSeenTimeUpdateCh: make(chan string, 10)}
+	tl := &tokenLogic{
+		sessionDataAccessor: sessionDataAccessor,
+		logger:              zap.NewNop(),
+		sessionDenylist:     &fakeSessionDenylist{},
+	}
+
+	for i := 0; i < 5; i++ {
+		if err := tl.checkSessionActive(context.Background(), "active"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	<-sessionDataAccessor.lastSeenTimeUpdateCh
+
+	// Updates older than the interval are due again.
+	tl.sessionLastSeenTimeUpdateTimeMap.Store("active", time.Now().Add(-sessionLastSeenTimeUpdateInterval))
+	if err := tl.checkSessionActive(context.Background(), "active"); err != nil {
+		t.Fatal(err)
+	}
+	<-sessionDataAccessor.lastSeenTimeUpdateCh
+
+	select {
+	case <-time.After(10 * time.Millisecond):
+	case <-sessionDataAccessor.lastSeenTimeUpdateCh:
+		t.Error("expected the last seen time to be updated only twice")
+	}
+}
@@ -0,0 +1,505 @@
+package logic
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	totpEncryptionKeySizeInBytes = 32
+	totpSecretSizeInBytes        = 20
+	totpDigits                   = 6
+	totpPeriodInSeconds          = 30
+	// totpAllowedTimeStepSkew is the number of time steps before and after the current one whose codes are
+	// accepted, to allow for clock drift and codes entered just before they change.
+	totpAllowedTimeStepSkew = 1
+	recoveryCodeLength      = 10
+)
+
+var (
+	ErrTOTPCodeInvalid = errors.New("totp code is invalid")
+
+	errTOTPNotEnrolled      = errors.New("totp is not enrolled")
+	errTOTPAlreadyActivated = errors.New("totp is already activated")
+)
+
+type EnrollTOTPInput struct {
+	Token string
+}
+
+type EnrollTOTPOutput struct {
+	Secret     string
+	OTPAuthURI string
+}
+
+type ActivateTOTPInput struct {
+	Token string
+	Code  string
+}
+
+type ActivateTOTPOutput struct {
+	RecoveryCodeList []string
+}
+
+type DisableTOTPInput struct {
+	Token string
+	Code  string
+}
+
+// TOTPLogic manages the TOTP second factor of accounts. TOTP secrets are stored encrypted, recovery codes
+// are stored hashed.
+type TOTPLogic interface {
+	EnrollTOTP(ctx context.Context, in EnrollTOTPInput) (EnrollTOTPOutput, error)
+	// ActivateTOTP requires a code of the enrolled secret and returns new recovery codes.
+	ActivateTOTP(ctx context.Context, in ActivateTOTPInput) (ActivateTOTPOutput, error)
+	// DisableTOTP requires a TOTP code or a recovery code.
+	DisableTOTP(ctx context.Context, in DisableTOTPInput) error
+	// DisableTOTPOfAccount does not authenticate its callers, the caller must have checked that they are
+	// allowed to disable the TOTP of the account.
+	DisableTOTPOfAccount(ctx context.Context, accountID uint64) error
+	IsTOTPActivated(ctx context.Context, accountID uint64) (bool, error)
+	// VerifyCode accepts a TOTP code or an unused recovery code of an account with TOTP activated, a code
+	// can only be used once. It returns ErrTOTPCodeInvalid for any other code.
+	VerifyCode(ctx context.Context, accountID uint64, code string) error
+}
+
+func NewTOTPLogic(
+	database database.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	accountTOTPDataAccessor database.AccountTOTPDataAccessor,
+	accountRecoveryCodeDataAccessor database.AccountRecoveryCodeDataAccessor,
+	tokenLogic TokenLogic,
+	logger *zap.Logger,
+	authConfig configs.Auth,
+) (TOTPLogic, error) {
+	if authConfig.TOTP.EncryptionKey == "" {
+		return nil, fmt.Errorf("totp encryption key is not set, set auth.totp.encryption_key or %s", configs.EnvTOTPEncryptionKey)
+	}
+
+	encryptionKey, err := base64.StdEncoding.DecodeString(authConfig.TOTP.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("totp encryption key is not valid base64: %w", err)
+	}
+
+	if len(encryptionKey) != totpEncryptionKeySizeInBytes {
+		return nil, fmt.Errorf("totp encryption key must be %d bytes long, got %d", totpEncryptionKeySizeInBytes, len(encryptionKey))
+	}
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &totpLogic{
+		database:                        database,
+		accountDataAccessor:             accountDataAccessor,
+		accountTOTPDataAccessor:         accountTOTPDataAccessor,
+		accountRecoveryCodeDataAccessor: accountRecoveryCodeDataAccessor,
+		tokenLogic:                      tokenLogic,
+		logger:                          logger,
+		totpConfig:                      authConfig.TOTP,
+		encryptionAEAD:                  aead,
+	}, nil
+}
+
+type totpLogic struct {
+	database                        database.Database
+	accountDataAccessor             database.AccountDataAccessor
+	accountTOTPDataAccessor         database.AccountTOTPDataAccessor
+	accountRecoveryCodeDataAccessor database.AccountRecoveryCodeDataAccessor
+	tokenLogic                      TokenLogic
+	logger                          *zap.Logger
+	totpConfig                      configs.TOTP
+	encryptionAEAD                  cipher.AEAD
+}
+
+// EnrollTOTP implements TOTPLogic.
+func (t *totpLogic) EnrollTOTP(ctx context.Context, in EnrollTOTPInput) (EnrollTOTPOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	accountID, _, err := t.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to find account from token")
+		return EnrollTOTPOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	logger = logger.With(zap.Uint64("account_id", accountID))
+
+	account, err := t.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return EnrollTOTPOutput{}, status.Error(codes.Internal, "error getting account")
+	}
+	if account.AccountID == 0 {
+		return EnrollTOTPOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	secret := make([]byte, totpSecretSizeInBytes)
+	if _, err = rand.Read(secret); err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate totp secret")
+		return EnrollTOTPOutput{}, status.Error(codes.Internal, "failed to enroll totp")
+	}
+
+	encryptedSecret, err := t.encryptSecret(accountID, secret)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to encrypt totp secret")
+		return EnrollTOTPOutput{}, status.Error(codes.Internal, "failed to enroll totp")
+	}
+
+	txErr := t.database.Transaction(func(tx *gorm.DB) error {
+		accountTOTP, err := t.accountTOTPDataAccessor.WithDatabaseTransaction(tx).GetAccountTOTPForUpdate(ctx, accountID)
+		if err != nil && !errors.Is(err, database.ErrAccountTOTPNotFound) {
+			return err
+		}
+
+		if err == nil && accountTOTP.IsActivated {
+			return errTOTPAlreadyActivated
+		}
+
+		return t.accountTOTPDataAccessor.WithDatabaseTransaction(tx).CreateOrReplaceAccountTOTP(ctx, database.AccountTOTP{
+			OfAccountID:     accountID,
+			EncryptedSecret: encryptedSecret,
+			CreateTime:      time.Now(),
+		})
+	})
+	if txErr != nil {
+		if errors.Is(txErr, errTOTPAlreadyActivated) {
+			return EnrollTOTPOutput{}, status.Error(codes.FailedPrecondition, "totp is already activated, disable it first")
+		}
+
+		logger.With(zap.Error(txErr)).Error("enroll totp transaction failed")
+		return EnrollTOTPOutput{}, status.Error(codes.Internal, "failed to enroll totp")
+	}
+
+	encodedSecret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(secret)
+	return EnrollTOTPOutput{
+		Secret:     encodedSecret,
+		OTPAuthURI: t.getOTPAuthURI(account.AccountName, encodedSecret),
+	}, nil
+}
+
+// ActivateTOTP implements TOTPLogic.
+func (t *totpLogic) ActivateTOTP(ctx context.Context, in ActivateTOTPInput) (ActivateTOTPOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	accountID, _, err := t.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to find account from token")
+		return ActivateTOTPOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	logger = logger.With(zap.Uint64("account_id", accountID))
+
+	recoveryCodeList, hashedRecoveryCodeList, err := generateRecoveryCodeList(t.totpConfig.RecoveryCodeCount)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate recovery codes")
+		return ActivateTOTPOutput{}, status.Error(codes.Internal, "failed to activate totp")
+	}
+
+	txErr := t.database.Transaction(func(tx *gorm.DB) error {
+		accountTOTP, err := t.accountTOTPDataAccessor.WithDatabaseTransaction(tx).GetAccountTOTPForUpdate(ctx, accountID)
+		if err != nil {
+			if errors.Is(err, database.ErrAccountTOTPNotFound) {
+				return errTOTPNotEnrolled
+			}
+
+			return err
+		}
+
+		if accountTOTP.IsActivated {
+			return errTOTPAlreadyActivated
+		}
+
+		timeStep, err := t.verifyTOTPCode(accountTOTP, in.Code)
+		if err != nil {
+			return err
+		}
+
+		if err = t.accountTOTPDataAccessor.WithDatabaseTransaction(tx).ActivateAccountTOTP(ctx, accountID); err != nil {
+			return err
+		}
+
+		if err = t.accountTOTPDataAccessor.WithDatabaseTransaction(tx).UpdateAccountTOTPLastUsedTimeStep(ctx, accountID, timeStep); err != nil {
+			return err
+		}
+
+		if err = t.accountRecoveryCodeDataAccessor.WithDatabaseTransaction(tx).DeleteAccountRecoveryCodeListOfAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		accountRecoveryCodeList := make([]database.AccountRecoveryCode, 0, len(hashedRecoveryCodeList))
+		for _, hashedRecoveryCode := range hashedRecoveryCodeList {
+			accountRecoveryCodeList = append(accountRecoveryCodeList, database.AccountRecoveryCode{
+				OfAccountID: accountID,
+				HashedCode:  hashedRecoveryCode,
+			})
+		}
+
+		return t.accountRecoveryCodeDataAccessor.WithDatabaseTransaction(tx).CreateAccountRecoveryCodeList(ctx, accountRecoveryCodeList)
+	})
+	if txErr != nil {
+		switch {
+		case errors.Is(txErr, errTOTPNotEnrolled):
+			return ActivateTOTPOutput{}, status.Error(codes.FailedPrecondition, "totp is not enrolled")
+		case errors.Is(txErr, errTOTPAlreadyActivated):
+			return ActivateTOTPOutput{}, status.Error(codes.FailedPrecondition, "totp is already activated")
+		case errors.Is(txErr, ErrTOTPCodeInvalid):
+			return ActivateTOTPOutput{}, status.Error(codes.InvalidArgument, "totp code is wrong")
+		default:
+			logger.With(zap.Error(txErr)).Error("activate totp transaction failed")
+			return ActivateTOTPOutput{}, status.Error(codes.Internal, "failed to activate totp")
+		}
+	}
+
+	logger.Info("totp activated")
+	return ActivateTOTPOutput{
+		RecoveryCodeList: recoveryCodeList,
+	}, nil
+}
+
+// DisableTOTP implements TOTPLogic.
+func (t *totpLogic) DisableTOTP(ctx context.Context, in DisableTOTPInput) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	accountID, _, err := t.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to find account from token")
+		return status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	logger = logger.With(zap.Uint64("account_id", accountID))
+
+	txErr := t.database.Transaction(func(tx *gorm.DB) error {
+		if err := t.verifyCode(ctx, tx, accountID, in.Code); err != nil {
+			return err
+		}
+
+		return t.deleteTOTPOfAccount(ctx, tx, accountID)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, ErrTOTPCodeInvalid) {
+			return status.Error(codes.InvalidArgument, "totp code is wrong")
+		}
+
+		logger.With(zap.Error(txErr)).Error("disable totp transaction failed")
+		return status.Error(codes.Internal, "failed to disable totp")
+	}
+
+	logger.Info("totp disabled")
+	return nil
+}
+
+// DisableTOTPOfAccount implements TOTPLogic.
+func (t *totpLogic) DisableTOTPOfAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", accountID))
+
+	txErr := t.database.Transaction(func(tx *gorm.DB) error {
+		return t.deleteTOTPOfAccount(ctx, tx, accountID)
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Error("disable totp of account transaction failed")
+		return status.Error(codes.Internal, "failed to disable totp")
+	}
+
+	logger.Info("totp of account disabled")
+	return nil
+}
+
+// IsTOTPActivated implements TOTPLogic.
+func (t *totpLogic) IsTOTPActivated(ctx context.Context, accountID uint64) (bool, error) {
+	accountTOTP, err := t.accountTOTPDataAccessor.GetAccountTOTP(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountTOTPNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return accountTOTP.IsActivated, nil
+}
+
+// VerifyCode implements TOTPLogic.
+func (t *totpLogic) VerifyCode(ctx context.Context, accountID uint64, code string) error {
+	return t.database.Transaction(func(tx *gorm.DB) error {
+		return t.verifyCode(ctx, tx, accountID, code)
+	})
+}
+
+// verifyCode checks a TOTP code or a recovery code and marks it used within tx.
+func (t *totpLogic) verifyCode(ctx context.Context, tx *gorm.DB, accountID uint64, code string) error {
+	accountTOTP, err := t.accountTOTPDataAccessor.WithDatabaseTransaction(tx).GetAccountTOTPForUpdate(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountTOTPNotFound) {
+			return ErrTOTPCodeInvalid
+		}
+
+		return err
+	}
+
+	if !accountTOTP.IsActivated {
+		return ErrTOTPCodeInvalid
+	}
+
+	if len(code) == totpDigits {
+		timeStep, err := t.verifyTOTPCode(accountTOTP, code)
+		if err != nil {
+			return err
+		}
+
+		return t.accountTOTPDataAccessor.WithDatabaseTransaction(tx).UpdateAccountTOTPLastUsedTimeStep(ctx, accountID, timeStep)
+	}
+
+	accountRecoveryCode, err := t.accountRecoveryCodeDataAccessor.WithDatabaseTransaction(tx).
+		GetUnusedAccountRecoveryCodeForUpdate(ctx, accountID, hashRecoveryCode(code))
+	if err != nil {
+		if errors.Is(err, database.ErrAccountRecoveryCodeNotFound) {
+			return ErrTOTPCodeInvalid
+		}
+
+		return err
+	}
+
+	utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", accountID)).Info("recovery code used")
+	return t.accountRecoveryCodeDataAccessor.WithDatabaseTransaction(tx).MarkAccountRecoveryCodeUsed(ctx, accountRecoveryCode.AccountRecoveryCodeID)
+}
+
+// verifyTOTPCode returns the time step of code if it is a code of the secret of accountTOTP which has not
+// been used yet.
+func (t *totpLogic) verifyTOTPCode(accountTOTP database.AccountTOTP, code string) (uint64, error) {
+	secret, err := t.decryptSecret(accountTOTP.OfAccountID, accountTOTP.EncryptedSecret)
+	if err != nil {
+		return 0, err
+	}
+
+	currentTimeStep := uint64(time.Now().Unix()) / totpPeriodInSeconds
+	for timeStep := currentTimeStep - totpAllowedTimeStepSkew; timeStep <= currentTimeStep+totpAllowedTimeStepSkew; timeStep++ {
+		if timeStep <= accountTOTP.LastUsedTimeStep {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(generateTOTPCode(secret, timeStep)), []byte(code)) == 1 {
+			return timeStep, nil
+		}
+	}
+
+	return 0, ErrTOTPCodeInvalid
+}
+
+func (t *totpLogic) deleteTOTPOfAccount(ctx context.Context, tx *gorm.DB, accountID uint64) error {
+	if err := t.accountRecoveryCodeDataAccessor.WithDatabaseTransaction(tx).DeleteAccountRecoveryCodeListOfAccount(ctx, accountID); err != nil {
+		return err
+	}
+
+	return t.accountTOTPDataAccessor.WithDatabaseTransaction(tx).DeleteAccountTOTP(ctx, accountID)
+}
+
+// encryptSecret returns the nonce followed by the sealed secret, the account ID is authenticated so that
+// a secret can not be moved to another account.
+func (t *totpLogic) encryptSecret(accountID uint64, secret []byte) ([]byte, error) {
+	nonce := make([]byte, t.encryptionAEAD.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return t.encryptionAEAD.Seal(nonce, nonce, secret, binary.BigEndian.AppendUint64(nil, accountID)), nil
+}
+
+func (t *totpLogic) decryptSecret(accountID uint64, encryptedSecret []byte) ([]byte, error) {
+	nonceSize := t.encryptionAEAD.NonceSize()
+	if len(encryptedSecret) < nonceSize {
+		return nil, errors.New("encrypted totp secret is too short")
+	}
+
+	nonce, sealedSecret := encryptedSecret[:nonceSize], encryptedSecret[nonceSize:]
+	return t.encryptionAEAD.Open(nil, nonce, sealedSecret, binary.BigEndian.AppendUint64(nil, accountID))
+}
+
+// getOTPAuthURI returns the key URI authenticator apps import, usually from a QR code.
+func (t *totpLogic) getOTPAuthURI(accountName string, encodedSecret string) string {
+	query := url.Values{}
+	query.Set("secret", encodedSecret)
+	query.Set("issuer", t.totpConfig.Issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(totpPeriodInSeconds))
+
+	uri := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + t.totpConfig.Issuer + ":" + accountName,
+		RawQuery: query.Encode(),
+	}
+
+	return uri.String()
+}
+
+// generateTOTPCode returns the code of a time step as specified by RFC 6238 with HMAC-SHA1.
+func generateTOTPCode(secret []byte, timeStep uint64) string {
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(binary.BigEndian.AppendUint64(nil, timeStep))
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%modulo)
+}
+
+// generateRecoveryCodeList returns random recovery codes and their hashes, only the hashes are stored.
+func generateRecoveryCodeList(count int) ([]string, []string, error) {
+	recoveryCodeList := make([]string, 0, count)
+	hashedRecoveryCodeList := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		randomBytes := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(randomBytes); err != nil {
+			return nil, nil, err
+		}
+
+		code := base32.StdEncoding.EncodeToString(randomBytes)[:recoveryCodeLength]
+		recoveryCode := code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+		recoveryCodeList = append(recoveryCodeList, recoveryCode)
+		hashedRecoveryCodeList = append(hashedRecoveryCodeList, hashRecoveryCode(recoveryCode))
+	}
+
+	return recoveryCodeList, hashedRecoveryCodeList, nil
+}
+
+// hashRecoveryCode ignores the case and the dashes of a recovery code, as people type them in.
+func hashRecoveryCode(recoveryCode string) string {
+	normalizedRecoveryCode := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(recoveryCode))
+	hashedRecoveryCode := sha256.Sum256([]byte(normalizedRecoveryCode))
+	return hex.EncodeToString(hashedRecoveryCode[:])
+}
@@ -0,0 +1,171 @@
+package logic
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"go.uber.org/zap"
+)
+
+func newTestTOTPLogic(t *testing.T) *totpLogic {
+	t.Helper()
+
+	encryptionKey := make([]byte, totpEncryptionKeySizeInBytes)
+	if _, err := rand.Read(encryptionKey); err != nil {
+		t.Fatal(err)
+	}
+
+	authConfig := configs.Auth{}
+	authConfig.TOTP.EncryptionKey = base64.StdEncoding.EncodeToString(encryptionKey)
+	totp, err := NewTOTPLogic(nil, nil, nil, nil, nil, zap.NewNop(), authConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return totp.(*totpLogic)
+}
+
+// TestGenerateTOTPCode uses the SHA-1 test vectors of RFC 6238, truncated to 6 digits.
+func TestGenerateTOTPCode(t *testing.T) {
+	secret := []byte("12345678901234567890")
+
+	testCases := []struct {
+		unixTime int64
+		expected string
+	}{
+		{unixTime: 59, expected: "287082"},
+		{unixTime: 1111111109, expected: "081804"},
+		{unixTime: 1111111111, expected: "050471"},
+		{unixTime: 1234567890, expected: "005924"},
+		{unixTime: 2000000000, expected: "279037"},
+		{unixTime: 20000000000, expected: "353130"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.expected, func(t *testing.T) {
+			code := generateTOTPCode(secret, uint64(testCase.unixTime)/totpPeriodInSeconds)
+			if code != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, code)
+			}
+		})
+	}
+}
+
+func TestVerifyTOTPCode(t *testing.T) {
+	totp := newTestTOTPLogic(t)
+
+	secret := make([]byte, totpSecretSizeInBytes)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatal(err)
+	}
+
+	const accountID = 1
+	encryptedSecret, err := totp.encryptSecret(accountID, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	currentTimeStep := uint64(time.Now().Unix()) / totpPeriodInSeconds
+	testCases := []struct {
+		name             string
+		accountID        uint64
+		lastUsedTimeStep uint64
+		code             string
+		expectedErr      error
+	}{
+		{
+			name:      "current code",
+			accountID: accountID,
+			code:      generateTOTPCode(secret, currentTimeStep),
+		},
+		{
+			name:      "previous code",
+			accountID: accountID,
+			code:      generateTOTPCode(secret, currentTimeStep-1),
+		},
+		{
+			name:        "expired code",
+			accountID:   accountID,
+			code:        generateTOTPCode(secret, currentTimeStep-2),
+			expectedErr: ErrTOTPCodeInvalid,
+		},
+		{
+			name:             "replayed code",
+			accountID:        accountID,
+			lastUsedTimeStep: currentTimeStep,
+			code:             generateTOTPCode(secret, currentTimeStep),
+			expectedErr:      ErrTOTPCodeInvalid,
+		},
+		{
+			name:        "wrong code",
+			accountID:   accountID,
+			code:        "not a code",
+			expectedErr: ErrTOTPCodeInvalid,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			timeStep, err := totp.verifyTOTPCode(database.AccountTOTP{
+				OfAccountID:      testCase.accountID,
+				EncryptedSecret:  encryptedSecret,
+				LastUsedTimeStep: testCase.lastUsedTimeStep,
+			}, testCase.code)
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedErr, err)
+			}
+
+			if err == nil && timeStep <= testCase.lastUsedTimeStep {
+				t.Errorf("accepted time step %d is not after the last used one", timeStep)
+			}
+		})
+	}
+}
+
+func TestVerifyTOTPCodeOfOtherAccount(t *testing.T) {
+	totp := newTestTOTPLogic(t)
+
+	secret := make([]byte, totpSecretSizeInBytes)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatal(err)
+	}
+
+	encryptedSecret, err := totp.encryptSecret(1, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The account ID is authenticated along the secret, a secret moved to another account can not be read.
+	_, err = totp.verifyTOTPCode(database.AccountTOTP{
+		OfAccountID:     2,
+		EncryptedSecret: encryptedSecret,
+	}, generateTOTPCode(secret, uint64(time.Now().Unix())/totpPeriodInSeconds))
+	if err == nil || errors.Is(err, ErrTOTPCodeInvalid) {
+		t.Errorf("expected a decryption error, got %v", err)
+	}
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	testCases := []struct {
+		name         string
+		recoveryCode string
+	}{
+		{name: "lower case", recoveryCode: "abcde-fghij"},
+		{name: "without dash", recoveryCode: "ABCDEFGHIJ"},
+		{name: "with spaces", recoveryCode: "ABCDE FGHIJ"},
+	}
+
+	expected := hashRecoveryCode("ABCDE-FGHIJ")
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if hashed := hashRecoveryCode(testCase.recoveryCode); hashed != expected {
+				t.Errorf("expected %s, got %s", expected, hashed)
+			}
+		})
+	}
+}
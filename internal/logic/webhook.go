@@ -0,0 +1,733 @@
+package logic
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gammazero/workerpool"
+	"github.com/maxuanquang/idm/internal/configs"
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	webhookEncryptionKeySizeInBytes = 32
+	webhookSecretSizeInBytes        = 32
+	// webhookSecretPrefix tells webhook secrets apart from API keys.
+	webhookSecretPrefix           = "whsec_"
+	webhookDeliveryErrorMaxLength = 1024
+
+	WebhookHeaderEvent      = "X-IDM-Event"
+	WebhookHeaderDeliveryID = "X-IDM-Delivery-ID"
+	WebhookHeaderTimestamp  = "X-IDM-Timestamp"
+	WebhookHeaderSignature  = "X-IDM-Signature"
+)
+
+var (
+	errWebhookDeliveryNotDue    = errors.New("webhook delivery is not due")
+	errWebhookAddressNotAllowed = errors.New("webhook address is not allowed")
+
+	// nonPublicWebhookPrefixList lists the ranges of global unicast addresses that are not reachable on the
+	// internet, on top of the private ones.
+	nonPublicWebhookPrefixList = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/8"),
+		netip.MustParsePrefix("100.64.0.0/10"),
+		netip.MustParsePrefix("192.0.0.0/24"),
+		netip.MustParsePrefix("198.18.0.0/15"),
+		netip.MustParsePrefix("240.0.0.0/4"),
+		netip.MustParsePrefix("64:ff9b::/96"),
+		netip.MustParsePrefix("64:ff9b:1::/48"),
+	}
+)
+
+type CreateWebhookInput struct {
+	Token string
+	URL   string
+	// EventTypeList is empty for webhooks called on every event.
+	EventTypeList []idm.WebhookEventType
+}
+
+type CreateWebhookOutput struct {
+	Webhook *idm.Webhook
+	// Secret is only known when the webhook is created.
+	Secret string
+}
+
+type GetWebhookListInput struct {
+	Token string
+}
+
+type GetWebhookListOutput struct {
+	WebhookList []*idm.Webhook
+}
+
+type DeleteWebhookInput struct {
+	Token     string
+	WebhookID uint64
+}
+
+type GetWebhookDeliveryListInput struct {
+	Token     string
+	WebhookID uint64
+	Offset    uint64
+	Limit     uint64
+}
+
+type GetWebhookDeliveryListOutput struct {
+	WebhookDeliveryList       []*idm.WebhookDelivery
+	TotalWebhookDeliveryCount uint64
+}
+
+// webhookPayload is the body of the requests of webhooks.
+type webhookPayload struct {
+	EventType    string          `json:"event_type"`
+	EventTime    time.Time       `json:"event_time"`
+	DownloadTask json.RawMessage `json:"download_task"`
+}
+
+// WebhookLogic manages the webhooks of accounts and delivers the events queued for them.
+type WebhookLogic interface {
+	CreateWebhook(ctx context.Context, in CreateWebhookInput) (CreateWebhookOutput, error)
+	GetWebhookList(ctx context.Context, in GetWebhookListInput) (GetWebhookListOutput, error)
+	DeleteWebhook(ctx context.Context, in DeleteWebhookInput) error
+	GetWebhookDeliveryList(ctx context.Context, in GetWebhookDeliveryListInput) (GetWebhookDeliveryListOutput, error)
+	// DeliverAllPendingWebhookDelivery attempts the pending deliveries whose next attempt is due.
+	DeliverAllPendingWebhookDelivery(ctx context.Context) error
+}
+
+func NewWebhookLogic(
+	tokenLogic TokenLogic,
+	webhookDataAccessor database.WebhookDataAccessor,
+	webhookDeliveryDataAccessor database.WebhookDeliveryDataAccessor,
+	database database.Database,
+	logger *zap.Logger,
+	webhookConfig configs.Webhook,
+	cronConfig configs.Cron,
+) (WebhookLogic, error) {
+	if webhookConfig.EncryptionKey == "" {
+		return nil, fmt.Errorf("webhook encryption key is not set, set webhook.encryption_key or %s", configs.EnvWebhookEncryptionKey)
+	}
+
+	encryptionKey, err := base64.StdEncoding.DecodeString(webhookConfig.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("webhook encryption key is not valid base64: %w", err)
+	}
+
+	if len(encryptionKey) != webhookEncryptionKeySizeInBytes {
+		return nil, fmt.Errorf("webhook encryption key must be %d bytes long, got %d", webhookEncryptionKeySizeInBytes, len(encryptionKey))
+	}
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookLogic{
+		tokenLogic:                  tokenLogic,
+		webhookDataAccessor:         webhookDataAccessor,
+		webhookDeliveryDataAccessor: webhookDeliveryDataAccessor,
+		database:                    database,
+		logger:                      logger,
+		webhookConfig:               webhookConfig,
+		cronConfig:                  cronConfig,
+		encryptionAEAD:              aead,
+		httpClient:                  newWebhookHTTPClient(webhookConfig),
+	}, nil
+}
+
+// newWebhookHTTPClient returns a client connecting only to public addresses, unless private networks are
+// allowed. The address is checked when connecting, after the host is resolved, so that a host resolving to a
+// public address when the webhook is created can not be pointed at a private one later on.
+func newWebhookHTTPClient(webhookConfig configs.Webhook) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookConfig.GetRequestTimeout(),
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			if webhookConfig.AllowPrivateNetwork {
+				return nil
+			}
+
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+
+			if !isPublicWebhookAddress(addrPort.Addr()) {
+				return errWebhookAddressNotAllowed
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Requests are not sent through the proxy of the environment, it would connect to the receiver in place of
+	// the dialer.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   webhookConfig.GetRequestTimeout(),
+		// Redirects are not followed, the receiver has to answer at the URL of the webhook.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+type webhookLogic struct {
+	tokenLogic                  TokenLogic
+	webhookDataAccessor         database.WebhookDataAccessor
+	webhookDeliveryDataAccessor database.WebhookDeliveryDataAccessor
+	database                    database.Database
+	logger                      *zap.Logger
+	webhookConfig               configs.Webhook
+	cronConfig                  configs.Cron
+	encryptionAEAD              cipher.AEAD
+	httpClient                  *http.Client
+}
+
+// CreateWebhook implements WebhookLogic.
+func (w *webhookLogic) CreateWebhook(ctx context.Context, in CreateWebhookInput) (CreateWebhookOutput, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.String("url", in.URL))
+
+	accountID, err := getAccountIDWithScope(ctx, w.tokenLogic, w.logger, in.Token, APIKeyScopeTasksWrite)
+	if err != nil {
+		return CreateWebhookOutput{}, err
+	}
+
+	if err := w.validateWebhookURL(ctx, in.URL); err != nil {
+		logger.With(zap.Error(err)).Info("webhook url is not allowed")
+		return CreateWebhookOutput{}, status.Error(codes.InvalidArgument, "webhook url must be a http or https url of a public address")
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate webhook secret")
+		return CreateWebhookOutput{}, status.Error(codes.Internal, "failed to create webhook")
+	}
+
+	encryptedSecret, err := w.encryptSecret(accountID, []byte(secret))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to encrypt webhook secret")
+		return CreateWebhookOutput{}, status.Error(codes.Internal, "failed to create webhook")
+	}
+
+	eventTypeList := slices.Clone(in.EventTypeList)
+	slices.Sort(eventTypeList)
+	eventTypeList = slices.Compact(eventTypeList)
+	jsonEventTypeList, err := json.Marshal(append([]idm.WebhookEventType{}, eventTypeList...))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal webhook event type list")
+		return CreateWebhookOutput{}, status.Error(codes.Internal, "failed to create webhook")
+	}
+
+	createdWebhook, err := w.webhookDataAccessor.CreateWebhook(ctx, database.Webhook{
+		OfAccountID:     accountID,
+		URL:             in.URL,
+		EncryptedSecret: encryptedSecret,
+		EventTypeList:   string(jsonEventTypeList),
+	})
+	if err != nil {
+		return CreateWebhookOutput{}, status.Error(codes.Internal, "failed to create webhook")
+	}
+
+	return CreateWebhookOutput{
+		Webhook: databaseWebhookToProtoWebhook(createdWebhook),
+		Secret:  secret,
+	}, nil
+}
+
+// GetWebhookList implements WebhookLogic.
+func (w *webhookLogic) GetWebhookList(ctx context.Context, in GetWebhookListInput) (GetWebhookListOutput, error) {
+	accountID, err := getAccountIDWithScope(ctx, w.tokenLogic, w.logger, in.Token, APIKeyScopeTasksRead)
+	if err != nil {
+		return GetWebhookListOutput{}, err
+	}
+
+	webhooks, err := w.webhookDataAccessor.GetWebhookListOfAccount(ctx, accountID)
+	if err != nil {
+		return GetWebhookListOutput{}, status.Error(codes.Internal, "failed to get webhook list")
+	}
+
+	webhookList := make([]*idm.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		webhookList = append(webhookList, databaseWebhookToProtoWebhook(webhook))
+	}
+
+	return GetWebhookListOutput{WebhookList: webhookList}, nil
+}
+
+// DeleteWebhook implements WebhookLogic.
+func (w *webhookLogic) DeleteWebhook(ctx context.Context, in DeleteWebhookInput) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("webhook_id", in.WebhookID))
+
+	webhook, err := w.getOwnedWebhook(ctx, in.Token, APIKeyScopeTasksWrite, in.WebhookID)
+	if err != nil {
+		return err
+	}
+
+	if err = w.webhookDataAccessor.DeleteWebhook(ctx, webhook.WebhookID); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete webhook")
+		return status.Error(codes.Internal, "failed to delete webhook")
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveryList implements WebhookLogic.
+func (w *webhookLogic) GetWebhookDeliveryList(ctx context.Context, in GetWebhookDeliveryListInput) (GetWebhookDeliveryListOutput, error) {
+	webhook, err := w.getOwnedWebhook(ctx, in.Token, APIKeyScopeTasksRead, in.WebhookID)
+	if err != nil {
+		return GetWebhookDeliveryListOutput{}, err
+	}
+
+	webhookDeliveries, err := w.webhookDeliveryDataAccessor.GetWebhookDeliveryListOfWebhook(ctx, webhook.WebhookID, in.Offset, in.Limit)
+	if err != nil {
+		return GetWebhookDeliveryListOutput{}, status.Error(codes.Internal, "failed to get webhook delivery list")
+	}
+
+	totalWebhookDeliveryCount, err := w.webhookDeliveryDataAccessor.GetWebhookDeliveryCountOfWebhook(ctx, webhook.WebhookID)
+	if err != nil {
+		return GetWebhookDeliveryListOutput{}, status.Error(codes.Internal, "failed to get webhook delivery count")
+	}
+
+	webhookDeliveryList := make([]*idm.WebhookDelivery, 0, len(webhookDeliveries))
+	for _, webhookDelivery := range webhookDeliveries {
+		webhookDeliveryList = append(webhookDeliveryList, databaseWebhookDeliveryToProtoWebhookDelivery(webhookDelivery))
+	}
+
+	return GetWebhookDeliveryListOutput{
+		WebhookDeliveryList:       webhookDeliveryList,
+		TotalWebhookDeliveryCount: totalWebhookDeliveryCount,
+	}, nil
+}
+
+// DeliverAllPendingWebhookDelivery implements WebhookLogic.
+func (w *webhookLogic) DeliverAllPendingWebhookDelivery(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, w.logger)
+
+	webhookDeliveryIDList, err := w.webhookDeliveryDataAccessor.GetDueWebhookDeliveryIDList(
+		ctx,
+		uint16(idm.WebhookDeliveryStatus_WebhookDeliveryPending),
+		time.Now(),
+		w.cronConfig.DeliverAllPendingWebhookDelivery.BatchSize,
+	)
+	if err != nil {
+		return err
+	}
+	if len(webhookDeliveryIDList) == 0 {
+		return nil
+	}
+
+	logger.
+		With(zap.Int("len(webhook_delivery_id_list)", len(webhookDeliveryIDList))).
+		Info("due webhook delivery found")
+
+	workerPool := workerpool.New(w.cronConfig.DeliverAllPendingWebhookDelivery.ConcurrencyLimit)
+	for _, id := range webhookDeliveryIDList {
+		workerPool.Submit(func() {
+			if deliverErr := w.deliverWebhookDelivery(ctx, id); deliverErr != nil {
+				logger.
+					With(zap.Uint64("webhook_delivery_id", id)).
+					With(zap.Error(deliverErr)).
+					Error("failed to deliver webhook delivery")
+			}
+		})
+	}
+
+	workerPool.StopWait()
+	return nil
+}
+
+// deliverWebhookDelivery makes one attempt of a delivery. The attempt is claimed first by moving the next
+// attempt time to when it would be retried, so that the delivery is not attempted again meanwhile and is
+// retried as usual if the server stops before the result is saved.
+func (w *webhookLogic) deliverWebhookDelivery(ctx context.Context, webhookDeliveryID uint64) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("webhook_delivery_id", webhookDeliveryID))
+
+	var (
+		webhook         database.Webhook
+		webhookDelivery database.WebhookDelivery
+		attemptTime     = time.Now()
+	)
+	txErr := w.database.Transaction(func(tx *gorm.DB) error {
+		var err error
+
+		webhookDelivery, err = w.webhookDeliveryDataAccessor.WithDatabaseTransaction(tx).GetWebhookDeliveryForUpdate(ctx, webhookDeliveryID)
+		if err != nil {
+			return err
+		}
+
+		if webhookDelivery.DeliveryStatus != uint16(idm.WebhookDeliveryStatus_WebhookDeliveryPending) ||
+			webhookDelivery.NextAttemptTime == nil ||
+			webhookDelivery.NextAttemptTime.After(attemptTime) {
+			return errWebhookDeliveryNotDue
+		}
+
+		webhook, err = w.webhookDataAccessor.WithDatabaseTransaction(tx).GetWebhook(ctx, webhookDelivery.OfWebhookID)
+		if err != nil {
+			return err
+		}
+
+		nextAttemptTime := attemptTime.Add(w.getRetryDelay(webhookDelivery.AttemptCount + 1))
+		webhookDelivery.AttemptCount++
+		webhookDelivery.LastAttemptTime = &attemptTime
+		webhookDelivery.NextAttemptTime = &nextAttemptTime
+		return w.webhookDeliveryDataAccessor.WithDatabaseTransaction(tx).UpdateWebhookDeliveryAttempt(ctx, webhookDelivery)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, errWebhookDeliveryNotDue) {
+			return nil
+		}
+
+		return txErr
+	}
+
+	responseStatusCode, deliverErr := w.sendWebhookRequest(ctx, webhook, webhookDelivery)
+	webhookDelivery.ResponseStatusCode = uint32(responseStatusCode)
+	switch {
+	case deliverErr == nil:
+		webhookDelivery.DeliveryStatus = uint16(idm.WebhookDeliveryStatus_WebhookDeliverySucceeded)
+		webhookDelivery.Error = ""
+		webhookDelivery.NextAttemptTime = nil
+	case webhookDelivery.AttemptCount >= w.webhookConfig.MaxAttemptCount:
+		webhookDelivery.DeliveryStatus = uint16(idm.WebhookDeliveryStatus_WebhookDeliveryFailed)
+		webhookDelivery.Error = truncateWebhookDeliveryError(deliverErr.Error())
+		webhookDelivery.NextAttemptTime = nil
+	default:
+		webhookDelivery.Error = truncateWebhookDeliveryError(deliverErr.Error())
+	}
+
+	if err := w.webhookDeliveryDataAccessor.UpdateWebhookDeliveryAttempt(ctx, webhookDelivery); err != nil {
+		logger.With(zap.Error(err)).Error("failed to save webhook delivery attempt")
+		return err
+	}
+
+	if deliverErr != nil {
+		logger.
+			With(zap.Uint32("attempt_count", webhookDelivery.AttemptCount)).
+			With(zap.Error(deliverErr)).
+			Warn("webhook delivery attempt failed")
+	}
+
+	return nil
+}
+
+// sendWebhookRequest returns the status code of the response, if any, and an error unless the receiver
+// answered with a 2xx status code.
+func (w *webhookLogic) sendWebhookRequest(
+	ctx context.Context,
+	webhook database.Webhook,
+	webhookDelivery database.WebhookDelivery,
+) (int, error) {
+	secret, err := w.decryptSecret(webhook.OfAccountID, webhook.EncryptedSecret)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decrypt webhook secret: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, strings.NewReader(webhookDelivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookHeaderEvent, idm.WebhookEventType(webhookDelivery.EventType).String())
+	request.Header.Set(WebhookHeaderDeliveryID, strconv.FormatUint(webhookDelivery.WebhookDeliveryID, 10))
+	request.Header.Set(WebhookHeaderTimestamp, timestamp)
+	request.Header.Set(WebhookHeaderSignature, signWebhookPayload(secret, timestamp, []byte(webhookDelivery.Payload)))
+
+	response, err := w.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	// The body of the response is not read, so that the receiver can not use webhooks to show the content of
+	// other addresses to the account.
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("receiver answered with status %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
+
+// validateWebhookURL checks that the URL is a http or https URL whose host only resolves to public addresses,
+// unless private networks are allowed.
+func (w *webhookLogic) validateWebhookURL(ctx context.Context, rawURL string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", parsedURL.Scheme)
+	}
+
+	if parsedURL.User != nil {
+		return errors.New("url must not have user info")
+	}
+
+	host := parsedURL.Hostname()
+	if host == "" {
+		return errors.New("url has no host")
+	}
+
+	if w.webhookConfig.AllowPrivateNetwork {
+		return nil
+	}
+
+	addrList, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrList {
+		if !isPublicWebhookAddress(addr) {
+			return errWebhookAddressNotAllowed
+		}
+	}
+
+	return nil
+}
+
+// getRetryDelay doubles the delay after each failed attempt, up to the max delay.
+func (w *webhookLogic) getRetryDelay(attemptCount uint32) time.Duration {
+	maxDelay := w.webhookConfig.GetMaxRetryDelay()
+	delay := w.webhookConfig.GetBaseRetryDelay()
+	for i := uint32(1); i < attemptCount && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
+
+// getOwnedWebhook returns the webhook if it belongs to the account of the token.
+func (w *webhookLogic) getOwnedWebhook(ctx context.Context, token string, scope string, webhookID uint64) (database.Webhook, error) {
+	accountID, err := getAccountIDWithScope(ctx, w.tokenLogic, w.logger, token, scope)
+	if err != nil {
+		return database.Webhook{}, err
+	}
+
+	webhook, err := w.webhookDataAccessor.GetWebhook(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, database.ErrWebhookNotFound) {
+			return database.Webhook{}, status.Error(codes.NotFound, "webhook not found")
+		}
+
+		return database.Webhook{}, status.Error(codes.Internal, "failed to get webhook")
+	}
+
+	if webhook.OfAccountID != accountID {
+		return database.Webhook{}, status.Error(codes.NotFound, "webhook not found")
+	}
+
+	return webhook, nil
+}
+
+// encryptSecret returns the nonce followed by the sealed secret, the account ID is authenticated so that
+// a secret can not be moved to another account.
+func (w *webhookLogic) encryptSecret(accountID uint64, secret []byte) ([]byte, error) {
+	nonce := make([]byte, w.encryptionAEAD.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return w.encryptionAEAD.Seal(nonce, nonce, secret, binary.BigEndian.AppendUint64(nil, accountID)), nil
+}
+
+func (w *webhookLogic) decryptSecret(accountID uint64, encryptedSecret []byte) ([]byte, error) {
+	nonceSize := w.encryptionAEAD.NonceSize()
+	if len(encryptedSecret) < nonceSize {
+		return nil, errors.New("encrypted webhook secret is too short")
+	}
+
+	nonce, sealedSecret := encryptedSecret[:nonceSize], encryptedSecret[nonceSize:]
+	return w.encryptionAEAD.Open(nil, nonce, sealedSecret, binary.BigEndian.AppendUint64(nil, accountID))
+}
+
+// getSubscribedWebhookList returns the webhooks of the account called on the event.
+func getSubscribedWebhookList(
+	ctx context.Context,
+	webhookDataAccessor database.WebhookDataAccessor,
+	accountID uint64,
+	eventType idm.WebhookEventType,
+) ([]database.Webhook, error) {
+	webhooks, err := webhookDataAccessor.GetWebhookListOfAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(webhooks, func(webhook database.Webhook) bool {
+		eventTypeList := parseWebhookEventTypeList(webhook.EventTypeList)
+		return len(eventTypeList) > 0 && !slices.Contains(eventTypeList, eventType)
+	}), nil
+}
+
+// createWebhookDeliveryList queues a delivery of the event to each webhook with a snapshot of the download
+// task. The data accessor has to use the transaction changing the download task, so that the event is only
+// delivered if the change is saved.
+func createWebhookDeliveryList(
+	ctx context.Context,
+	webhookDeliveryDataAccessor database.WebhookDeliveryDataAccessor,
+	webhooks []database.Webhook,
+	downloadTask *idm.DownloadTask,
+	eventType idm.WebhookEventType,
+) error {
+	jsonDownloadTask, err := protojson.Marshal(downloadTask)
+	if err != nil {
+		return err
+	}
+
+	eventTime := time.Now()
+	payload, err := json.Marshal(webhookPayload{
+		EventType:    eventType.String(),
+		EventTime:    eventTime,
+		DownloadTask: jsonDownloadTask,
+	})
+	if err != nil {
+		return err
+	}
+
+	webhookDeliveryList := make([]database.WebhookDelivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		webhookDeliveryList = append(webhookDeliveryList, database.WebhookDelivery{
+			OfWebhookID:      webhook.WebhookID,
+			OfDownloadTaskID: downloadTask.GetId(),
+			EventType:        uint16(eventType),
+			Payload:          string(payload),
+			DeliveryStatus:   uint16(idm.WebhookDeliveryStatus_WebhookDeliveryPending),
+			NextAttemptTime:  &eventTime,
+		})
+	}
+
+	return webhookDeliveryDataAccessor.CreateWebhookDeliveryList(ctx, webhookDeliveryList)
+}
+
+// getWebhookEventType returns the event webhooks are called on for the download task lifecycle event, if any.
+func getWebhookEventType(lifecycleEventType idm.DownloadTaskLifecycleEventType) (idm.WebhookEventType, bool) {
+	switch lifecycleEventType {
+	case idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventCreated:
+		return idm.WebhookEventType_WebhookEventDownloadTaskCreated, true
+	case idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventStarted:
+		return idm.WebhookEventType_WebhookEventDownloadTaskStarted, true
+	case idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventSucceeded:
+		return idm.WebhookEventType_WebhookEventDownloadTaskSucceeded, true
+	case idm.DownloadTaskLifecycleEventType_DownloadTaskLifecycleEventFailed:
+		return idm.WebhookEventType_WebhookEventDownloadTaskFailed, true
+	default:
+		return idm.WebhookEventType_UndefinedWebhookEventType, false
+	}
+}
+
+// isPublicWebhookAddress returns false for the loopback, private, link local, multicast and unspecified
+// addresses, and the other ranges not reachable on the internet.
+func isPublicWebhookAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range nonPublicWebhookPrefixList {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// signWebhookPayload returns the X-IDM-Signature header, receivers compute the same HMAC to check that the
+// request comes from this server and the timestamp to reject replayed requests.
+func signWebhookPayload(secret []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretSizeInBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return webhookSecretPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// parseWebhookEventTypeList returns nothing for lists that can not be parsed, so that the webhook is called on
+// every event rather than on none.
+func parseWebhookEventTypeList(eventTypeList string) []idm.WebhookEventType {
+	var parsedEventTypeList []idm.WebhookEventType
+	if err := json.Unmarshal([]byte(eventTypeList), &parsedEventTypeList); err != nil {
+		return nil
+	}
+
+	return parsedEventTypeList
+}
+
+func truncateWebhookDeliveryError(deliveryError string) string {
+	if len(deliveryError) > webhookDeliveryErrorMaxLength {
+		return strings.ToValidUTF8(deliveryError[:webhookDeliveryErrorMaxLength], "")
+	}
+
+	return deliveryError
+}
+
+func databaseWebhookToProtoWebhook(webhook database.Webhook) *idm.Webhook {
+	return &idm.Webhook{
+		Id:            webhook.WebhookID,
+		Url:           webhook.URL,
+		EventTypeList: parseWebhookEventTypeList(webhook.EventTypeList),
+		CreateTime:    timestamppb.New(webhook.CreateTime),
+	}
+}
+
+func databaseWebhookDeliveryToProtoWebhookDelivery(webhookDelivery database.WebhookDelivery) *idm.WebhookDelivery {
+	return &idm.WebhookDelivery{
+		Id:                 webhookDelivery.WebhookDeliveryID,
+		WebhookId:          webhookDelivery.OfWebhookID,
+		DownloadTaskId:     webhookDelivery.OfDownloadTaskID,
+		EventType:          idm.WebhookEventType(webhookDelivery.EventType),
+		Status:             idm.WebhookDeliveryStatus(webhookDelivery.DeliveryStatus),
+		AttemptCount:       webhookDelivery.AttemptCount,
+		ResponseStatusCode: webhookDelivery.ResponseStatusCode,
+		Error:              webhookDelivery.Error,
+		CreateTime:         timestamppb.New(webhookDelivery.CreateTime),
+		LastAttemptTime:    timeToTimestamp(webhookDelivery.LastAttemptTime),
+		NextAttemptTime:    timeToTimestamp(webhookDelivery.NextAttemptTime),
+	}
+}
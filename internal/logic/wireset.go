@@ -4,8 +4,21 @@ import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
 	NewAccountLogic,
+	NewAdminLogic,
 	NewHashLogic,
+	NewPasswordLogic,
+	NewTOTPLogic,
+	NewLoginThrottleLogic,
 	NewTokenLogic,
+	NewTokenSigningKeyLogic,
 	NewDownloadTaskLogic,
+	NewShareLinkLogic,
+	NewOIDCLogic,
+	NewAPIKeyLogic,
+	NewWorkspaceLogic,
+	NewTagLogic,
+	NewCategoryLogic,
+	NewWebhookLogic,
+	NewOutboxMessageLogic,
 	NewHTTPDownloader,
 )
@@ -0,0 +1,528 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/idm/internal/dataaccess/database"
+	"github.com/maxuanquang/idm/internal/generated/grpc/idm"
+	"github.com/maxuanquang/idm/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var (
+	errLastWorkspaceManager = errors.New("workspace must keep a manager")
+)
+
+type CreateWorkspaceInput struct {
+	Token string
+	Name  string
+}
+
+type CreateWorkspaceOutput struct {
+	Workspace *idm.Workspace
+}
+
+type GetWorkspaceListInput struct {
+	Token string
+}
+
+type GetWorkspaceListOutput struct {
+	WorkspaceList []*idm.Workspace
+}
+
+type GetWorkspaceMemberListInput struct {
+	Token       string
+	WorkspaceID uint64
+}
+
+type GetWorkspaceMemberListOutput struct {
+	WorkspaceMemberList []*idm.WorkspaceMember
+}
+
+type AddWorkspaceMemberInput struct {
+	Token       string
+	WorkspaceID uint64
+	AccountName string
+	Role        idm.WorkspaceRole
+}
+
+type UpdateWorkspaceMemberInput struct {
+	Token       string
+	WorkspaceID uint64
+	AccountID   uint64
+	Role        idm.WorkspaceRole
+}
+
+type WorkspaceMemberOutput struct {
+	WorkspaceMember *idm.WorkspaceMember
+}
+
+type RemoveWorkspaceMemberInput struct {
+	Token       string
+	WorkspaceID uint64
+	AccountID   uint64
+}
+
+type CheckWorkspaceRoleInput struct {
+	AccountID uint64
+	// WorkspaceID is 0 for the personal workspace of the account.
+	WorkspaceID uint64
+	Role        idm.WorkspaceRole
+}
+
+// WorkspaceLogic manages the workspaces sharing download tasks between accounts.
+type WorkspaceLogic interface {
+	CreateWorkspace(ctx context.Context, in CreateWorkspaceInput) (CreateWorkspaceOutput, error)
+	GetWorkspaceList(ctx context.Context, in GetWorkspaceListInput) (GetWorkspaceListOutput, error)
+	GetWorkspaceMemberList(ctx context.Context, in GetWorkspaceMemberListInput) (GetWorkspaceMemberListOutput, error)
+	AddWorkspaceMember(ctx context.Context, in AddWorkspaceMemberInput) (WorkspaceMemberOutput, error)
+	UpdateWorkspaceMember(ctx context.Context, in UpdateWorkspaceMemberInput) (WorkspaceMemberOutput, error)
+	RemoveWorkspaceMember(ctx context.Context, in RemoveWorkspaceMemberInput) error
+
+	// CreatePersonalWorkspace creates the personal workspace of a new account.
+	CreatePersonalWorkspace(ctx context.Context, account database.Account) error
+	// CheckWorkspaceRole returns the ID of the workspace if the account has at least the role in it.
+	CheckWorkspaceRole(ctx context.Context, in CheckWorkspaceRoleInput) (uint64, error)
+	WithDatabaseTransaction(database database.Database) WorkspaceLogic
+}
+
+func NewWorkspaceLogic(
+	database database.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	workspaceDataAccessor database.WorkspaceDataAccessor,
+	workspaceMemberDataAccessor database.WorkspaceMemberDataAccessor,
+	tokenLogic TokenLogic,
+	logger *zap.Logger,
+) WorkspaceLogic {
+	return &workspaceLogic{
+		database:                    database,
+		accountDataAccessor:         accountDataAccessor,
+		workspaceDataAccessor:       workspaceDataAccessor,
+		workspaceMemberDataAccessor: workspaceMemberDataAccessor,
+		tokenLogic:                  tokenLogic,
+		logger:                      logger,
+	}
+}
+
+type workspaceLogic struct {
+	database                    database.Database
+	accountDataAccessor         database.AccountDataAccessor
+	workspaceDataAccessor       database.WorkspaceDataAccessor
+	workspaceMemberDataAccessor database.WorkspaceMemberDataAccessor
+	tokenLogic                  TokenLogic
+	logger                      *zap.Logger
+}
+
+// CreateWorkspace implements WorkspaceLogic.
+func (w *workspaceLogic) CreateWorkspace(ctx context.Context, in CreateWorkspaceInput) (CreateWorkspaceOutput, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.String("name", in.Name))
+
+	accountID, _, err := w.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
+		return CreateWorkspaceOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	var createdWorkspace database.Workspace
+	txErr := w.database.Transaction(func(tx *gorm.DB) error {
+		createdWorkspace, err = w.createWorkspace(ctx, tx, database.Workspace{Name: in.Name}, accountID)
+		return err
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Error("create workspace transaction failed")
+		return CreateWorkspaceOutput{}, status.Error(codes.Internal, "failed to create workspace")
+	}
+
+	return CreateWorkspaceOutput{
+		Workspace: databaseWorkspaceToProtoWorkspace(createdWorkspace, idm.WorkspaceRole_Manager),
+	}, nil
+}
+
+// GetWorkspaceList implements WorkspaceLogic.
+func (w *workspaceLogic) GetWorkspaceList(ctx context.Context, in GetWorkspaceListInput) (GetWorkspaceListOutput, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger)
+
+	accountID, _, err := w.tokenLogic.GetAccountIDAndExpireTime(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account id and expire time from token")
+		return GetWorkspaceListOutput{}, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	workspaceMembers, err := w.workspaceMemberDataAccessor.GetWorkspaceMemberListOfAccount(ctx, accountID)
+	if err != nil {
+		return GetWorkspaceListOutput{}, status.Error(codes.Internal, "failed to get workspace list")
+	}
+
+	roles := make(map[uint64]idm.WorkspaceRole, len(workspaceMembers))
+	workspaceIDList := make([]uint64, 0, len(workspaceMembers))
+	for _, workspaceMember := range workspaceMembers {
+		roles[workspaceMember.OfWorkspaceID] = idm.WorkspaceRole(workspaceMember.Role)
+		workspaceIDList = append(workspaceIDList, workspaceMember.OfWorkspaceID)
+	}
+
+	workspaces, err := w.workspaceDataAccessor.GetWorkspaceList(ctx, workspaceIDList)
+	if err != nil {
+		return GetWorkspaceListOutput{}, status.Error(codes.Internal, "failed to get workspace list")
+	}
+
+	workspaceList := make([]*idm.Workspace, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		workspaceList = append(workspaceList, databaseWorkspaceToProtoWorkspace(workspace, roles[workspace.WorkspaceID]))
+	}
+
+	return GetWorkspaceListOutput{
+		WorkspaceList: workspaceList,
+	}, nil
+}
+
+// GetWorkspaceMemberList implements WorkspaceLogic.
+func (w *workspaceLogic) GetWorkspaceMemberList(ctx context.Context, in GetWorkspaceMemberListInput) (GetWorkspaceMemberListOutput, error) {
+	accountID, err := w.getAccountID(ctx, in.Token)
+	if err != nil {
+		return GetWorkspaceMemberListOutput{}, err
+	}
+
+	if _, err = w.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: in.WorkspaceID,
+		Role:        idm.WorkspaceRole_Viewer,
+	}); err != nil {
+		return GetWorkspaceMemberListOutput{}, err
+	}
+
+	workspaceMembers, err := w.workspaceMemberDataAccessor.GetWorkspaceMemberListOfWorkspace(ctx, in.WorkspaceID)
+	if err != nil {
+		return GetWorkspaceMemberListOutput{}, status.Error(codes.Internal, "failed to get workspace member list")
+	}
+
+	workspaceMemberList := make([]*idm.WorkspaceMember, 0, len(workspaceMembers))
+	for _, workspaceMember := range workspaceMembers {
+		account, err := w.accountDataAccessor.GetAccountByID(ctx, workspaceMember.OfAccountID)
+		if err != nil {
+			return GetWorkspaceMemberListOutput{}, status.Error(codes.Internal, "failed to get account")
+		}
+
+		workspaceMemberList = append(workspaceMemberList, databaseWorkspaceMemberToProtoWorkspaceMember(workspaceMember, account))
+	}
+
+	return GetWorkspaceMemberListOutput{
+		WorkspaceMemberList: workspaceMemberList,
+	}, nil
+}
+
+// AddWorkspaceMember implements WorkspaceLogic.
+func (w *workspaceLogic) AddWorkspaceMember(ctx context.Context, in AddWorkspaceMemberInput) (WorkspaceMemberOutput, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).
+		With(zap.Uint64("workspace_id", in.WorkspaceID)).
+		With(zap.String("account_name", in.AccountName))
+
+	accountID, err := w.getAccountID(ctx, in.Token)
+	if err != nil {
+		return WorkspaceMemberOutput{}, err
+	}
+
+	workspace, err := w.getManagedWorkspace(ctx, accountID, in.WorkspaceID)
+	if err != nil {
+		return WorkspaceMemberOutput{}, err
+	}
+
+	if workspace.PersonalOfAccountID != nil {
+		return WorkspaceMemberOutput{}, status.Error(codes.FailedPrecondition, "personal workspaces can not have other members")
+	}
+
+	account, err := w.accountDataAccessor.GetAccountByName(ctx, in.AccountName)
+	if err != nil {
+		return WorkspaceMemberOutput{}, status.Error(codes.Internal, "failed to get account")
+	}
+	if account.AccountID == 0 {
+		return WorkspaceMemberOutput{}, status.Error(codes.NotFound, "account not found")
+	}
+
+	_, err = w.workspaceMemberDataAccessor.GetWorkspaceMember(ctx, in.WorkspaceID, account.AccountID)
+	if err == nil {
+		return WorkspaceMemberOutput{}, status.Error(codes.AlreadyExists, "account is already a member of the workspace")
+	}
+	if !errors.Is(err, database.ErrWorkspaceMemberNotFound) {
+		return WorkspaceMemberOutput{}, status.Error(codes.Internal, "failed to get workspace member")
+	}
+
+	workspaceMember := database.WorkspaceMember{
+		OfWorkspaceID: in.WorkspaceID,
+		OfAccountID:   account.AccountID,
+		Role:          uint16(in.Role),
+		CreateTime:    time.Now(),
+	}
+	if err = w.workspaceMemberDataAccessor.CreateWorkspaceMember(ctx, workspaceMember); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create workspace member")
+		return WorkspaceMemberOutput{}, status.Error(codes.Internal, "failed to add workspace member")
+	}
+
+	return WorkspaceMemberOutput{
+		WorkspaceMember: databaseWorkspaceMemberToProtoWorkspaceMember(workspaceMember, account),
+	}, nil
+}
+
+// UpdateWorkspaceMember implements WorkspaceLogic.
+func (w *workspaceLogic) UpdateWorkspaceMember(ctx context.Context, in UpdateWorkspaceMemberInput) (WorkspaceMemberOutput, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).
+		With(zap.Uint64("workspace_id", in.WorkspaceID)).
+		With(zap.Uint64("account_id", in.AccountID))
+
+	accountID, err := w.getAccountID(ctx, in.Token)
+	if err != nil {
+		return WorkspaceMemberOutput{}, err
+	}
+
+	if _, err = w.getManagedWorkspace(ctx, accountID, in.WorkspaceID); err != nil {
+		return WorkspaceMemberOutput{}, err
+	}
+
+	var workspaceMember database.WorkspaceMember
+	txErr := w.database.Transaction(func(tx *gorm.DB) error {
+		workspaceMember, err = w.lockWorkspaceMember(ctx, tx, in.WorkspaceID, in.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if in.Role != idm.WorkspaceRole_Manager {
+			if err = w.checkNotLastManager(ctx, tx, workspaceMember); err != nil {
+				return err
+			}
+		}
+
+		workspaceMember.Role = uint16(in.Role)
+		return w.workspaceMemberDataAccessor.WithDatabaseTransaction(tx).
+			UpdateWorkspaceMemberRole(ctx, in.WorkspaceID, in.AccountID, workspaceMember.Role)
+	})
+	if txErr != nil {
+		return WorkspaceMemberOutput{}, w.workspaceMemberTransactionError(logger, txErr)
+	}
+
+	account, err := w.accountDataAccessor.GetAccountByID(ctx, in.AccountID)
+	if err != nil {
+		return WorkspaceMemberOutput{}, status.Error(codes.Internal, "failed to get account")
+	}
+
+	return WorkspaceMemberOutput{
+		WorkspaceMember: databaseWorkspaceMemberToProtoWorkspaceMember(workspaceMember, account),
+	}, nil
+}
+
+// RemoveWorkspaceMember implements WorkspaceLogic.
+func (w *workspaceLogic) RemoveWorkspaceMember(ctx context.Context, in RemoveWorkspaceMemberInput) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).
+		With(zap.Uint64("workspace_id", in.WorkspaceID)).
+		With(zap.Uint64("account_id", in.AccountID))
+
+	accountID, err := w.getAccountID(ctx, in.Token)
+	if err != nil {
+		return err
+	}
+
+	// Any member can leave a workspace, only managers can remove others.
+	requiredRole := idm.WorkspaceRole_Manager
+	if in.AccountID == accountID {
+		requiredRole = idm.WorkspaceRole_Viewer
+	}
+
+	if _, err = w.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: in.WorkspaceID,
+		Role:        requiredRole,
+	}); err != nil {
+		return err
+	}
+
+	txErr := w.database.Transaction(func(tx *gorm.DB) error {
+		workspaceMember, err := w.lockWorkspaceMember(ctx, tx, in.WorkspaceID, in.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if err = w.checkNotLastManager(ctx, tx, workspaceMember); err != nil {
+			return err
+		}
+
+		return w.workspaceMemberDataAccessor.WithDatabaseTransaction(tx).DeleteWorkspaceMember(ctx, in.WorkspaceID, in.AccountID)
+	})
+	if txErr != nil {
+		return w.workspaceMemberTransactionError(logger, txErr)
+	}
+
+	return nil
+}
+
+// CreatePersonalWorkspace implements WorkspaceLogic.
+func (w *workspaceLogic) CreatePersonalWorkspace(ctx context.Context, account database.Account) error {
+	_, err := w.createWorkspace(ctx, w.database, database.Workspace{
+		Name:                account.AccountName,
+		PersonalOfAccountID: &account.AccountID,
+	}, account.AccountID)
+	return err
+}
+
+// CheckWorkspaceRole implements WorkspaceLogic.
+func (w *workspaceLogic) CheckWorkspaceRole(ctx context.Context, in CheckWorkspaceRoleInput) (uint64, error) {
+	workspaceID := in.WorkspaceID
+	if workspaceID == 0 {
+		workspace, err := w.workspaceDataAccessor.GetPersonalWorkspaceOfAccount(ctx, in.AccountID)
+		if err != nil {
+			if errors.Is(err, database.ErrWorkspaceNotFound) {
+				return 0, status.Error(codes.NotFound, "personal workspace not found")
+			}
+
+			return 0, status.Error(codes.Internal, "failed to get personal workspace")
+		}
+
+		workspaceID = workspace.WorkspaceID
+	}
+
+	workspaceMember, err := w.workspaceMemberDataAccessor.GetWorkspaceMember(ctx, workspaceID, in.AccountID)
+	if err != nil {
+		// Workspaces of others are reported as missing rather than forbidden so that their IDs are not disclosed.
+		if errors.Is(err, database.ErrWorkspaceMemberNotFound) {
+			return 0, status.Error(codes.NotFound, "workspace not found")
+		}
+
+		return 0, status.Error(codes.Internal, "failed to get workspace member")
+	}
+
+	if idm.WorkspaceRole(workspaceMember.Role) < in.Role {
+		return 0, ErrPermissionDenied
+	}
+
+	return workspaceID, nil
+}
+
+// WithDatabaseTransaction implements WorkspaceLogic.
+func (w *workspaceLogic) WithDatabaseTransaction(database database.Database) WorkspaceLogic {
+	return &workspaceLogic{
+		database:                    database,
+		accountDataAccessor:         w.accountDataAccessor.WithDatabaseTransaction(database),
+		workspaceDataAccessor:       w.workspaceDataAccessor.WithDatabaseTransaction(database),
+		workspaceMemberDataAccessor: w.workspaceMemberDataAccessor.WithDatabaseTransaction(database),
+		tokenLogic:                  w.tokenLogic,
+		logger:                      w.logger,
+	}
+}
+
+func (w *workspaceLogic) getAccountID(ctx context.Context, token string) (uint64, error) {
+	accountID, _, err := w.tokenLogic.GetAccountIDAndExpireTime(ctx, token)
+	if err != nil {
+		utils.LoggerWithContext(ctx, w.logger).With(zap.Error(err)).Error("failed to get account id and expire time from token")
+		return 0, status.Error(codes.Unauthenticated, "authentication token is invalid")
+	}
+
+	return accountID, nil
+}
+
+func (w *workspaceLogic) getManagedWorkspace(ctx context.Context, accountID, workspaceID uint64) (database.Workspace, error) {
+	if _, err := w.CheckWorkspaceRole(ctx, CheckWorkspaceRoleInput{
+		AccountID:   accountID,
+		WorkspaceID: workspaceID,
+		Role:        idm.WorkspaceRole_Manager,
+	}); err != nil {
+		return database.Workspace{}, err
+	}
+
+	workspace, err := w.workspaceDataAccessor.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		return database.Workspace{}, status.Error(codes.Internal, "failed to get workspace")
+	}
+
+	return workspace, nil
+}
+
+// createWorkspace creates a workspace managed by accountID.
+func (w *workspaceLogic) createWorkspace(
+	ctx context.Context,
+	tx database.Database,
+	workspace database.Workspace,
+	accountID uint64,
+) (database.Workspace, error) {
+	now := time.Now()
+	workspace.CreateTime = now
+	createdWorkspace, err := w.workspaceDataAccessor.WithDatabaseTransaction(tx).CreateWorkspace(ctx, workspace)
+	if err != nil {
+		return database.Workspace{}, err
+	}
+
+	err = w.workspaceMemberDataAccessor.WithDatabaseTransaction(tx).CreateWorkspaceMember(ctx, database.WorkspaceMember{
+		OfWorkspaceID: createdWorkspace.WorkspaceID,
+		OfAccountID:   accountID,
+		Role:          uint16(idm.WorkspaceRole_Manager),
+		CreateTime:    now,
+	})
+	if err != nil {
+		return database.Workspace{}, err
+	}
+
+	return createdWorkspace, nil
+}
+
+// lockWorkspaceMember locks the workspace so that concurrent changes to its members can not remove its last
+// manager, then returns the member.
+func (w *workspaceLogic) lockWorkspaceMember(ctx context.Context, tx *gorm.DB, workspaceID, accountID uint64) (database.WorkspaceMember, error) {
+	if _, err := w.workspaceDataAccessor.WithDatabaseTransaction(tx).GetWorkspaceForUpdate(ctx, workspaceID); err != nil {
+		return database.WorkspaceMember{}, err
+	}
+
+	return w.workspaceMemberDataAccessor.WithDatabaseTransaction(tx).GetWorkspaceMember(ctx, workspaceID, accountID)
+}
+
+func (w *workspaceLogic) checkNotLastManager(ctx context.Context, tx *gorm.DB, workspaceMember database.WorkspaceMember) error {
+	if workspaceMember.Role != uint16(idm.WorkspaceRole_Manager) {
+		return nil
+	}
+
+	managerCount, err := w.workspaceMemberDataAccessor.WithDatabaseTransaction(tx).
+		GetWorkspaceMemberCountWithRole(ctx, workspaceMember.OfWorkspaceID, uint16(idm.WorkspaceRole_Manager))
+	if err != nil {
+		return err
+	}
+
+	if managerCount <= 1 {
+		return errLastWorkspaceManager
+	}
+
+	return nil
+}
+
+func (w *workspaceLogic) workspaceMemberTransactionError(logger *zap.Logger, txErr error) error {
+	switch {
+	case errors.Is(txErr, database.ErrWorkspaceNotFound), errors.Is(txErr, database.ErrWorkspaceMemberNotFound):
+		return status.Error(codes.NotFound, "workspace member not found")
+	case errors.Is(txErr, errLastWorkspaceManager):
+		return status.Error(codes.FailedPrecondition, "workspace must keep at least one manager")
+	default:
+		logger.With(zap.Error(txErr)).Error("workspace member transaction failed")
+		return status.Error(codes.Internal, "failed to update workspace member")
+	}
+}
+
+func databaseWorkspaceToProtoWorkspace(workspace database.Workspace, role idm.WorkspaceRole) *idm.Workspace {
+	return &idm.Workspace{
+		Id:       workspace.WorkspaceID,
+		Name:     workspace.Name,
+		Personal: workspace.PersonalOfAccountID != nil,
+		Role:     role,
+	}
+}
+
+func databaseWorkspaceMemberToProtoWorkspaceMember(
+	workspaceMember database.WorkspaceMember,
+	account database.Account,
+) *idm.WorkspaceMember {
+	return &idm.WorkspaceMember{
+		Account: &idm.Account{
+			Id:          account.AccountID,
+			AccountName: account.AccountName,
+		},
+		Role: idm.WorkspaceRole(workspaceMember.Role),
+	}
+}
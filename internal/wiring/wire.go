@@ -27,4 +27,16 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath) (app.Stan
 	wire.Build(WireSet)
 
 	return app.StandaloneServer{}, nil, nil
-}
\ No newline at end of file
+}
+
+func InitializeDownloadTaskLogic(configFilePath configs.ConfigFilePath) (logic.DownloadTaskLogic, func(), error) {
+	wire.Build(WireSet)
+
+	return nil, nil, nil
+}
+
+func InitializeAdminLogic(configFilePath configs.ConfigFilePath) (logic.AdminLogic, func(), error) {
+	wire.Build(WireSet)
+
+	return nil, nil, nil
+}
@@ -46,14 +46,17 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath) (app.Stan
 	}
 	accountDataAccessor := database.NewAccountDataAccessor(databaseDatabase, logger)
 	accountPasswordDataAccessor := database.NewAccountPasswordDataAccessor(databaseDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(databaseDatabase, logger)
+	refreshTokenDataAccessor := database.NewRefreshTokenDataAccessor(databaseDatabase, logger)
 	hashLogic := logic.NewHashLogic()
+	passwordResetTokenDataAccessor := database.NewPasswordResetTokenDataAccessor(databaseDatabase, logger)
+	apiKeyDataAccessor := database.NewAPIKeyDataAccessor(databaseDatabase, logger)
 	tokenPublicKeyDataAccessor, err := database.NewTokenPublicKeyDataAccessor(databaseDatabase, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	auth := config.Auth
 	configsCache := config.Cache
 	client, err := cache.NewClient(configsCache, logger)
 	if err != nil {
@@ -67,58 +70,148 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath) (app.Stan
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	tokenLogic, err := logic.NewTokenLogic(accountDataAccessor, tokenPublicKeyDataAccessor, logger, auth, tokenPublicKey)
+	auth := config.Auth
+	tokenSigningKeyLogic, err := logic.NewTokenSigningKeyLogic(databaseDatabase, tokenPublicKeyDataAccessor, tokenPublicKey, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	sessionDenylist, err := cache.NewSessionDenylist(client)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	tokenLogic, err := logic.NewTokenLogic(accountDataAccessor, sessionDataAccessor, apiKeyDataAccessor, tokenSigningKeyLogic, logger, auth, sessionDenylist)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
+	passwordLogic, err := logic.NewPasswordLogic(databaseDatabase, accountDataAccessor, accountPasswordDataAccessor, passwordResetTokenDataAccessor, hashLogic, tokenLogic, logger, auth)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	accountTOTPDataAccessor := database.NewAccountTOTPDataAccessor(databaseDatabase, logger)
+	accountRecoveryCodeDataAccessor := database.NewAccountRecoveryCodeDataAccessor(databaseDatabase, logger)
+	totpLogic, err := logic.NewTOTPLogic(databaseDatabase, accountDataAccessor, accountTOTPDataAccessor, accountRecoveryCodeDataAccessor, tokenLogic, logger, auth)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	workspaceDataAccessor := database.NewWorkspaceDataAccessor(databaseDatabase, logger)
+	workspaceMemberDataAccessor := database.NewWorkspaceMemberDataAccessor(databaseDatabase, logger)
+	workspaceLogic := logic.NewWorkspaceLogic(databaseDatabase, accountDataAccessor, workspaceDataAccessor, workspaceMemberDataAccessor, tokenLogic, logger)
 	takenAccountName, err := cache.NewTakenAccountName(client)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	accountLogic := logic.NewAccountLogic(databaseDatabase, accountDataAccessor, accountPasswordDataAccessor, hashLogic, tokenLogic, takenAccountName, logger)
-	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(databaseDatabase, logger)
-	mq := config.MQ
-	producerClient, err := producer.NewClient(mq, logger)
+	failedLoginAttempt, err := cache.NewFailedLoginAttempt(client)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	loginThrottleLogic := logic.NewLoginThrottleLogic(failedLoginAttempt, logger, auth)
+	totpLoginChallenge, err := cache.NewTOTPLoginChallenge(client)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	downloadTaskCreatedProducer, err := producer.NewDownloadTaskCreatedProducer(producerClient, logger)
+	accountLogic, err := logic.NewAccountLogic(databaseDatabase, accountDataAccessor, accountPasswordDataAccessor, sessionDataAccessor, refreshTokenDataAccessor, hashLogic, passwordLogic, tokenLogic, totpLogic, workspaceLogic, takenAccountName, loginThrottleLogic, totpLoginChallenge, logger, auth)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(databaseDatabase, logger)
+	downloadTaskEventDataAccessor := database.NewDownloadTaskEventDataAccessor(databaseDatabase, logger)
+	tagDataAccessor := database.NewTagDataAccessor(databaseDatabase, logger)
+	categoryDataAccessor := database.NewCategoryDataAccessor(databaseDatabase, logger)
+	webhookDataAccessor := database.NewWebhookDataAccessor(databaseDatabase, logger)
+	webhookDeliveryDataAccessor := database.NewWebhookDeliveryDataAccessor(databaseDatabase, logger)
+	outboxMessageDataAccessor := database.NewOutboxMessageDataAccessor(databaseDatabase, logger)
 	download := config.Download
-	fileClient, err := file.NewClient(download, logger)
+	tieredClient, err := file.NewTieredClient(download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	codecSelector, err := file.NewCodecSelector(download, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
 	cron := config.Cron
-	downloadTaskLogic, err := logic.NewDownloadTaskLogic(tokenLogic, accountDataAccessor, downloadTaskDataAccessor, downloadTaskCreatedProducer, fileClient, databaseDatabase, logger, cron)
+	downloadTaskLogic, err := logic.NewDownloadTaskLogic(tokenLogic, workspaceLogic, accountDataAccessor, downloadTaskDataAccessor, downloadTaskEventDataAccessor, tagDataAccessor, categoryDataAccessor, webhookDataAccessor, webhookDeliveryDataAccessor, outboxMessageDataAccessor, tieredClient, codecSelector, databaseDatabase, logger, cron)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	shareLinkDataAccessor := database.NewShareLinkDataAccessor(databaseDatabase, logger)
+	shareLink := config.ShareLink
+	shareLinkLogic, err := logic.NewShareLinkLogic(tokenLogic, hashLogic, workspaceLogic, downloadTaskLogic, downloadTaskDataAccessor, shareLinkDataAccessor, shareLink, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	accountExternalIdentityDataAccessor := database.NewAccountExternalIdentityDataAccessor(databaseDatabase, logger)
+	oidcLoginState, err := cache.NewOIDCLoginState(client)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	oidc := config.OIDC
+	oidcLogic, err := logic.NewOIDCLogic(databaseDatabase, accountDataAccessor, accountExternalIdentityDataAccessor, accountLogic, tokenLogic, workspaceLogic, oidcLoginState, takenAccountName, oidc, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	apiKeyLogic := logic.NewAPIKeyLogic(tokenLogic, apiKeyDataAccessor, logger)
+	tagLogic := logic.NewTagLogic(tokenLogic, workspaceLogic, tagDataAccessor, logger)
+	categoryLogic := logic.NewCategoryLogic(tokenLogic, workspaceLogic, categoryDataAccessor, downloadTaskDataAccessor, databaseDatabase, logger)
+	webhook := config.Webhook
+	webhookLogic, err := logic.NewWebhookLogic(tokenLogic, webhookDataAccessor, webhookDeliveryDataAccessor, databaseDatabase, logger, webhook, cron)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	idmServiceServer, err := grpc.NewHandler(accountLogic, downloadTaskLogic, shareLinkLogic, oidcLogic, passwordLogic, totpLogic, apiKeyLogic, workspaceLogic, tagLogic, categoryLogic, webhookLogic, configsGRPC)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	idmServiceServer := grpc.NewHandler(accountLogic, downloadTaskLogic, configsGRPC)
-	server := grpc.NewServer(configsGRPC, idmServiceServer)
+	adminLogic := logic.NewAdminLogic(databaseDatabase, accountDataAccessor, sessionDataAccessor, downloadTaskDataAccessor, downloadTaskEventDataAccessor, tagDataAccessor, outboxMessageDataAccessor, passwordLogic, tokenLogic, totpLogic, logger)
+	adminServiceServer := grpc.NewAdminHandler(adminLogic)
+	authorizationInterceptor := grpc.NewAuthorizationInterceptor(accountLogic)
+	server := grpc.NewServer(configsGRPC, idmServiceServer, adminServiceServer, authorizationInterceptor)
 	configsHTTP := config.HTTP
 	spaHandler := http.NewSPAHandler(logger)
-	httpServer := http.NewServer(configsHTTP, configsGRPC, auth, spaHandler, logger)
+	downloadTaskFileHandler := http.NewDownloadTaskFileHandler(downloadTaskLogic, logger)
+	jwksHandler := http.NewJWKSHandler(tokenSigningKeyLogic, logger)
+	httpServer := http.NewServer(configsHTTP, configsGRPC, auth, oidc, spaHandler, downloadTaskFileHandler, jwksHandler, logger)
 	downloadTaskCreatedHandler, err := consumer.NewDownloadTaskCreatedHandler(downloadTaskLogic, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
+	mq := config.MQ
 	consumerConsumer, err := consumer2.NewConsumer(mq, logger)
 	if err != nil {
 		cleanup2()
@@ -128,7 +221,18 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath) (app.Stan
 	rootConsumer := consumer.NewRootConsumer(downloadTaskCreatedHandler, consumerConsumer, logger)
 	executeAllPendingDownloadTaskJob := jobs.NewExecuteAllPendingDownloadTaskJob(downloadTaskLogic, cron)
 	updateFailedDownloadTaskStatusToPendingJob := jobs.NewUpdateFailedDownloadTaskStatusToPendingJob(downloadTaskLogic, cron)
-	jobsCron, err := jobs.NewCron(executeAllPendingDownloadTaskJob, updateFailedDownloadTaskStatusToPendingJob, logger)
+	moveDownloadTaskFileToColdTierJob := jobs.NewMoveDownloadTaskFileToColdTierJob(downloadTaskLogic, cron)
+	rotateTokenSigningKeyJob := jobs.NewRotateTokenSigningKeyJob(tokenSigningKeyLogic, cron)
+	deliverAllPendingWebhookDeliveryJob := jobs.NewDeliverAllPendingWebhookDeliveryJob(webhookLogic, cron)
+	producerClient, err := producer.NewClient(mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	outboxMessageLogic := logic.NewOutboxMessageLogic(outboxMessageDataAccessor, producerClient, databaseDatabase, logger, cron)
+	relayAllUnsentOutboxMessageJob := jobs.NewRelayAllUnsentOutboxMessageJob(outboxMessageLogic, cron)
+	jobsCron, err := jobs.NewCron(executeAllPendingDownloadTaskJob, updateFailedDownloadTaskStatusToPendingJob, moveDownloadTaskFileToColdTierJob, rotateTokenSigningKeyJob, deliverAllPendingWebhookDeliveryJob, relayAllUnsentOutboxMessageJob, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
@@ -146,6 +250,184 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath) (app.Stan
 	}, nil
 }
 
+func InitializeDownloadTaskLogic(configFilePath configs.ConfigFilePath) (logic.DownloadTaskLogic, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	configsDatabase := config.Database
+	databaseDatabase, cleanup, err := database.InitializeDB(configsDatabase)
+	if err != nil {
+		return nil, nil, err
+	}
+	log := config.Log
+	logger, cleanup2, err := utils.InitializeLogger(log)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	accountDataAccessor := database.NewAccountDataAccessor(databaseDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(databaseDatabase, logger)
+	apiKeyDataAccessor := database.NewAPIKeyDataAccessor(databaseDatabase, logger)
+	tokenPublicKeyDataAccessor, err := database.NewTokenPublicKeyDataAccessor(databaseDatabase, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	configsCache := config.Cache
+	client, err := cache.NewClient(configsCache, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tokenPublicKey, err := cache.NewTokenPublicKey(client)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	auth := config.Auth
+	tokenSigningKeyLogic, err := logic.NewTokenSigningKeyLogic(databaseDatabase, tokenPublicKeyDataAccessor, tokenPublicKey, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	sessionDenylist, err := cache.NewSessionDenylist(client)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tokenLogic, err := logic.NewTokenLogic(accountDataAccessor, sessionDataAccessor, apiKeyDataAccessor, tokenSigningKeyLogic, logger, auth, sessionDenylist)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	workspaceDataAccessor := database.NewWorkspaceDataAccessor(databaseDatabase, logger)
+	workspaceMemberDataAccessor := database.NewWorkspaceMemberDataAccessor(databaseDatabase, logger)
+	workspaceLogic := logic.NewWorkspaceLogic(databaseDatabase, accountDataAccessor, workspaceDataAccessor, workspaceMemberDataAccessor, tokenLogic, logger)
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(databaseDatabase, logger)
+	downloadTaskEventDataAccessor := database.NewDownloadTaskEventDataAccessor(databaseDatabase, logger)
+	tagDataAccessor := database.NewTagDataAccessor(databaseDatabase, logger)
+	categoryDataAccessor := database.NewCategoryDataAccessor(databaseDatabase, logger)
+	webhookDataAccessor := database.NewWebhookDataAccessor(databaseDatabase, logger)
+	webhookDeliveryDataAccessor := database.NewWebhookDeliveryDataAccessor(databaseDatabase, logger)
+	outboxMessageDataAccessor := database.NewOutboxMessageDataAccessor(databaseDatabase, logger)
+	download := config.Download
+	tieredClient, err := file.NewTieredClient(download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	codecSelector, err := file.NewCodecSelector(download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	cron := config.Cron
+	downloadTaskLogic, err := logic.NewDownloadTaskLogic(tokenLogic, workspaceLogic, accountDataAccessor, downloadTaskDataAccessor, downloadTaskEventDataAccessor, tagDataAccessor, categoryDataAccessor, webhookDataAccessor, webhookDeliveryDataAccessor, outboxMessageDataAccessor, tieredClient, codecSelector, databaseDatabase, logger, cron)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	return downloadTaskLogic, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InitializeAdminLogic(configFilePath configs.ConfigFilePath) (logic.AdminLogic, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	configsDatabase := config.Database
+	databaseDatabase, cleanup, err := database.InitializeDB(configsDatabase)
+	if err != nil {
+		return nil, nil, err
+	}
+	log := config.Log
+	logger, cleanup2, err := utils.InitializeLogger(log)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	accountDataAccessor := database.NewAccountDataAccessor(databaseDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(databaseDatabase, logger)
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(databaseDatabase, logger)
+	downloadTaskEventDataAccessor := database.NewDownloadTaskEventDataAccessor(databaseDatabase, logger)
+	tagDataAccessor := database.NewTagDataAccessor(databaseDatabase, logger)
+	outboxMessageDataAccessor := database.NewOutboxMessageDataAccessor(databaseDatabase, logger)
+	accountPasswordDataAccessor := database.NewAccountPasswordDataAccessor(databaseDatabase, logger)
+	passwordResetTokenDataAccessor := database.NewPasswordResetTokenDataAccessor(databaseDatabase, logger)
+	hashLogic := logic.NewHashLogic()
+	apiKeyDataAccessor := database.NewAPIKeyDataAccessor(databaseDatabase, logger)
+	tokenPublicKeyDataAccessor, err := database.NewTokenPublicKeyDataAccessor(databaseDatabase, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	configsCache := config.Cache
+	client, err := cache.NewClient(configsCache, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tokenPublicKey, err := cache.NewTokenPublicKey(client)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	auth := config.Auth
+	tokenSigningKeyLogic, err := logic.NewTokenSigningKeyLogic(databaseDatabase, tokenPublicKeyDataAccessor, tokenPublicKey, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	sessionDenylist, err := cache.NewSessionDenylist(client)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tokenLogic, err := logic.NewTokenLogic(accountDataAccessor, sessionDataAccessor, apiKeyDataAccessor, tokenSigningKeyLogic, logger, auth, sessionDenylist)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordLogic, err := logic.NewPasswordLogic(databaseDatabase, accountDataAccessor, accountPasswordDataAccessor, passwordResetTokenDataAccessor, hashLogic, tokenLogic, logger, auth)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountTOTPDataAccessor := database.NewAccountTOTPDataAccessor(databaseDatabase, logger)
+	accountRecoveryCodeDataAccessor := database.NewAccountRecoveryCodeDataAccessor(databaseDatabase, logger)
+	totpLogic, err := logic.NewTOTPLogic(databaseDatabase, accountDataAccessor, accountTOTPDataAccessor, accountRecoveryCodeDataAccessor, tokenLogic, logger, auth)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	adminLogic := logic.NewAdminLogic(databaseDatabase, accountDataAccessor, sessionDataAccessor, downloadTaskDataAccessor, downloadTaskEventDataAccessor, tagDataAccessor, outboxMessageDataAccessor, passwordLogic, tokenLogic, totpLogic, logger)
+	return adminLogic, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
 // wire.go:
 
 var WireSet = wire.NewSet(configs.WireSet, dataaccess.WireSet, handler.WireSet, logic.WireSet, utils.WireSet, app.WireSet)